@@ -66,9 +66,16 @@ func startHTTPServer(ctx context.Context, injector *wirex.Injector) (func(), err
 	}
 
 	if dir := config.C.Middleware.Static.Dir; dir != "" {
+		indexHeaders := make(map[string]string)
+		if config.C.Webmention.Enabled { // 声明 Webmention 接收端点
+			indexHeaders["Link"] = `</api/webmention>; rel="webmention"`
+		}
 		e.Use(middleware.StaticWithConfig(middleware.StaticConfig{
 			Root:                dir,
 			SkippedPathPrefixes: allowedPrefixes, // 非 api/ 路径
+			CrawlerUserAgents:   config.C.Middleware.Static.CrawlerUserAgents,
+			RenderHead:          injector.M.Blog.ShareHandler.RenderHead,
+			IndexHeaders:        indexHeaders,
 		}))
 	}
 
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/codeExpert666/goinkblog-backend/pkg/json"
 	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
@@ -9,13 +10,22 @@ import (
 
 // Config 配置参数
 type Config struct {
-	General    General              `json:"general"`
-	Logger     logging.LoggerConfig `json:"logger"`
-	Storage    Storage              `json:"storage"`
-	Middleware Middleware           `json:"middleware"`
-	Util       Util                 `json:"util"`
-	Dictionary Dictionary           `json:"dictionary"`
-	AI         AI                   `json:"ai"`
+	General     General              `json:"general"`
+	Logger      logging.LoggerConfig `json:"logger"`
+	Storage     Storage              `json:"storage"`
+	Middleware  Middleware           `json:"middleware"`
+	Util        Util                 `json:"util"`
+	Dictionary  Dictionary           `json:"dictionary"`
+	AI          AI                   `json:"ai"`
+	Blog        Blog                 `json:"blog"`
+	Comment     Comment              `json:"comment"`
+	Stat        Stat                 `json:"stat"`
+	Sensitive   Sensitive            `json:"sensitive"`
+	Webmention  Webmention           `json:"webmention"`
+	ActivityPub ActivityPub          `json:"activitypub"`
+	LinkCheck   LinkCheck            `json:"linkcheck"`
+	Mention     Mention              `json:"mention"`
+	Mailer      Mailer               `json:"mailer"`
 }
 
 type General struct {
@@ -94,6 +104,18 @@ type AI struct {
 		LoadModelsInterval   int `default:"11" json:"load_models_interval"`  // 单位为分钟
 		UpdateWeightInterval int `default:"2" json:"update_weight_interval"` // 单位为分钟
 	} `json:"selector"`
+	Moderation AIModeration `json:"moderation"`
+}
+
+type AIModeration struct {
+	Enabled          bool    `json:"enabled"`                          // 是否开启待审核评论的 AI 审核
+	Interval         int     `default:"60" json:"interval"`            // 后台任务的轮询间隔，单位为秒
+	BatchSize        int     `default:"10" json:"batch_size"`          // 每轮处理的最大评论数
+	MaxAttempts      int     `default:"3" json:"max_attempts"`         // 调用模型或解析结果失败时的最大尝试次数
+	RetryBackoff     int     `default:"300" json:"retry_backoff"`      // 首次重试的等待时间，之后按指数增长，单位为秒
+	Lease            int     `default:"300" json:"lease"`              // 领取评论后的处理时限，超时后可被重新领取，单位为秒
+	ApproveThreshold float64 `default:"0.95" json:"approve_threshold"` // 判定为正常且置信度不低于该值时自动通过，大于 1 表示不自动通过
+	RejectThreshold  float64 `default:"0.9" json:"reject_threshold"`   // 判定为违规且置信度不低于该值时自动拒绝，大于 1 表示不自动拒绝
 }
 
 type Model struct {
@@ -109,6 +131,139 @@ type Model struct {
 	Weight      int     `json:"weight"`
 }
 
+type Blog struct {
+	TagSuggest struct {
+		RefreshInterval int `default:"60" json:"refresh_interval"` // 检查标签变更的间隔，单位为秒
+		MaxLimit        int `default:"20" json:"max_limit"`        // 单次联想返回的最大标签数
+	} `json:"tag_suggest"`
+	ArticleReview struct {
+		Enabled      bool     `json:"enabled"`                                        // 是否开启文章审核，开启后非信任角色发布文章需经审核
+		TrustedRoles []string `default:"[\"admin\",\"editor\"]" json:"trusted_roles"` // 发布文章无需审核、且可以审核文章的角色
+	} `json:"article_review"`
+	DuplicateCheck struct {
+		MinSimilarity float64 `default:"0.85" json:"min_similarity"` // 判定为疑似重复的最低相似度（基于 SimHash 指纹，取值 0~1）
+		TopN          int     `default:"5" json:"top_n"`             // 每篇文章最多记录的相似文章数
+	} `json:"duplicate_check"`
+	Share struct {
+		SiteURL     string `json:"site_url"`                         // 站点对外访问地址，如 https://blog.example.com，为空时根据请求推断
+		SiteName    string `default:"GoInk Blog" json:"site_name"`   // 站点名称
+		ArticlePath string `default:"/article/" json:"article_path"` // 前端文章详情页路径前缀，后接文章ID
+		CacheAge    int    `default:"3600" json:"cache_age"`         // oEmbed 响应建议的缓存时间，单位为秒
+	} `json:"share"`
+}
+
+type Comment struct {
+	EditWindow   int      `default:"30" json:"edit_window"`                       // 评论发表后作者可编辑的时间，单位为分钟，小于 0 表示不限制
+	BatchReview  int      `default:"100" json:"batch_review"`                     // 单次批量审核的最大评论数
+	MaxPinned    int      `default:"3" json:"max_pinned"`                         // 每篇文章最多置顶的评论数
+	TrustedRoles []string `default:"[\"admin\",\"editor\"]" json:"trusted_roles"` // 受信任的角色，文章作者与其协管员同样受信任
+	Default      struct {
+		Status        string `default:"open" json:"status"`        // 评论开关：open-开放，closed-关闭，auto-发布指定天数后自动关闭
+		AutoCloseDays int    `default:"30" json:"auto_close_days"` // 自动关闭评论的天数，从文章首次发布时起算
+		Moderation    string `default:"pre" json:"moderation"`     // 审核模式：pre-先审后发，post-先发后审，trusted-仅限受信任用户评论
+	} `json:"default"`
+	Guest struct {
+		Enabled     bool   `json:"enabled"`                               // 是否允许未登录的访客评论，需同时开启邮件发送并配置 blog.share.site_url
+		VerifyPath  string `default:"/comment/verify" json:"verify_path"` // 前端验证访客邮箱的页面路径，验证链接为该路径后接 ?token=令牌
+		ClaimPath   string `default:"/comment/claim" json:"claim_path"`   // 前端认领访客评论的页面路径，认领链接为该路径后接 ?token=令牌
+		TokenExpire int    `default:"24" json:"token_expire"`             // 验证与认领链接的有效期，单位为小时，逾期未验证的访客评论将被删除
+	} `json:"guest"`
+	Spam struct {
+		Enabled         bool     `json:"enabled"`                        // 是否开启垃圾评论评分，关闭时非管理员的评论全部进入待审核
+		ApproveBelow    int      `default:"20" json:"approve_below"`     // 评分低于该值的评论自动通过，小于等于 0 表示不自动通过
+		RejectAbove     int      `default:"80" json:"reject_above"`      // 评分高于该值的评论自动拒绝，大于等于 100 表示不自动拒绝
+		MaxLinks        int      `default:"1" json:"max_links"`          // 不计分的链接数，超出部分每个链接计分
+		LinkScore       int      `default:"10" json:"link_score"`        // 每个超出的链接的分数
+		DuplicateWindow int      `default:"24" json:"duplicate_window"`  // 检查重复内容的时间范围，单位为小时
+		DuplicateScore  int      `default:"30" json:"duplicate_score"`   // 内容与时间范围内其他评论完全相同时的分数
+		NewAccountHours int      `default:"24" json:"new_account_hours"` // 注册时间不足该值的账号视为新账号，单位为小时
+		NewAccountScore int      `default:"15" json:"new_account_score"` // 新账号的分数
+		RateLimit       int      `default:"5" json:"rate_limit"`         // 一小时内的评论数超过该值时视为发布过快
+		RateScore       int      `default:"20" json:"rate_score"`        // 发布过快的分数
+		Blocklist       []string `json:"blocklist"`                      // 屏蔽词或域名，不区分大小写
+		BlocklistScore  int      `default:"50" json:"blocklist_score"`   // 每个命中的屏蔽词的分数
+		BayesWeight     int      `default:"40" json:"bayes_weight"`      // 贝叶斯分类结果的最大分数，倾向正常时减分
+		BayesMinDocs    int64    `default:"20" json:"bayes_min_docs"`    // 垃圾与正常样本均达到该数量后才使用贝叶斯分类
+	} `json:"spam"`
+}
+
+type Stat struct {
+	ArticleRollup struct {
+		Interval     int `default:"300" json:"interval"`     // 文章每日统计的汇总间隔，单位为秒
+		BackfillDays int `default:"30" json:"backfill_days"` // 启动时最多回填的天数
+	} `json:"article_rollup"`
+}
+
+type Sensitive struct {
+	RefreshInterval int `default:"60" json:"refresh_interval"` // 检查敏感词库变更的间隔，单位为秒
+}
+
+type Webmention struct {
+	Enabled           bool  `json:"enabled"`                         // 是否开启 Webmention 的发送与接收，发送时需配置 blog.share.site_url
+	Interval          int   `default:"30" json:"interval"`           // 后台任务的轮询间隔，单位为秒
+	Timeout           int   `default:"10" json:"timeout"`            // 请求外部站点的超时时间，单位为秒
+	MaxAttempts       int   `default:"5" json:"max_attempts"`        // 发送或验证失败时的最大尝试次数
+	RetryBackoff      int   `default:"60" json:"retry_backoff"`      // 首次重试的等待时间，之后按指数增长，单位为秒
+	BatchSize         int   `default:"20" json:"batch_size"`         // 每轮处理的最大任务数
+	MaxBodySize       int64 `default:"1048576" json:"max_body_size"` // 读取外部页面的最大字节数
+	AllowPrivateHosts bool  `json:"allow_private_hosts"`             // 是否允许访问内网地址（仅用于本地测试）
+}
+
+type ActivityPub struct {
+	Enabled           bool  `json:"enabled"`                         // 是否开启 ActivityPub 联邦，需同时配置 blog.share.site_url
+	Interval          int   `default:"30" json:"interval"`           // 投递任务的轮询间隔，单位为秒
+	Timeout           int   `default:"10" json:"timeout"`            // 请求远程实例的超时时间，单位为秒
+	MaxAttempts       int   `default:"8" json:"max_attempts"`        // 投递失败时的最大尝试次数
+	RetryBackoff      int   `default:"60" json:"retry_backoff"`      // 首次重试的等待时间，之后按指数增长，单位为秒
+	BatchSize         int   `default:"50" json:"batch_size"`         // 每轮处理的最大投递任务数
+	MaxBodySize       int64 `default:"1048576" json:"max_body_size"` // 读取远程响应与收件箱请求体的最大字节数
+	MaxClockSkew      int   `default:"3600" json:"max_clock_skew"`   // HTTP 签名中请求时间允许的最大偏差，单位为秒
+	ActorCacheTTL     int   `default:"86400" json:"actor_cache_ttl"` // 远程参与者信息（含公钥）的缓存时间，单位为秒
+	OutboxPageSize    int   `default:"20" json:"outbox_page_size"`   // 发件箱每页的活动数
+	AllowPrivateHosts bool  `json:"allow_private_hosts"`             // 是否允许访问内网地址（仅用于本地测试）
+}
+
+type LinkCheck struct {
+	Enabled           bool `json:"enabled"`                        // 是否开启文章外部链接检查
+	Interval          int  `default:"60" json:"interval"`          // 后台任务的轮询间隔，单位为秒
+	Timeout           int  `default:"10" json:"timeout"`           // 单次请求的超时时间，单位为秒
+	Concurrency       int  `default:"8" json:"concurrency"`        // 同时进行的最大请求数
+	HostDelay         int  `default:"2000" json:"host_delay"`      // 对同一主机两次请求之间的最小间隔，单位为毫秒
+	BatchSize         int  `default:"100" json:"batch_size"`       // 每轮检查的最大链接数
+	RecheckInterval   int  `default:"168" json:"recheck_interval"` // 可用链接的复查间隔，单位为小时
+	RetryInterval     int  `default:"24" json:"retry_interval"`    // 失效或无法访问的链接的复查间隔，单位为小时
+	AllowPrivateHosts bool `json:"allow_private_hosts"`            // 是否允许访问内网地址（仅用于本地测试）
+}
+
+type Mention struct {
+	MaxPerContent int    `default:"10" json:"max_per_content"`  // 单条文章或评论中最多生效的提及人数，超出部分不记录
+	ProfilePath   string `default:"/user/" json:"profile_path"` // 前端用户主页路径前缀，后接用户名
+}
+
+type Mailer struct {
+	Enabled       bool   `json:"enabled"`                          // 是否开启邮件发送，关闭时忽略所有邮件
+	Host          string `default:"localhost" json:"host"`         // SMTP 服务器地址
+	Port          int    `default:"25" json:"port"`                // SMTP 服务器端口，本地可使用 MailHog（1025）
+	Username      string `json:"username"`                         // SMTP 用户名，为空时不认证
+	Password      string `json:"password"`                         // SMTP 密码
+	TLS           string `json:"tls"`                              // TLS 模式：为空表示明文，starttls 或 tls
+	From          string `default:"noreply@localhost" json:"from"` // 发件人地址
+	FromName      string `default:"GoInkBlog" json:"from_name"`    // 发件人名称
+	TemplateDir   string `default:"mail" json:"template_dir"`      // 邮件模板目录，相对于工作目录
+	DefaultLocale string `default:"zh-CN" json:"default_locale"`   // 默认语言，找不到对应语言的模板时使用
+	Interval      int    `default:"30" json:"interval"`            // 后台任务的轮询间隔，单位为秒
+	Timeout       int    `default:"10" json:"timeout"`             // 单封邮件的发送超时，单位为秒
+	MaxAttempts   int    `default:"5" json:"max_attempts"`         // 发送失败时的最大尝试次数
+	RetryBackoff  int    `default:"60" json:"retry_backoff"`       // 首次重试的等待时间，之后按指数增长，单位为秒
+	BatchSize     int    `default:"20" json:"batch_size"`          // 每轮发送的最大邮件数
+	Digest        struct {
+		Enabled   bool `json:"enabled"`                // 是否发送通知摘要邮件
+		Hour      int  `default:"8" json:"hour"`       // 摘要邮件的发送时间（服务器本地时间的小时，0-23）
+		WeeklyDay int  `default:"1" json:"weekly_day"` // 每周摘要的发送日，0 表示周日
+		MaxItems  int  `default:"20" json:"max_items"` // 单封摘要邮件最多列出的通知数
+	} `json:"digest"`
+}
+
 type Dictionary struct {
 	UserCacheExp int `default:"4" json:"user_cache_exp"` // 用户缓存过期时间（小时）
 }
@@ -138,6 +293,11 @@ func (c *Config) FormatTableName(name string) string {
 	return c.Storage.DB.TablePrefix + name
 }
 
+// SiteURL 站点对外访问地址（去除末尾的斜杠），未配置时为空
+func (c *Config) SiteURL() string {
+	return strings.TrimRight(c.Blog.Share.SiteURL, "/")
+}
+
 // PreLoad Redis配置自动复用
 func (c *Config) PreLoad() {
 	addr := c.Storage.Cache.Redis.Addr
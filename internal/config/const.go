@@ -9,6 +9,12 @@ const (
 
 	// CacheNSForAI AI 模块相关的缓存命名空间
 	CacheNSForAI = "ai"
+
+	// CacheNSForBlog 博客模块相关的缓存命名空间
+	CacheNSForBlog = "blog"
+
+	// CacheNSForSensitive 敏感词模块相关的缓存命名空间
+	CacheNSForSensitive = "sensitive"
 )
 
 const (
@@ -17,6 +23,12 @@ const (
 
 	// CacheKeyForSyncToCasbin Casbin同步标记的缓存键
 	CacheKeyForSyncToCasbin = "sync:casbin"
+
+	// CacheKeyForSyncToTagSuggester 标签联想索引同步标记的缓存键
+	CacheKeyForSyncToTagSuggester = "sync:tag_suggester"
+
+	// CacheKeyForSyncToSensitiveFilter 敏感词过滤器同步标记的缓存键
+	CacheKeyForSyncToSensitiveFilter = "sync:sensitive_filter"
 )
 
 const (
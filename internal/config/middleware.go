@@ -76,7 +76,10 @@ type Middleware struct {
 		AutoLoadInterval    int      `json:"auto_load_interval"`                    // 秒
 	} `json:"casbin"`
 
-	Static struct { // 命令行参数
-		Dir string `json:"dir"`
+	Static struct {
+		Dir string `json:"dir"` // 命令行参数
+
+		// 需要注入分享元数据的爬虫 User-Agent 关键字（不区分大小写）
+		CrawlerUserAgents []string `default:"[\"facebookexternalhit\",\"Twitterbot\",\"Slackbot\",\"LinkedInBot\",\"Discordbot\",\"TelegramBot\",\"WhatsApp\",\"Googlebot\",\"bingbot\",\"Baiduspider\"]" json:"crawler_user_agents"`
 	} `json:"static"`
 }
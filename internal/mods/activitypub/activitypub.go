@@ -0,0 +1,81 @@
+package activitypub
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/api"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/schema"
+)
+
+// ActivityPub ActivityPub 联邦模块
+type ActivityPub struct {
+	DB                 *gorm.DB
+	ActivityPubService *biz.ActivityPubService
+	ActivityPubHandler *api.ActivityPubHandler
+}
+
+// Set 注入 ActivityPub 模块
+var Set = wire.NewSet(
+	wire.Struct(new(ActivityPub), "*"),
+
+	// ActivityPub 相关结构体
+	wire.Struct(new(api.ActivityPubHandler), "*"),
+	wire.Struct(new(biz.ActivityPubService), "*"),
+	wire.Struct(new(dal.ActivityPubRepository), "*"),
+)
+
+// AutoMigrate 自动迁移数据库
+func (a *ActivityPub) AutoMigrate(ctx context.Context) error {
+	return a.DB.AutoMigrate(
+		&schema.ActorKey{},
+		&schema.Follower{},
+		&schema.RemoteActor{},
+		&schema.RemoteLike{},
+		&schema.Delivery{},
+	)
+}
+
+// Init 初始化 ActivityPub 模块
+func (a *ActivityPub) Init(ctx context.Context) error {
+	if config.C.Storage.DB.AutoMigrate {
+		if err := a.AutoMigrate(ctx); err != nil {
+			return err
+		}
+	}
+
+	// 启动投递的后台任务
+	a.ActivityPubService.Start(ctx)
+	return nil
+}
+
+// RegisterRouters 注册路由
+func (a *ActivityPub) RegisterRouters(ctx context.Context, activitypub *gin.RouterGroup) error {
+	users := activitypub.Group("/users")
+	{
+		users.GET("/:id", a.ActivityPubHandler.GetActor)
+		users.GET("/:id/outbox", a.ActivityPubHandler.GetOutbox)
+		users.GET("/:id/followers", a.ActivityPubHandler.GetFollowers)
+		users.POST("/:id/inbox", a.ActivityPubHandler.UserInbox)
+	}
+	activitypub.GET("/articles/:id", a.ActivityPubHandler.GetArticle)
+	activitypub.POST("/inbox", a.ActivityPubHandler.SharedInbox)
+	return nil
+}
+
+// RegisterWellKnownRouters 注册 /.well-known/ 下的路由
+func (a *ActivityPub) RegisterWellKnownRouters(ctx context.Context, wellKnown *gin.RouterGroup) error {
+	wellKnown.GET("/webfinger", a.ActivityPubHandler.WebFinger)
+	return nil
+}
+
+// Release 释放资源
+func (a *ActivityPub) Release(ctx context.Context) error {
+	return a.ActivityPubService.Release(ctx)
+}
@@ -0,0 +1,233 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/activitypub"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/json"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// ActivityPubHandler ActivityPub API处理器
+type ActivityPubHandler struct {
+	ActivityPubService *biz.ActivityPubService
+}
+
+// resActivity 以 ActivityPub 媒体类型响应，协议数据不使用统一的响应包装
+func resActivity(c *gin.Context, contentType string, v interface{}) {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		util.ResError(c, errors.WithStack(err))
+		return
+	}
+
+	c.Set(util.ResBodyKey, buf)
+	c.Data(http.StatusOK, contentType, buf)
+	c.Abort()
+}
+
+// parseUserID 解析路径中的用户ID
+func parseUserID(c *gin.Context) (uint, error) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return 0, errors.BadRequest("无效的用户ID")
+	}
+	return uint(id), nil
+}
+
+// WebFinger 根据账号查找参与者
+// @Tags ActivityPubAPI
+// @Summary WebFinger 账号查询
+// @Produce json
+// @Param resource query string true "资源标识，如 acct:username@example.com"
+// @Success 200 {object} activitypub.JRD
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Router /.well-known/webfinger [get]
+func (h *ActivityPubHandler) WebFinger(c *gin.Context) {
+	var req schema.WebFingerRequest
+	if err := util.ParseQuery(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.ActivityPubService.WebFinger(ctx, req.Resource)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	resActivity(c, activitypub.ContentTypeJRD, data)
+}
+
+// GetActor 获取用户对应的参与者
+// @Tags ActivityPubAPI
+// @Summary 获取用户对应的参与者
+// @Produce json
+// @Param id path uint true "用户ID" minimum(1)
+// @Success 200 {object} activitypub.Actor
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Router /api/activitypub/users/{id} [get]
+func (h *ActivityPubHandler) GetActor(c *gin.Context) {
+	id, err := parseUserID(c)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.ActivityPubService.GetActor(ctx, id)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	resActivity(c, activitypub.ContentType, data)
+}
+
+// GetOutbox 获取用户的发件箱
+// @Tags ActivityPubAPI
+// @Summary 获取用户的发件箱（已发布文章的 Create 活动）
+// @Produce json
+// @Param id path uint true "用户ID" minimum(1)
+// @Param page query int false "页码，不传时返回集合概要" minimum(1)
+// @Success 200 {object} activitypub.OrderedCollection
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Router /api/activitypub/users/{id}/outbox [get]
+func (h *ActivityPubHandler) GetOutbox(c *gin.Context) {
+	id, err := parseUserID(c)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+	var req schema.CollectionPageRequest
+	if err := util.ParseQuery(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.ActivityPubService.GetOutbox(ctx, id, req.Page)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	resActivity(c, activitypub.ContentType, data)
+}
+
+// GetFollowers 获取用户的关注者集合
+// @Tags ActivityPubAPI
+// @Summary 获取用户的关注者集合（仅包含数量）
+// @Produce json
+// @Param id path uint true "用户ID" minimum(1)
+// @Success 200 {object} activitypub.OrderedCollection
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Router /api/activitypub/users/{id}/followers [get]
+func (h *ActivityPubHandler) GetFollowers(c *gin.Context) {
+	id, err := parseUserID(c)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.ActivityPubService.GetFollowers(ctx, id)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	resActivity(c, activitypub.ContentType, data)
+}
+
+// GetArticle 获取文章对应的对象
+// @Tags ActivityPubAPI
+// @Summary 获取已发布文章对应的对象
+// @Produce json
+// @Param id path uint true "文章ID" minimum(1)
+// @Success 200 {object} activitypub.Object
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Router /api/activitypub/articles/{id} [get]
+func (h *ActivityPubHandler) GetArticle(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.ActivityPubService.GetArticle(ctx, uint(id))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	resActivity(c, activitypub.ContentType, data)
+}
+
+// UserInbox 用户收件箱
+// @Tags ActivityPubAPI
+// @Summary 用户收件箱（需 HTTP 签名，支持 Follow、Undo、Like 与 Create）
+// @Accept json
+// @Param id path uint true "用户ID" minimum(1)
+// @Param activity body activitypub.Activity true "活动"
+// @Success 202
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Router /api/activitypub/users/{id}/inbox [post]
+func (h *ActivityPubHandler) UserInbox(c *gin.Context) {
+	id, err := parseUserID(c)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+	h.handleInbox(c, id)
+}
+
+// SharedInbox 共享收件箱
+// @Tags ActivityPubAPI
+// @Summary 共享收件箱（需 HTTP 签名，支持 Follow、Undo、Like 与 Create）
+// @Accept json
+// @Param activity body activitypub.Activity true "活动"
+// @Success 202
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Router /api/activitypub/inbox [post]
+func (h *ActivityPubHandler) SharedInbox(c *gin.Context) {
+	h.handleInbox(c, 0)
+}
+
+func (h *ActivityPubHandler) handleInbox(c *gin.Context, userID uint) {
+	body, err := io.ReadAll(io.LimitReader(c.Request.Body, config.C.ActivityPub.MaxBodySize))
+	if err != nil {
+		util.ResError(c, errors.BadRequest("读取请求体失败"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.ActivityPubService.HandleInbox(ctx, userID, c.Request, body); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+	c.Abort()
+}
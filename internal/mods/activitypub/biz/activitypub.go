@@ -0,0 +1,827 @@
+package biz
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/schema"
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	blogDal "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	blogSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	commentBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/biz"
+	commentDal "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/dal"
+	commentSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	notificationBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	notificationSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/activitypub"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// 本站 ActivityPub 资源路径
+const (
+	actorPathPrefix   = "/api/activitypub/users/"
+	articlePathPrefix = "/api/activitypub/articles/"
+	sharedInboxPath   = "/api/activitypub/inbox"
+)
+
+// actorKeyBits 用户密钥对的 RSA 位数
+const actorKeyBits = 2048
+
+// ActivityPubService ActivityPub 业务逻辑层，使本站用户可以被联邦网络中的账号关注
+// 收件箱中的活动同步处理，向关注者的投递由后台任务异步完成，失败时按指数退避重试
+type ActivityPubService struct {
+	client                *activitypub.Client `wire:"-"`
+	worker                outbox.Worker       `wire:"-"` // 定期处理到期的投递任务，有新任务时立即唤醒
+	ActivityPubRepository *dal.ActivityPubRepository
+	UserRepository        *userDal.UserRepository
+	ArticleRepository     *blogDal.ArticleRepository
+	CommentRepository     *commentDal.CommentRepository
+	CommentService        *commentBiz.CommentService
+	NotificationService   *notificationBiz.NotificationService
+	Trans                 util.Trans
+}
+
+// Start 启动后台投递任务
+func (s *ActivityPubService) Start(ctx context.Context) {
+	cfg := config.C.ActivityPub
+	s.client = activitypub.NewClient(activitypub.Options{
+		Timeout:           time.Duration(cfg.Timeout) * time.Second,
+		MaxBodySize:       cfg.MaxBodySize,
+		UserAgent:         config.C.General.AppName + "/" + config.C.General.Version,
+		AllowPrivateHosts: cfg.AllowPrivateHosts,
+	})
+	if !cfg.Enabled {
+		return
+	}
+	if config.C.SiteURL() == "" {
+		logging.Context(ctx).Warn("未配置站点地址（blog.share.site_url），ActivityPub 联邦未启用")
+		return
+	}
+
+	s.worker.Start(ctx, time.Duration(cfg.Interval)*time.Second, s.run)
+}
+
+// run 处理到期的投递任务
+func (s *ActivityPubService) run(ctx context.Context, _ bool) {
+	s.processDeliveries(ctx)
+}
+
+// enabled 是否启用联邦，参与者ID需要稳定的站点地址，未配置站点地址时不启用
+func enabled() bool {
+	return config.C.ActivityPub.Enabled && config.C.SiteURL() != ""
+}
+
+// errDisabled 未启用联邦时各接口返回的错误
+func errDisabled() error {
+	return errors.NotFound("未开启 ActivityPub")
+}
+
+// siteHost 站点主机名
+func siteHost() string {
+	u, err := url.Parse(config.C.SiteURL())
+	if err != nil {
+		return ""
+	}
+	return u.Host
+}
+
+// absoluteURL 将站内相对地址（如头像）转换为绝对地址
+func absoluteURL(path string) string {
+	if strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+		return path
+	}
+	return config.C.SiteURL() + "/" + strings.TrimLeft(path, "/")
+}
+
+func actorURL(userID uint) string {
+	return config.C.SiteURL() + actorPathPrefix + strconv.FormatUint(uint64(userID), 10)
+}
+
+func keyID(userID uint) string {
+	return actorURL(userID) + "#main-key"
+}
+
+func followersURL(userID uint) string {
+	return actorURL(userID) + "/followers"
+}
+
+func articleObjectURL(articleID uint) string {
+	return config.C.SiteURL() + articlePathPrefix + strconv.FormatUint(uint64(articleID), 10)
+}
+
+// parseLocalID 解析本站资源 IRI 中的ID，如参与者与文章对象
+func parseLocalID(iri, prefix string) (uint, bool) {
+	rest, ok := strings.CutPrefix(iri, config.C.SiteURL()+prefix)
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(rest, 10, 32)
+	if err != nil || id == 0 {
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// parseArticleIRI 解析指向本站文章的 IRI，支持文章对象地址与前端文章页面地址
+func parseArticleIRI(iri string) (uint, bool) {
+	if id, ok := parseLocalID(iri, articlePathPrefix); ok {
+		return id, true
+	}
+	u, err := url.Parse(iri)
+	if err != nil || !strings.EqualFold(u.Host, siteHost()) {
+		return 0, false
+	}
+	return blogSchema.ParseArticlePath(u.Path)
+}
+
+// retryDelay 第 attempts 次失败后的重试等待时间
+func retryDelay(attempts int) time.Duration {
+	return outbox.Backoff(time.Duration(config.C.ActivityPub.RetryBackoff)*time.Second, attempts)
+}
+
+// lease 任务领取后的保护时间，超过该时间未完成的任务可被重新领取
+func lease() time.Duration {
+	return outbox.Lease(time.Duration(config.C.ActivityPub.Timeout) * time.Second)
+}
+
+// getActorKey 获取用户的密钥对，不存在时生成
+func (s *ActivityPubService) getActorKey(ctx context.Context, userID uint) (*schema.ActorKey, error) {
+	key, err := s.ActivityPubRepository.GetActorKey(ctx, userID)
+	if err == nil {
+		return key, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	privateKey, publicKey, err := activitypub.GenerateKeyPair(actorKeyBits)
+	if err != nil {
+		return nil, err
+	}
+	err = s.ActivityPubRepository.CreateActorKey(ctx, &schema.ActorKey{
+		UserID:     userID,
+		PublicKey:  publicKey,
+		PrivateKey: privateKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 重新读取，并发生成时以先保存的密钥为准
+	return s.ActivityPubRepository.GetActorKey(ctx, userID)
+}
+
+// WebFinger 根据 acct:用户名@主机 或参与者地址查找用户
+func (s *ActivityPubService) WebFinger(ctx context.Context, resource string) (*activitypub.JRD, error) {
+	if !enabled() {
+		return nil, errDisabled()
+	}
+
+	var userID uint
+	if username, host, ok := activitypub.ParseAcct(resource); ok {
+		if !strings.EqualFold(host, siteHost()) {
+			return nil, errors.NotFound("用户不存在")
+		}
+		user, err := s.UserRepository.GetByUsername(ctx, username)
+		if err != nil {
+			return nil, err
+		}
+		userID = user.ID
+	} else if id, ok := parseLocalID(resource, actorPathPrefix); ok {
+		userID = id
+	} else {
+		return nil, errors.BadRequest("无效的资源标识")
+	}
+
+	user, err := s.UserRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &activitypub.JRD{
+		Subject: "acct:" + user.Username + "@" + siteHost(),
+		Aliases: []string{actorURL(user.ID)},
+		Links: []activitypub.JRDLink{
+			{Rel: "self", Type: activitypub.ContentType, Href: actorURL(user.ID)},
+		},
+	}, nil
+}
+
+// GetActor 获取用户对应的参与者
+func (s *ActivityPubService) GetActor(ctx context.Context, userID uint) (*activitypub.Actor, error) {
+	if !enabled() {
+		return nil, errDisabled()
+	}
+
+	user, err := s.UserRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	key, err := s.getActorKey(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	id := actorURL(userID)
+	actor := &activitypub.Actor{
+		Context:           []string{activitypub.ContextActivityStreams, activitypub.ContextSecurity},
+		ID:                id,
+		Type:              "Person",
+		PreferredUsername: user.Username,
+		Name:              user.Username,
+		Summary:           html.EscapeString(user.Bio),
+		URL:               id,
+		Inbox:             id + "/inbox",
+		Outbox:            id + "/outbox",
+		Followers:         followersURL(userID),
+		PublicKey: &activitypub.PublicKey{
+			ID:           keyID(userID),
+			Owner:        id,
+			PublicKeyPem: key.PublicKey,
+		},
+		Endpoints: &activitypub.Endpoints{SharedInbox: config.C.SiteURL() + sharedInboxPath},
+	}
+	if user.Avatar != "" {
+		actor.Icon = &activitypub.Image{Type: "Image", URL: absoluteURL(user.Avatar)}
+	}
+	return actor, nil
+}
+
+// articleObject 将文章转换为 ActivityPub 对象，正文以摘要与原文链接的形式给出
+func articleObject(article *blogSchema.Article) *activitypub.Object {
+	link := blogSchema.ArticleURL(config.C.SiteURL(), article.ID)
+	content := `<p><a href="` + html.EscapeString(link) + `">` + html.EscapeString(link) + `</a></p>`
+	if article.Summary != "" {
+		content = "<p>" + html.EscapeString(article.Summary) + "</p>" + content
+	}
+
+	published := article.CreatedAt.UTC()
+	updated := article.UpdatedAt.UTC()
+	return &activitypub.Object{
+		ID:           articleObjectURL(article.ID),
+		Type:         "Article",
+		AttributedTo: actorURL(article.AuthorID),
+		Name:         article.Title,
+		Content:      content,
+		URL:          link,
+		Published:    &published,
+		Updated:      &updated,
+		To:           []string{activitypub.PublicCollection},
+		Cc:           []string{followersURL(article.AuthorID)},
+	}
+}
+
+// articleActivity 创建文章相关的活动
+func articleActivity(article *blogSchema.Article, activityType string) (*activitypub.Activity, error) {
+	objectID := articleObjectURL(article.ID)
+	var object interface{} = articleObject(article)
+	id := objectID + "#create"
+	switch activityType {
+	case activitypub.ActivityUpdate:
+		id = fmt.Sprintf("%s#update-%d", objectID, time.Now().Unix())
+	case activitypub.ActivityDelete:
+		id = objectID + "#delete"
+		object = map[string]string{"id": objectID, "type": "Tombstone"}
+	}
+
+	activity, err := activitypub.NewActivity(activityType, id, actorURL(article.AuthorID), object)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if activityType == activitypub.ActivityCreate {
+		published := article.CreatedAt.UTC()
+		activity.Published = &published
+	}
+	activity.To = []string{activitypub.PublicCollection}
+	activity.Cc = []string{followersURL(article.AuthorID)}
+	return activity, nil
+}
+
+// GetArticle 获取已发布文章对应的对象
+func (s *ActivityPubService) GetArticle(ctx context.Context, articleID uint) (*activitypub.Object, error) {
+	if !enabled() {
+		return nil, errDisabled()
+	}
+
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if article.Status != blogSchema.ArticleStatusPublished {
+		return nil, errors.NotFound("文章不存在")
+	}
+
+	object := articleObject(article)
+	object.Context = activitypub.ContextActivityStreams
+	return object, nil
+}
+
+// GetOutbox 获取用户的发件箱，包含已发布文章的 Create 活动，page 为 0 时返回集合概要
+func (s *ActivityPubService) GetOutbox(ctx context.Context, userID uint, page int) (*activitypub.OrderedCollection, error) {
+	if !enabled() {
+		return nil, errDisabled()
+	}
+	if _, err := s.UserRepository.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	id := actorURL(userID) + "/outbox"
+	pageSize := config.C.ActivityPub.OutboxPageSize
+	if page == 0 {
+		_, total, err := s.ActivityPubRepository.GetPublishedArticles(ctx, userID, 1, 1)
+		if err != nil {
+			return nil, err
+		}
+		return &activitypub.OrderedCollection{
+			Context:    activitypub.ContextActivityStreams,
+			ID:         id,
+			Type:       "OrderedCollection",
+			TotalItems: total,
+			First:      id + "?page=1",
+		}, nil
+	}
+
+	articles, total, err := s.ActivityPubRepository.GetPublishedArticles(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]interface{}, 0, len(articles))
+	for i := range articles {
+		activity, err := articleActivity(&articles[i], activitypub.ActivityCreate)
+		if err != nil {
+			return nil, err
+		}
+		activity.Context = nil
+		items = append(items, activity)
+	}
+
+	result := &activitypub.OrderedCollection{
+		Context:      activitypub.ContextActivityStreams,
+		ID:           fmt.Sprintf("%s?page=%d", id, page),
+		Type:         "OrderedCollectionPage",
+		TotalItems:   total,
+		PartOf:       id,
+		OrderedItems: items,
+	}
+	if int64(page*pageSize) < total {
+		result.Next = fmt.Sprintf("%s?page=%d", id, page+1)
+	}
+	if page > 1 {
+		result.Prev = fmt.Sprintf("%s?page=%d", id, page-1)
+	}
+	return result, nil
+}
+
+// GetFollowers 获取用户的关注者集合，出于隐私考虑仅公开关注者数量
+func (s *ActivityPubService) GetFollowers(ctx context.Context, userID uint) (*activitypub.OrderedCollection, error) {
+	if !enabled() {
+		return nil, errDisabled()
+	}
+	if _, err := s.UserRepository.GetByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	total, err := s.ActivityPubRepository.CountFollowers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &activitypub.OrderedCollection{
+		Context:    activitypub.ContextActivityStreams,
+		ID:         followersURL(userID),
+		Type:       "OrderedCollection",
+		TotalItems: total,
+	}, nil
+}
+
+// PublishArticle 向作者的关注者投递文章的发布、更新或删除活动，失败不影响文章操作，仅记录日志
+func (s *ActivityPubService) PublishArticle(ctx context.Context, article *blogSchema.Article, activityType string) {
+	if !enabled() {
+		return
+	}
+
+	followers, err := s.ActivityPubRepository.GetFollowers(ctx, article.AuthorID)
+	if err != nil {
+		logging.Context(ctx).Error("获取关注者失败", zap.Error(err), zap.Uint("user_id", article.AuthorID))
+		return
+	}
+	if len(followers) == 0 {
+		return
+	}
+
+	inboxes := make([]string, 0, len(followers))
+	for _, follower := range followers {
+		inboxes = append(inboxes, follower.DeliveryInbox())
+	}
+
+	activity, err := articleActivity(article, activityType)
+	if err == nil {
+		err = s.enqueue(ctx, article.AuthorID, inboxes, activity)
+	}
+	if err != nil {
+		logging.Context(ctx).Error("创建 ActivityPub 投递任务失败", zap.Error(err),
+			zap.Uint("article_id", article.ID), zap.String("type", activityType))
+	}
+}
+
+// DeleteArticleData 删除文章相关的联邦数据（远程点赞）
+func (s *ActivityPubService) DeleteArticleData(ctx context.Context, articleID uint) error {
+	return s.ActivityPubRepository.DeleteRemoteLikesByArticleID(ctx, articleID)
+}
+
+// enqueue 为每个（去重后的）收件箱创建投递任务
+func (s *ActivityPubService) enqueue(ctx context.Context, userID uint, inboxes []string, activity *activitypub.Activity) error {
+	payload, err := json.Marshal(activity)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	now := time.Now()
+	seen := make(map[string]bool, len(inboxes))
+	items := make([]*schema.Delivery, 0, len(inboxes))
+	for _, inbox := range inboxes {
+		if inbox == "" || seen[inbox] {
+			continue
+		}
+		seen[inbox] = true
+		items = append(items, &schema.Delivery{
+			UserID:        userID,
+			Inbox:         inbox,
+			Payload:       string(payload),
+			Status:        schema.DeliveryStatusPending,
+			NextAttemptAt: now,
+		})
+	}
+
+	if err := s.ActivityPubRepository.CreateDeliveries(ctx, items); err != nil {
+		return err
+	}
+	s.worker.Kick()
+	return nil
+}
+
+// processDeliveries 处理到期的投递任务，投递成功的任务直接删除
+func (s *ActivityPubService) processDeliveries(ctx context.Context) {
+	items, err := s.ActivityPubRepository.ClaimDueDeliveries(ctx, config.C.ActivityPub.BatchSize, lease())
+	if err != nil {
+		logging.Context(ctx).Error("领取 ActivityPub 投递任务失败", zap.Error(err))
+		return
+	}
+
+	keys := make(map[uint]*rsa.PrivateKey)
+	for i := range items {
+		item := &items[i]
+		err := s.deliver(ctx, item, keys)
+		if err == nil {
+			if err := s.ActivityPubRepository.DeleteDelivery(ctx, item.ID); err != nil {
+				logging.Context(ctx).Error("删除 ActivityPub 投递任务失败", zap.Error(err), zap.Uint("id", item.ID))
+			}
+			continue
+		}
+
+		item.Attempts++
+		item.LastError = util.Truncate(err.Error(), 500)
+		if item.Attempts >= config.C.ActivityPub.MaxAttempts {
+			item.Status = schema.DeliveryStatusFailed
+		} else {
+			item.NextAttemptAt = time.Now().Add(retryDelay(item.Attempts))
+		}
+		logging.Context(ctx).Warn("投递 ActivityPub 活动失败", zap.Error(err),
+			zap.String("inbox", item.Inbox), zap.Int("attempts", item.Attempts))
+
+		if err := s.ActivityPubRepository.UpdateDelivery(ctx, item); err != nil {
+			logging.Context(ctx).Error("更新 ActivityPub 投递任务失败", zap.Error(err), zap.Uint("id", item.ID))
+		}
+	}
+}
+
+// deliver 使用发送者的私钥签名并投递活动，keys 缓存本轮已加载的私钥
+func (s *ActivityPubService) deliver(ctx context.Context, item *schema.Delivery, keys map[uint]*rsa.PrivateKey) error {
+	key, ok := keys[item.UserID]
+	if !ok {
+		actorKey, err := s.getActorKey(ctx, item.UserID)
+		if err != nil {
+			return err
+		}
+		key, err = activitypub.ParsePrivateKey(actorKey.PrivateKey)
+		if err != nil {
+			return err
+		}
+		keys[item.UserID] = key
+	}
+	return s.client.Deliver(ctx, item.Inbox, []byte(item.Payload), keyID(item.UserID), key)
+}
+
+// HandleInbox 处理收件箱收到的活动，userID 为 0 表示共享收件箱
+// 支持 Follow（自动接受）、Undo（取消关注或点赞）、Like（点赞文章）与 Create（回复文章，作为待审核评论）
+func (s *ActivityPubService) HandleInbox(ctx context.Context, userID uint, req *http.Request, body []byte) error {
+	if !enabled() {
+		return errDisabled()
+	}
+	if userID > 0 {
+		if _, err := s.UserRepository.GetByID(ctx, userID); err != nil {
+			return err
+		}
+	}
+
+	var activity activitypub.Activity
+	if err := json.Unmarshal(body, &activity); err != nil || activity.Type == "" || activity.Actor == "" {
+		return errors.BadRequest("无效的活动")
+	}
+
+	// 远程账号注销时会广播针对自身的 Delete，此时已无法获取其公钥，直接忽略
+	if activity.Type == activitypub.ActivityDelete && activity.ObjectID() == activity.Actor {
+		return nil
+	}
+
+	actor, err := s.verifySignature(ctx, req, body)
+	if err != nil {
+		return err
+	}
+	if actor.ActorID != activity.Actor {
+		return errors.Forbidden("签名者与活动发起者不一致")
+	}
+
+	switch activity.Type {
+	case activitypub.ActivityFollow:
+		return s.handleFollow(ctx, actor, &activity)
+	case activitypub.ActivityUndo:
+		return s.handleUndo(ctx, actor, &activity)
+	case activitypub.ActivityLike:
+		return s.handleLike(ctx, actor, &activity)
+	case activitypub.ActivityCreate:
+		return s.handleCreate(ctx, actor, &activity)
+	}
+	return nil
+}
+
+// verifySignature 验证请求的 HTTP 签名，返回签名者
+// 使用缓存的公钥验证失败时重新获取一次，以应对远程参与者更换密钥
+func (s *ActivityPubService) verifySignature(ctx context.Context, req *http.Request, body []byte) (*schema.RemoteActor, error) {
+	maxSkew := time.Duration(config.C.ActivityPub.MaxClockSkew) * time.Second
+	for _, refresh := range []bool{false, true} {
+		var actor *schema.RemoteActor
+		var fresh bool
+		_, err := activitypub.VerifyRequest(req, body, maxSkew, func(keyID string) (*rsa.PublicKey, error) {
+			var err error
+			actor, fresh, err = s.getRemoteActor(ctx, keyID, refresh)
+			if err != nil {
+				return nil, err
+			}
+			return activitypub.ParsePublicKey(actor.PublicKeyPem)
+		})
+		if err == nil {
+			return actor, nil
+		}
+		if !errors.Is(err, activitypub.ErrInvalidSignature) {
+			return nil, err
+		}
+		if actor == nil || fresh {
+			logging.Context(ctx).Warn("ActivityPub 请求签名无效", zap.Error(err))
+			return nil, errors.Unauthorized("HTTP 签名无效")
+		}
+	}
+	return nil, errors.Unauthorized("HTTP 签名无效")
+}
+
+// getRemoteActor 根据公钥ID获取远程参与者，缓存过期或 refresh 为 true 时重新获取，fresh 表示是否为新获取
+func (s *ActivityPubService) getRemoteActor(ctx context.Context, keyID string, refresh bool) (*schema.RemoteActor, bool, error) {
+	cached, err := s.ActivityPubRepository.GetRemoteActorByKeyID(ctx, keyID)
+	if err == nil && !refresh && time.Since(cached.FetchedAt) < time.Duration(config.C.ActivityPub.ActorCacheTTL)*time.Second {
+		return cached, false, nil
+	} else if err != nil && !errors.IsNotFound(err) {
+		return nil, false, err
+	}
+
+	// 公钥ID通常为参与者地址加片段（如 #main-key）
+	actorIRI, _, _ := strings.Cut(keyID, "#")
+	remote, err := s.client.FetchActor(ctx, actorIRI)
+	if err != nil {
+		logging.Context(ctx).Warn("获取远程参与者失败", zap.Error(err), zap.String("key_id", keyID))
+		return nil, false, errors.Unauthorized("无法获取签名公钥")
+	}
+	if remote.PublicKey == nil || remote.PublicKey.ID != keyID || remote.PublicKey.Owner != remote.ID {
+		return nil, false, errors.Unauthorized("签名公钥与参与者不匹配")
+	}
+	if keyURL, err := url.Parse(keyID); err != nil || !sameHost(remote.ID, keyURL.Host) {
+		return nil, false, errors.Unauthorized("签名公钥与参与者不匹配")
+	}
+	if len(remote.ID) > 255 || len(remote.Inbox) > 500 {
+		return nil, false, errors.BadRequest("远程参与者地址过长")
+	}
+
+	actor := &schema.RemoteActor{
+		ActorID:      remote.ID,
+		KeyID:        keyID,
+		PublicKeyPem: remote.PublicKey.PublicKeyPem,
+		Inbox:        remote.Inbox,
+		Name:         util.Truncate(remote.DisplayName(), 100),
+		FetchedAt:    time.Now(),
+	}
+	if remote.Endpoints != nil && len(remote.Endpoints.SharedInbox) <= 500 {
+		actor.SharedInbox = remote.Endpoints.SharedInbox
+	}
+	if err := s.ActivityPubRepository.SaveRemoteActor(ctx, actor); err != nil {
+		return nil, false, err
+	}
+	return actor, true, nil
+}
+
+// sameHost 判断地址的主机是否为 host
+func sameHost(rawURL, host string) bool {
+	u, err := url.Parse(rawURL)
+	return err == nil && u.Host != "" && strings.EqualFold(u.Host, host)
+}
+
+// handleFollow 处理关注，自动接受并回复 Accept
+func (s *ActivityPubService) handleFollow(ctx context.Context, actor *schema.RemoteActor, activity *activitypub.Activity) error {
+	userID, ok := parseLocalID(activity.ObjectID(), actorPathPrefix)
+	if !ok {
+		return errors.BadRequest("关注的用户不存在")
+	}
+	if _, err := s.UserRepository.GetByID(ctx, userID); err != nil {
+		return err
+	}
+
+	err := s.ActivityPubRepository.SaveFollower(ctx, &schema.Follower{
+		UserID:      userID,
+		ActorID:     actor.ActorID,
+		Inbox:       actor.Inbox,
+		SharedInbox: actor.SharedInbox,
+	})
+	if err != nil {
+		return err
+	}
+	s.NotificationService.Notify(ctx, notificationBiz.Event{
+		Type:       notificationSchema.NotificationTypeFollow,
+		UserID:     userID,
+		ActorName:  remoteActorName(actor),
+		TargetType: notificationSchema.NotificationTargetUser,
+		TargetID:   userID,
+	})
+
+	id := fmt.Sprintf("%s#accepts/follows/%d", actorURL(userID), time.Now().UnixNano())
+	accept, err := activitypub.NewActivity(activitypub.ActivityAccept, id, actorURL(userID), activity)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return s.enqueue(ctx, userID, []string{actor.Inbox}, accept)
+}
+
+// handleUndo 处理撤销关注与撤销点赞
+func (s *ActivityPubService) handleUndo(ctx context.Context, actor *schema.RemoteActor, activity *activitypub.Activity) error {
+	inner, ok := activity.ObjectActivity()
+	if !ok {
+		// 对象仅为 IRI 时无法确定撤销的活动，忽略
+		return nil
+	}
+	if inner.Actor != activity.Actor {
+		return errors.Forbidden("只能撤销自己发起的活动")
+	}
+
+	switch inner.Type {
+	case activitypub.ActivityFollow:
+		if userID, ok := parseLocalID(inner.ObjectID(), actorPathPrefix); ok {
+			return s.ActivityPubRepository.DeleteFollower(ctx, userID, actor.ActorID)
+		}
+	case activitypub.ActivityLike:
+		if articleID, ok := parseArticleIRI(inner.ObjectID()); ok {
+			return s.Trans.Exec(ctx, func(ctx context.Context) error {
+				deleted, err := s.ActivityPubRepository.DeleteRemoteLike(ctx, articleID, actor.ActorID)
+				if err != nil || !deleted {
+					return err
+				}
+				return s.ArticleRepository.IncrementLikeCount(ctx, articleID, -1)
+			})
+		}
+	}
+	return nil
+}
+
+// handleLike 处理对文章的点赞，重复点赞不重复计数
+func (s *ActivityPubService) handleLike(ctx context.Context, actor *schema.RemoteActor, activity *activitypub.Activity) error {
+	articleID, ok := parseArticleIRI(activity.ObjectID())
+	if !ok {
+		return nil
+	}
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return err
+	}
+	if article.Status != blogSchema.ArticleStatusPublished {
+		return errors.NotFound("文章不存在")
+	}
+
+	created := false
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = s.ActivityPubRepository.CreateRemoteLike(ctx, &schema.RemoteLike{
+			ArticleID: articleID,
+			ActorID:   actor.ActorID,
+		})
+		if err != nil || !created {
+			return err
+		}
+		return s.ArticleRepository.IncrementLikeCount(ctx, articleID, 1)
+	})
+	if err != nil || !created {
+		return err
+	}
+
+	s.NotificationService.Notify(ctx, notificationBiz.Event{
+		Type:       notificationSchema.NotificationTypeLike,
+		UserID:     article.AuthorID,
+		ActorName:  remoteActorName(actor),
+		TargetType: notificationSchema.NotificationTargetArticle,
+		TargetID:   article.ID,
+		ArticleID:  article.ID,
+	})
+	return nil
+}
+
+// remoteActorName 远程参与者在通知中的名称，没有展示名称时使用参与者ID
+func remoteActorName(actor *schema.RemoteActor) string {
+	if actor.Name != "" {
+		return actor.Name
+	}
+	return actor.ActorID
+}
+
+// handleCreate 处理对文章的回复，回复作为待审核评论进入评论审核队列，重复投递的回复忽略；
+// 已关闭评论的文章下的回复直接丢弃而不返回错误，避免远程实例反复重投
+func (s *ActivityPubService) handleCreate(ctx context.Context, actor *schema.RemoteActor, activity *activitypub.Activity) error {
+	note, ok := activity.ObjectValue()
+	if !ok || note.InReplyTo == "" {
+		return nil
+	}
+	articleID, ok := parseArticleIRI(note.InReplyTo)
+	if !ok {
+		return nil
+	}
+	if note.AttributedTo != "" && note.AttributedTo != activity.Actor {
+		return errors.Forbidden("回复作者与活动发起者不一致")
+	}
+	if note.ID == "" || len(note.ID) > 500 {
+		return errors.BadRequest("无效的回复对象")
+	}
+	// 回复地址即去重依据，只接受发起者所在实例的地址，避免冒用其他实例的回复
+	if actorURL, err := url.Parse(actor.ActorID); err != nil || !sameHost(note.ID, actorURL.Host) {
+		return errors.Forbidden("回复地址与活动发起者不属于同一实例")
+	}
+
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return err
+	}
+	if article.Status != blogSchema.ArticleStatusPublished {
+		return errors.NotFound("文章不存在")
+	}
+	closed, err := s.CommentService.CommentsClosed(ctx, article)
+	if err != nil || closed {
+		return err
+	}
+
+	_, err = s.CommentRepository.GetBySourceURL(ctx, commentSchema.CommentTypeActivityPub, articleID, note.ID)
+	if err == nil {
+		return nil
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+
+	content := activitypub.HTMLToText(note.Content)
+	if content == "" {
+		return nil
+	}
+	_, err = s.CommentService.CreateComment(ctx, 0, &commentSchema.CreateCommentRequest{
+		Content:      content,
+		ArticleID:    articleID,
+		Type:         commentSchema.CommentTypeActivityPub,
+		SourceURL:    note.ID,
+		SourceAuthor: actor.Name,
+	})
+	if errors.IsConflict(err) {
+		// 同一回复的并发投递已由其他请求保存
+		return nil
+	}
+	return err
+}
+
+// Release 停止后台任务
+func (s *ActivityPubService) Release(ctx context.Context) error {
+	s.worker.Stop()
+	return nil
+}
@@ -0,0 +1,192 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/schema"
+	blogSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetActorKeyDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.ActorKey{})
+}
+
+func GetFollowerDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.Follower{})
+}
+
+func GetRemoteActorDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.RemoteActor{})
+}
+
+func GetRemoteLikeDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.RemoteLike{})
+}
+
+func GetDeliveryDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.Delivery{})
+}
+
+// ActivityPubRepository ActivityPub 数据访问层
+type ActivityPubRepository struct {
+	DB *gorm.DB
+}
+
+// GetActorKey 获取用户的密钥对
+func (r *ActivityPubRepository) GetActorKey(ctx context.Context, userID uint) (*schema.ActorKey, error) {
+	var key schema.ActorKey
+	if err := GetActorKeyDB(ctx, r.DB).Where("user_id = ?", userID).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("密钥不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &key, nil
+}
+
+// CreateActorKey 保存用户的密钥对，已存在时忽略
+func (r *ActivityPubRepository) CreateActorKey(ctx context.Context, key *schema.ActorKey) error {
+	result := GetActorKeyDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(key)
+	return errors.WithStack(result.Error)
+}
+
+// SaveFollower 保存关注者，已存在时更新收件箱地址
+func (r *ActivityPubRepository) SaveFollower(ctx context.Context, follower *schema.Follower) error {
+	result := GetFollowerDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "actor_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"inbox", "shared_inbox"}),
+	}).Create(follower)
+	return errors.WithStack(result.Error)
+}
+
+// DeleteFollower 删除关注者
+func (r *ActivityPubRepository) DeleteFollower(ctx context.Context, userID uint, actorID string) error {
+	result := GetFollowerDB(ctx, r.DB).Where("user_id = ? AND actor_id = ?", userID, actorID).Delete(&schema.Follower{})
+	return errors.WithStack(result.Error)
+}
+
+// GetFollowers 获取用户的所有关注者
+func (r *ActivityPubRepository) GetFollowers(ctx context.Context, userID uint) ([]schema.Follower, error) {
+	var followers []schema.Follower
+	if err := GetFollowerDB(ctx, r.DB).Where("user_id = ?", userID).Find(&followers).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return followers, nil
+}
+
+// CountFollowers 统计用户的关注者数量
+func (r *ActivityPubRepository) CountFollowers(ctx context.Context, userID uint) (int64, error) {
+	var count int64
+	if err := GetFollowerDB(ctx, r.DB).Where("user_id = ?", userID).Count(&count).Error; err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return count, nil
+}
+
+// GetRemoteActorByKeyID 根据公钥ID获取缓存的远程参与者
+func (r *ActivityPubRepository) GetRemoteActorByKeyID(ctx context.Context, keyID string) (*schema.RemoteActor, error) {
+	var actor schema.RemoteActor
+	if err := GetRemoteActorDB(ctx, r.DB).Where("key_id = ?", keyID).First(&actor).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("远程参与者不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &actor, nil
+}
+
+// SaveRemoteActor 保存远程参与者，已存在时更新
+func (r *ActivityPubRepository) SaveRemoteActor(ctx context.Context, actor *schema.RemoteActor) error {
+	result := GetRemoteActorDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "actor_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"key_id", "public_key_pem", "inbox", "shared_inbox", "name", "fetched_at"}),
+	}).Create(actor)
+	return errors.WithStack(result.Error)
+}
+
+// CreateRemoteLike 记录远程点赞，返回是否为新增记录
+func (r *ActivityPubRepository) CreateRemoteLike(ctx context.Context, like *schema.RemoteLike) (bool, error) {
+	result := GetRemoteLikeDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(like)
+	if result.Error != nil {
+		return false, errors.WithStack(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
+
+// DeleteRemoteLike 删除远程点赞，返回是否删除了记录
+func (r *ActivityPubRepository) DeleteRemoteLike(ctx context.Context, articleID uint, actorID string) (bool, error) {
+	result := GetRemoteLikeDB(ctx, r.DB).Where("article_id = ? AND actor_id = ?", articleID, actorID).Delete(&schema.RemoteLike{})
+	if result.Error != nil {
+		return false, errors.WithStack(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteRemoteLikesByArticleID 删除文章的所有远程点赞
+func (r *ActivityPubRepository) DeleteRemoteLikesByArticleID(ctx context.Context, articleID uint) error {
+	result := GetRemoteLikeDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.RemoteLike{})
+	return errors.WithStack(result.Error)
+}
+
+// CreateDeliveries 创建投递任务
+func (r *ActivityPubRepository) CreateDeliveries(ctx context.Context, items []*schema.Delivery) error {
+	if len(items) == 0 {
+		return nil
+	}
+	result := GetDeliveryDB(ctx, r.DB).Create(items)
+	return errors.WithStack(result.Error)
+}
+
+// ClaimDueDeliveries 领取到期的投递任务，领取后任务在 lease 时间内不会被其他实例重复领取
+func (r *ActivityPubRepository) ClaimDueDeliveries(ctx context.Context, limit int, lease time.Duration) ([]schema.Delivery, error) {
+	var items []schema.Delivery
+	now := time.Now()
+	err := GetDeliveryDB(ctx, r.DB).
+		Where("status = ? AND next_attempt_at <= ?", schema.DeliveryStatusPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return outbox.Claim(func() *gorm.DB { return GetDeliveryDB(ctx, r.DB) }, items, "next_attempt_at", now.Add(lease),
+		func(item *schema.Delivery) (uint, *time.Time) { return item.ID, &item.NextAttemptAt })
+}
+
+// UpdateDelivery 更新投递任务
+func (r *ActivityPubRepository) UpdateDelivery(ctx context.Context, item *schema.Delivery) error {
+	result := GetDeliveryDB(ctx, r.DB).Where("id = ?", item.ID).Select("*").Omit("created_at").Updates(item)
+	return errors.WithStack(result.Error)
+}
+
+// DeleteDelivery 删除投递任务
+func (r *ActivityPubRepository) DeleteDelivery(ctx context.Context, id uint) error {
+	result := GetDeliveryDB(ctx, r.DB).Where("id = ?", id).Delete(&schema.Delivery{})
+	return errors.WithStack(result.Error)
+}
+
+// GetPublishedArticles 按发布时间倒序分页获取用户已发布的文章
+func (r *ActivityPubRepository) GetPublishedArticles(ctx context.Context, userID uint, page, pageSize int) ([]blogSchema.Article, int64, error) {
+	db := util.GetDB(ctx, r.DB).Model(&blogSchema.Article{}).
+		Where("author_id = ? AND status = ?", userID, blogSchema.ArticleStatusPublished)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	var articles []blogSchema.Article
+	err := db.Order("created_at DESC").Offset((page - 1) * pageSize).Limit(pageSize).Find(&articles).Error
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+	return articles, total, nil
+}
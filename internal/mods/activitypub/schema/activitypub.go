@@ -0,0 +1,108 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 投递任务状态常量
+const (
+	DeliveryStatusPending = "pending" // 等待投递（包括等待重试）
+	DeliveryStatusFailed  = "failed"  // 多次尝试后仍投递失败
+)
+
+// ActorKey 用户（参与者）用于 HTTP 签名的密钥对，首次使用时生成
+type ActorKey struct {
+	UserID     uint      `json:"user_id" gorm:"primaryKey;autoIncrement:false;comment:用户ID"`
+	PublicKey  string    `json:"public_key" gorm:"type:text;not null;comment:PEM编码的公钥"`
+	PrivateKey string    `json:"-" gorm:"type:text;not null;comment:PEM编码的私钥"`
+	CreatedAt  time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *ActorKey) TableName() string {
+	return config.C.FormatTableName("activitypub_actor_key")
+}
+
+// Follower 关注本站用户的远程参与者
+type Follower struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	UserID      uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_user_actor;comment:被关注的用户ID"`
+	ActorID     string    `json:"actor_id" gorm:"size:255;not null;uniqueIndex:idx_user_actor;comment:远程参与者ID"`
+	Inbox       string    `json:"inbox" gorm:"size:500;not null;comment:远程参与者收件箱"`
+	SharedInbox string    `json:"shared_inbox" gorm:"size:500;comment:远程实例共享收件箱"`
+	CreatedAt   time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *Follower) TableName() string {
+	return config.C.FormatTableName("activitypub_follower")
+}
+
+// DeliveryInbox 投递使用的收件箱，优先使用共享收件箱以减少同一实例的重复投递
+func (a *Follower) DeliveryInbox() string {
+	if a.SharedInbox != "" {
+		return a.SharedInbox
+	}
+	return a.Inbox
+}
+
+// RemoteActor 远程参与者缓存，用于验证 HTTP 签名与展示回复作者
+type RemoteActor struct {
+	ID           uint      `json:"id" gorm:"primaryKey"`
+	ActorID      string    `json:"actor_id" gorm:"size:255;not null;uniqueIndex;comment:远程参与者ID"`
+	KeyID        string    `json:"key_id" gorm:"size:255;index;comment:公钥ID"`
+	PublicKeyPem string    `json:"public_key_pem" gorm:"type:text;comment:PEM编码的公钥"`
+	Inbox        string    `json:"inbox" gorm:"size:500;comment:收件箱"`
+	SharedInbox  string    `json:"shared_inbox" gorm:"size:500;comment:共享收件箱"`
+	Name         string    `json:"name" gorm:"size:100;comment:展示名称"`
+	FetchedAt    time.Time `json:"fetched_at" gorm:"comment:获取时间"`
+}
+
+// TableName 表名
+func (a *RemoteActor) TableName() string {
+	return config.C.FormatTableName("activitypub_remote_actor")
+}
+
+// RemoteLike 远程参与者对文章的点赞
+type RemoteLike struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	ArticleID uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_article_actor;comment:文章ID"`
+	ActorID   string    `json:"actor_id" gorm:"size:255;not null;uniqueIndex:idx_article_actor;comment:远程参与者ID"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *RemoteLike) TableName() string {
+	return config.C.FormatTableName("activitypub_remote_like")
+}
+
+// Delivery 待投递的活动，投递成功后删除
+type Delivery struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	UserID        uint      `json:"user_id" gorm:"not null;index;comment:发送活动的用户ID"`
+	Inbox         string    `json:"inbox" gorm:"size:500;not null;comment:目标收件箱"`
+	Payload       string    `json:"payload" gorm:"type:mediumtext;not null;comment:活动JSON"`
+	Status        string    `json:"status" gorm:"size:20;not null;index:idx_status_next;comment:投递状态"`
+	Attempts      int       `json:"attempts" gorm:"not null;default:0;comment:已尝试次数"`
+	NextAttemptAt time.Time `json:"next_attempt_at" gorm:"index:idx_status_next;comment:下次尝试时间"`
+	LastError     string    `json:"last_error" gorm:"size:500;comment:最近一次失败原因"`
+	CreatedAt     time.Time `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *Delivery) TableName() string {
+	return config.C.FormatTableName("activitypub_delivery")
+}
+
+// CollectionPageRequest 集合分页请求，不传页码时返回集合概要
+type CollectionPageRequest struct {
+	Page int `form:"page" binding:"omitempty,min=1"`
+}
+
+// WebFingerRequest WebFinger 查询请求
+type WebFingerRequest struct {
+	Resource string `form:"resource" binding:"required"`
+}
@@ -14,9 +14,10 @@ import (
 
 // AI AI模块
 type AI struct {
-	DB               *gorm.DB
-	ModelHandler     *api.ModelHandler
-	AssistantHandler *api.AssistantHandler
+	DB                *gorm.DB
+	ModelHandler      *api.ModelHandler
+	AssistantHandler  *api.AssistantHandler
+	ModerationHandler *api.ModerationHandler
 }
 
 // Set 注入 AI 模块
@@ -32,13 +33,18 @@ var Set = wire.NewSet(
 	wire.Struct(new(api.ModelHandler), "*"),
 	wire.Struct(new(biz.ModelService), "*"),
 
+	// AI审核相关结构体
+	wire.Struct(new(api.ModerationHandler), "*"),
+	wire.Struct(new(biz.ModerationService), "*"),
+	wire.Struct(new(dal.ModerationRepository), "*"),
+
 	// 公共结构体
 	wire.Struct(new(dal.ModelRepository), "*"),
 )
 
 // AutoMigrate 自动迁移数据库
 func (a *AI) AutoMigrate(ctx context.Context) error {
-	return a.DB.AutoMigrate(new(schema.Model))
+	return a.DB.AutoMigrate(new(schema.Model), new(schema.ModerationLog))
 }
 
 // Init 初始化 AI 模块
@@ -85,6 +91,9 @@ func (a *AI) Init(ctx context.Context) error {
 	// 启动定时权重更新任务
 	a.AssistantHandler.AssistantService.Selector.AutoWeightUpdate(ctx)
 
+	// 启动评论 AI 审核任务
+	a.ModerationHandler.ModerationService.Start(ctx)
+
 	return nil
 }
 
@@ -110,11 +119,19 @@ func (a *AI) RegisterRouters(ctx context.Context, ai *gin.RouterGroup) error {
 		ai.GET("/models/overview", a.ModelHandler.GetOverviewStats)
 	}
 
+	// AI审核接口
+	{
+		ai.GET("/moderation/logs", a.ModerationHandler.GetLogs)
+	}
+
 	return nil
 }
 
 // Release 释放资源
 func (a *AI) Release(ctx context.Context) error {
+	if err := a.ModerationHandler.ModerationService.Release(ctx); err != nil {
+		return err
+	}
 	if err := a.AssistantHandler.AssistantService.Selector.Release(ctx); err != nil {
 		return err
 	}
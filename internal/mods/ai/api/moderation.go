@@ -0,0 +1,44 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/ai/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/ai/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// ModerationHandler AI 审核控制器
+type ModerationHandler struct {
+	ModerationService *biz.ModerationService
+}
+
+// GetLogs 获取 AI 审核记录
+// @Tags AIAPI
+// @Security ApiKeyAuth
+// @Summary 分页获取 AI 审核评论的记录，包括审核建议与自动审核动作（仅管理员可用）
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "每页容量" minimum(1) maximum(100) default(10)
+// @Param comment_id query uint false "评论ID"
+// @Param action query string false "执行的动作" Enums(none, approved, rejected, failed)
+// @Success 200 {object} util.ResponseResult{data=schema.ModerationLogPaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/ai/moderation/logs [get]
+func (h *ModerationHandler) GetLogs(c *gin.Context) {
+	var params schema.ModerationLogQueryParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := logging.NewTag(c.Request.Context(), logging.TagKeyAI)
+	data, err := h.ModerationService.GetLogs(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
@@ -97,13 +97,7 @@ func (a *AssistantService) Process(ctx context.Context, taskType TaskType, req *
 	}
 
 	// 注册对应的LLM客户端
-	client, err := ai.NewClient(
-		ai.SetProvider(model.Provider),
-		ai.SetAPIKey(model.APIKey),
-		ai.SetEndpoint(model.Endpoint),
-		ai.SetModel(model.ModelName),
-		ai.SetTemperature(model.Temperature),
-		ai.SetTimeout(model.Timeout))
+	client, err := newClient(model)
 	if err != nil {
 		callback(false, 0)
 		logging.Context(ctx).Error("注册模型对应客户端失败", zap.Error(err), zap.Uint("model_id", model.ID))
@@ -146,6 +140,38 @@ func (a *AssistantService) Process(ctx context.Context, taskType TaskType, req *
 	}
 }
 
+// Call 选择模型同步调用，返回所用模型、模型输出与耗时，供后台任务使用
+// 模型的调用结果会计入选择器的统计
+func (a *AssistantService) Call(ctx context.Context, prompt string) (*schema.Model, string, time.Duration, error) {
+	model, callback, err := a.Selector.SelectModel(ctx)
+	if err != nil {
+		return nil, "", 0, err
+	}
+
+	client, err := newClient(model)
+	if err != nil {
+		callback(false, 0)
+		return model, "", 0, err
+	}
+
+	startTime := time.Now()
+	resp, err := client.Call(ctx, prompt)
+	latency := time.Since(startTime)
+	callback(err == nil, latency)
+	return model, resp, latency, err
+}
+
+// newClient 注册模型对应的LLM客户端
+func newClient(model *schema.Model) (ai.LLMClient, error) {
+	return ai.NewClient(
+		ai.SetProvider(model.Provider),
+		ai.SetAPIKey(model.APIKey),
+		ai.SetEndpoint(model.Endpoint),
+		ai.SetModel(model.ModelName),
+		ai.SetTemperature(model.Temperature),
+		ai.SetTimeout(model.Timeout))
+}
+
 // parseResp 解析同步调用得到的字符串结果（标题、标签）
 func (a *AssistantService) parseResp(ctx context.Context, taskType TaskType, resp string) []string {
 	respList := strings.Split(resp, ",")
@@ -0,0 +1,68 @@
+package dal
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/ai/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// GetModerationLogDB 获取 AI 审核记录数据库实例
+func GetModerationLogDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.ModerationLog{})
+}
+
+// ModerationRepository AI 审核记录数据访问层
+type ModerationRepository struct {
+	DB *gorm.DB
+}
+
+// CreateLog 记录一次 AI 审核
+func (r *ModerationRepository) CreateLog(ctx context.Context, log *schema.ModerationLog) error {
+	result := GetModerationLogDB(ctx, r.DB).Create(log)
+	return errors.WithStack(result.Error)
+}
+
+// ListLogs 分页获取 AI 审核记录，按创建时间倒序
+func (r *ModerationRepository) ListLogs(ctx context.Context, params *schema.ModerationLogQueryParams) (*schema.ModerationLogPaginationResult, error) {
+	var result schema.ModerationLogPaginationResult
+
+	// 默认值
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+
+	db := GetModerationLogDB(ctx, r.DB)
+	if params.CommentID > 0 {
+		db = db.Where("comment_id = ?", params.CommentID)
+	}
+	if params.Action != "" {
+		db = db.Where("action = ?", params.Action)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]schema.ModerationLog, 0)
+	if total > 0 {
+		offset := (params.Page - 1) * params.PageSize
+		if err := db.Order("id DESC").Offset(offset).Limit(params.PageSize).Find(&items).Error; err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = params.Page
+	result.PageSize = params.PageSize
+	result.TotalPages = int((total + int64(params.PageSize) - 1) / int64(params.PageSize))
+	return &result, nil
+}
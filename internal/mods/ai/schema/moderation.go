@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// AI 审核动作常量
+const (
+	ModerationActionNone     = "none"     // 仅给出建议，等待人工审核
+	ModerationActionApproved = "approved" // 自动通过
+	ModerationActionRejected = "rejected" // 自动拒绝
+	ModerationActionFailed   = "failed"   // 调用模型或解析结果失败
+)
+
+// ModerationLog AI 审核记录，每次调用模型审核评论都会记录一条，用于审计
+type ModerationLog struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`                                        // 主键
+	CommentID  uint      `json:"comment_id" gorm:"not null;index;comment:评论ID"`               // 评论ID
+	ModelID    uint      `json:"model_id" gorm:"not null;default:0;comment:模型ID"`             // 模型ID
+	ModelName  string    `json:"model_name" gorm:"type:varchar(64);comment:模型名称"`             // 模型名称
+	Category   string    `json:"category" gorm:"type:varchar(20);comment:审核类别"`               // 审核类别
+	Confidence float64   `json:"confidence" gorm:"type:decimal(4,3);default:0;comment:置信度"`   // 置信度
+	Reason     string    `json:"reason" gorm:"type:varchar(500);comment:审核理由"`                // 审核理由
+	Suggestion string    `json:"suggestion" gorm:"type:varchar(20);comment:审核建议"`             // 审核建议
+	Action     string    `json:"action" gorm:"type:varchar(20);not null;index;comment:执行的动作"` // 执行的动作
+	Error      string    `json:"error" gorm:"type:varchar(500);comment:失败原因"`                 // 失败原因
+	Latency    int64     `json:"latency" gorm:"default:0;comment:模型响应耗时(毫秒)"`                 // 模型响应耗时（毫秒）
+	CreatedAt  time.Time `json:"created_at" gorm:"autoCreateTime;index"`                      // 创建时间
+}
+
+func (a *ModerationLog) TableName() string {
+	return config.C.FormatTableName("ai_moderation_log")
+}
+
+// ModerationLogQueryParams AI 审核记录查询参数
+type ModerationLogQueryParams struct {
+	Page      int    `json:"page" form:"page" binding:"omitempty,min=1"`
+	PageSize  int    `json:"page_size" form:"page_size" binding:"omitempty,min=1,max=100"`
+	CommentID uint   `json:"comment_id" form:"comment_id"`
+	Action    string `json:"action" form:"action" binding:"omitempty,oneof=none approved rejected failed"`
+}
+
+// ModerationLogPaginationResult AI 审核记录分页结果
+type ModerationLogPaginationResult struct {
+	Items      []ModerationLog `json:"items"`
+	Total      int64           `json:"total"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"page_size"`
+	TotalPages int             `json:"total_pages"`
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/codeExpert666/goinkblog-backend/internal/config"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/auth/schema"
+	sensitiveBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/biz"
 	"github.com/codeExpert666/goinkblog-backend/pkg/cachex"
 	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
 	"github.com/codeExpert666/goinkblog-backend/pkg/jwtx"
@@ -21,11 +22,16 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// RegisterHook 用户注册成功后的回调，供其他模块处理与新用户相关的数据
+type RegisterHook func(ctx context.Context, user *schema.User)
+
 // AuthService 认证业务逻辑层
 type AuthService struct {
-	UserRepository *dal.UserRepository
-	Auth           jwtx.Auther
-	Cache          cachex.Cacher
+	registerHooks   []RegisterHook `wire:"-"`
+	UserRepository  *dal.UserRepository
+	Auth            jwtx.Auther
+	Cache           cachex.Cacher
+	SensitiveFilter *sensitiveBiz.SensitiveFilter
 }
 
 // ParseUserID 解析用户ID（中间件使用）
@@ -124,6 +130,16 @@ func (s *AuthService) ResponseCaptcha(ctx context.Context, w http.ResponseWriter
 	return nil
 }
 
+// VerifyCaptcha 校验验证码，验证码无论校验成功与否均失效
+func (s *AuthService) VerifyCaptcha(id, answer string) bool {
+	return captcha.VerifyString(id, answer)
+}
+
+// OnRegister 注册用户注册成功后的回调，需在模块初始化时调用
+func (s *AuthService) OnRegister(hook RegisterHook) {
+	s.registerHooks = append(s.registerHooks, hook)
+}
+
 func (s *AuthService) genUserToken(ctx context.Context, userID uint) (*schema.LoginToken, error) {
 	// 生成JWT令牌
 	token, err := s.Auth.GenerateToken(ctx, userID)
@@ -146,6 +162,11 @@ func (s *AuthService) genUserToken(ctx context.Context, userID uint) (*schema.Lo
 
 // Register 用户注册
 func (s *AuthService) Register(ctx context.Context, req *schema.RegisterRequest) (*schema.LoginResponse, error) {
+	// 用户名不允许包含任何敏感词
+	if err := s.checkUsername(ctx, req.Username); err != nil {
+		return nil, err
+	}
+
 	// 加密密码
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
 	if err != nil {
@@ -163,6 +184,9 @@ func (s *AuthService) Register(ctx context.Context, req *schema.RegisterRequest)
 	if err := s.UserRepository.Create(ctx, user); err != nil {
 		return nil, err
 	}
+	for _, hook := range s.registerHooks {
+		hook(ctx, user)
+	}
 
 	// 生成用户访问令牌
 	token, err := s.genUserToken(ctx, user.ID)
@@ -188,10 +212,18 @@ func (s *AuthService) Register(ctx context.Context, req *schema.RegisterRequest)
 	return response, nil
 }
 
+// checkUsername 检查用户名是否包含敏感词，用户名不做掩码处理，命中任何敏感词均拒绝
+func (s *AuthService) checkUsername(ctx context.Context, username string) error {
+	if result := s.SensitiveFilter.Check(ctx, username); len(result.Hits) > 0 {
+		return errors.BadRequest("用户名包含敏感词，请更换")
+	}
+	return nil
+}
+
 // Login 用户登录
 func (s *AuthService) Login(ctx context.Context, req *schema.LoginRequest) (*schema.LoginResponse, error) {
 	// 验证验证码
-	if !captcha.VerifyString(req.CaptchaID, req.Captcha) {
+	if !s.VerifyCaptcha(req.CaptchaID, req.Captcha) {
 		return nil, errors.BadRequest("验证码错误")
 	}
 
@@ -308,6 +340,9 @@ func (s *AuthService) UpdateProfile(ctx context.Context, req *schema.UpdateProfi
 
 	// 更新其他资料
 	if req.Username != "" && req.Username != user.Username {
+		if err := s.checkUsername(ctx, req.Username); err != nil {
+			return nil, err
+		}
 		user.Username = req.Username
 	}
 
@@ -316,7 +351,14 @@ func (s *AuthService) UpdateProfile(ctx context.Context, req *schema.UpdateProfi
 	}
 
 	if req.Bio != "" {
-		user.Bio = req.Bio
+		// 个人简介无人工审核流程，命中需审核的敏感词时同样拒绝
+		bio, review, err := s.SensitiveFilter.Screen(ctx, "个人简介", req.Bio)
+		if err != nil {
+			return nil, err
+		} else if review {
+			return nil, errors.BadRequest("个人简介包含敏感内容，请修改后重试")
+		}
+		user.Bio = bio
 	}
 
 	if req.Avatar != "" {
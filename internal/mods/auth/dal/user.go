@@ -115,3 +115,13 @@ func (r *UserRepository) GetAll(ctx context.Context, page, pageSize int) ([]sche
 	result := db.Offset(offset).Limit(pageSize).Find(&users)
 	return users, total, errors.WithStack(result.Error)
 }
+
+// GetByUsernames 批量获取指定用户名的用户，仅返回ID与用户名
+func (r *UserRepository) GetByUsernames(ctx context.Context, usernames []string) ([]schema.User, error) {
+	var users []schema.User
+	if len(usernames) == 0 {
+		return users, nil
+	}
+	result := GetUserDB(ctx, r.DB).Select("id, username").Where("username IN ?", usernames).Find(&users)
+	return users, errors.WithStack(result.Error)
+}
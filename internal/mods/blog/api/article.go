@@ -26,7 +26,7 @@ type ArticleHandler struct {
 // @Param category_ids query []uint false "分类ID列表（可多选）" collectionFormat(multi) minimum(1)
 // @Param tag_ids query []uint false "标签ID列表（可多选）" collectionFormat(multi) minimum(1)
 // @Param author query string false "作者名称（current 表示当前用户）"
-// @Param status query string false "状态" Enums(published, draft)
+// @Param status query string false "状态（仅在 author=current 时可查询已发布以外的状态，其他情况只返回已发布的文章）" Enums(published, draft, pending, rejected, changes_requested)
 // @Param sort_by query string false "排序依据" Enums(newest, views, likes, favorites, comments) default(newest)
 // @Param keyword query string false "搜索关键词"
 // @Param time_range query string false "创建时间范围" Enums(today, week, month, year, all) default(all)
@@ -365,6 +365,97 @@ func (h *ArticleHandler) GetUserViewHistory(c *gin.Context) {
 	util.ResSuccess(c, data)
 }
 
+// @Tags ArticleAPI
+// @Security ApiKeyAuth
+// @Summary 上报文章阅读进度
+// @Param id path uint true "文章ID"
+// @Param body body schema.ReadingProgressRequest true "阅读进度"
+// @Success 200 {object} util.ResponseResult{data=schema.ReadingProgressResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/articles/{id}/progress [post]
+func (h *ArticleHandler) SaveReadingProgress(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	var req schema.ReadingProgressRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.ArticleService.SaveReadingProgress(ctx, userID, uint(id), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags ArticleAPI
+// @Security ApiKeyAuth
+// @Summary 获取文章阅读进度
+// @Param id path uint true "文章ID"
+// @Success 200 {object} util.ResponseResult{data=schema.ReadingProgressResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/articles/{id}/progress [get]
+func (h *ArticleHandler) GetReadingProgress(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.ArticleService.GetReadingProgress(ctx, userID, uint(id))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags ArticleAPI
+// @Security ApiKeyAuth
+// @Summary 获取继续阅读列表（未读完的文章）
+// @Param page query int false "页数" minimum(1) default(1)
+// @Param page_size query int false "页容量" minimum(1) maximum(100) default(10)
+// @Success 200 {object} util.ResponseResult{data=schema.ArticlePaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/articles/continue [get]
+func (h *ArticleHandler) GetContinueReading(c *gin.Context) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页码"))
+		return
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页容量"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.ArticleService.GetContinueReading(ctx, userID, page, pageSize)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
 // @Tags ArticleAPI
 // @Security ApiKeyAuth
 // @Summary 获取用户评论过的文章
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// @Tags ArticleAPI
+// @Security ApiKeyAuth
+// @Summary 获取疑似重复文章报告（仅管理员可用）
+// @Param article_id query uint false "被检测的文章ID"
+// @Param min_similarity query number false "最低相似度" minimum(0) maximum(1)
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "页容量" minimum(1) maximum(100) default(10)
+// @Success 200 {object} util.ResponseResult{data=schema.ArticleDuplicatePaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/articles/duplicates [get]
+func (h *ArticleHandler) GetDuplicateReport(c *gin.Context) {
+	var params schema.ArticleDuplicateListRequest
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.ArticleService.GetDuplicateReport(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
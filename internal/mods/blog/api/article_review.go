@@ -0,0 +1,103 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// @Tags ArticleAPI
+// @Security ApiKeyAuth
+// @Summary 获取文章审核队列（仅审核人员可用）
+// @Param status query string false "文章状态，默认为待审核" Enums(pending, rejected, changes_requested) default(pending)
+// @Param author_id query uint false "作者ID"
+// @Param keyword query string false "标题关键词"
+// @Param sort_order query string false "按提交时间排序" Enums(asc, desc) default(asc)
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "页容量" minimum(1) maximum(100) default(10)
+// @Success 200 {object} util.ResponseResult{data=schema.ArticlePaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/articles/review [get]
+func (h *ArticleHandler) GetArticlesForReview(c *gin.Context) {
+	var params schema.ArticleReviewListRequest
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.ArticleService.GetArticlesForReview(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags ArticleAPI
+// @Security ApiKeyAuth
+// @Summary 审核文章（仅审核人员可用）
+// @Param id path uint true "文章ID"
+// @Param body body schema.ReviewArticleRequest true "审核信息"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/articles/{id}/review [post]
+func (h *ArticleHandler) ReviewArticle(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	var req schema.ReviewArticleRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.ArticleService.ReviewArticle(ctx, userID, uint(id), &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags ArticleAPI
+// @Security ApiKeyAuth
+// @Summary 获取文章审核历史（仅作者与审核人员可用）
+// @Param id path uint true "文章ID"
+// @Success 200 {object} util.ResponseResult{data=[]schema.ArticleReviewResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/articles/{id}/reviews [get]
+func (h *ArticleHandler) GetArticleReviews(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.ArticleService.GetArticleReviews(ctx, userID, uint(id))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
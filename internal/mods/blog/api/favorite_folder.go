@@ -0,0 +1,282 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// FavoriteFolderHandler 收藏夹API处理器
+type FavoriteFolderHandler struct {
+	FavoriteFolderService *biz.FavoriteFolderService
+}
+
+// @Tags FavoriteFolderAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户的收藏夹列表
+// @Success 200 {object} util.ResponseResult{data=[]schema.FavoriteFolderResponse}
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders [get]
+func (h *FavoriteFolderHandler) ListFolders(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.FavoriteFolderService.ListFolders(ctx, userID)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags FavoriteFolderAPI
+// @Summary 获取指定用户的收藏夹列表（非本人仅返回公开收藏夹）
+// @Param user_id path uint true "用户ID"
+// @Success 200 {object} util.ResponseResult{data=[]schema.FavoriteFolderResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders/user/{user_id} [get]
+func (h *FavoriteFolderHandler) ListUserFolders(c *gin.Context) {
+	ownerID, err := strconv.ParseUint(c.Param("user_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的用户ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.FavoriteFolderService.ListUserFolders(ctx, userID, uint(ownerID))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags FavoriteFolderAPI
+// @Security ApiKeyAuth
+// @Summary 创建收藏夹
+// @Param body body schema.CreateFavoriteFolderRequest true "收藏夹信息"
+// @Success 200 {object} util.ResponseResult{data=schema.FavoriteFolderResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders [post]
+func (h *FavoriteFolderHandler) CreateFolder(c *gin.Context) {
+	var req schema.CreateFavoriteFolderRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.FavoriteFolderService.CreateFolder(ctx, userID, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags FavoriteFolderAPI
+// @Security ApiKeyAuth
+// @Summary 更新收藏夹
+// @Param id path uint true "收藏夹ID"
+// @Param body body schema.UpdateFavoriteFolderRequest true "收藏夹信息"
+// @Success 200 {object} util.ResponseResult{data=schema.FavoriteFolderResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders/{id} [put]
+func (h *FavoriteFolderHandler) UpdateFolder(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的收藏夹ID"))
+		return
+	}
+
+	var req schema.UpdateFavoriteFolderRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.FavoriteFolderService.UpdateFolder(ctx, userID, uint(id), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags FavoriteFolderAPI
+// @Security ApiKeyAuth
+// @Summary 删除收藏夹（其中的收藏移回默认收藏夹）
+// @Param id path uint true "收藏夹ID"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders/{id} [delete]
+func (h *FavoriteFolderHandler) DeleteFolder(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的收藏夹ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.FavoriteFolderService.DeleteFolder(ctx, userID, uint(id)); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags FavoriteFolderAPI
+// @Summary 获取收藏夹中的文章（私有收藏夹仅所有者可见）
+// @Param id path uint true "收藏夹ID"
+// @Param page query int false "页数" minimum(1) default(1)
+// @Param page_size query int false "页容量" minimum(1) maximum(100) default(10)
+// @Success 200 {object} util.ResponseResult{data=schema.ArticlePaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders/{id}/articles [get]
+func (h *FavoriteFolderHandler) GetFolderArticles(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的收藏夹ID"))
+		return
+	}
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页码"))
+		return
+	}
+	pageSize, err := strconv.Atoi(c.DefaultQuery("page_size", "10"))
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页容量"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.FavoriteFolderService.GetFolderArticles(ctx, userID, uint(id), page, pageSize)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags FavoriteFolderAPI
+// @Security ApiKeyAuth
+// @Summary 收藏文章到指定收藏夹
+// @Param id path uint true "收藏夹ID"
+// @Param body body schema.AddFavoriteFolderItemRequest true "文章信息"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders/{id}/articles [post]
+func (h *FavoriteFolderHandler) AddArticle(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的收藏夹ID"))
+		return
+	}
+
+	var req schema.AddFavoriteFolderItemRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.FavoriteFolderService.AddArticle(ctx, userID, uint(id), req.ArticleID); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags FavoriteFolderAPI
+// @Security ApiKeyAuth
+// @Summary 将收藏的文章移动到另一个收藏夹
+// @Param id path uint true "收藏夹ID"
+// @Param article_id path uint true "文章ID"
+// @Param body body schema.MoveFavoriteFolderItemRequest true "目标收藏夹"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders/{id}/articles/{article_id} [put]
+func (h *FavoriteFolderHandler) MoveArticle(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的收藏夹ID"))
+		return
+	}
+	articleID, err := strconv.ParseUint(c.Param("article_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	var req schema.MoveFavoriteFolderItemRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.FavoriteFolderService.MoveArticle(ctx, userID, uint(id), uint(articleID), req.TargetFolderID); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags FavoriteFolderAPI
+// @Security ApiKeyAuth
+// @Summary 将文章从收藏夹中移除（取消收藏）
+// @Param id path uint true "收藏夹ID"
+// @Param article_id path uint true "文章ID"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/folders/{id}/articles/{article_id} [delete]
+func (h *FavoriteFolderHandler) RemoveArticle(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的收藏夹ID"))
+		return
+	}
+	articleID, err := strconv.ParseUint(c.Param("article_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.FavoriteFolderService.RemoveArticle(ctx, userID, uint(id), uint(articleID)); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
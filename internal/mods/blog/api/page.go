@@ -0,0 +1,258 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// PageHandler 独立页面API处理器
+type PageHandler struct {
+	PageService *biz.PageService
+}
+
+// @Tags PageAPI
+// @Summary 获取导航中的独立页面
+// @Success 200 {object} util.ResponseResult{data=[]schema.PageNavItem}
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages/nav [get]
+func (h *PageHandler) GetNavPages(c *gin.Context) {
+	ctx := c.Request.Context()
+	data, err := h.PageService.GetNavPages(ctx)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags PageAPI
+// @Summary 通过页面标识获取已发布的独立页面
+// @Param slug path string true "页面标识"
+// @Success 200 {object} util.ResponseResult{data=schema.PageResponse}
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages/slug/{slug} [get]
+func (h *PageHandler) GetPageBySlug(c *gin.Context) {
+	ctx := c.Request.Context()
+	data, err := h.PageService.GetPageBySlug(ctx, c.Param("slug"))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags PageAPI
+// @Security ApiKeyAuth
+// @Summary 获取独立页面列表（仅管理员可用）
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "每页容量" minimum(1) maximum(100) default(10)
+// @Param status query string false "页面状态" Enums(published, draft)
+// @Param keyword query string false "按标题或标识搜索"
+// @Success 200 {object} util.ResponseResult{data=schema.PagePaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages [get]
+func (h *PageHandler) GetPageList(c *gin.Context) {
+	var params schema.PageQueryParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.PageService.GetPageList(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags PageAPI
+// @Security ApiKeyAuth
+// @Summary 获取独立页面详情（仅管理员可用）
+// @Param id path uint true "页面ID" minimum(1)
+// @Success 200 {object} util.ResponseResult{data=schema.PageResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages/{id} [get]
+func (h *PageHandler) GetPage(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页面ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.PageService.GetPage(ctx, uint(id))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags PageAPI
+// @Security ApiKeyAuth
+// @Summary 创建独立页面（仅管理员可用）
+// @Param body body schema.CreatePageRequest true "页面信息"
+// @Success 200 {object} util.ResponseResult{data=schema.PageResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 409 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages [post]
+func (h *PageHandler) CreatePage(c *gin.Context) {
+	var req schema.CreatePageRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.PageService.CreatePage(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags PageAPI
+// @Security ApiKeyAuth
+// @Summary 更新独立页面（仅管理员可用）
+// @Param id path uint true "页面ID" minimum(1)
+// @Param body body schema.UpdatePageRequest true "页面信息"
+// @Success 200 {object} util.ResponseResult{data=schema.PageResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 409 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages/{id} [put]
+func (h *PageHandler) UpdatePage(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页面ID"))
+		return
+	}
+
+	var req schema.UpdatePageRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.PageService.UpdatePage(ctx, uint(id), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags PageAPI
+// @Security ApiKeyAuth
+// @Summary 删除独立页面（仅管理员可用）
+// @Param id path uint true "页面ID" minimum(1)
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages/{id} [delete]
+func (h *PageHandler) DeletePage(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页面ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.PageService.DeletePage(ctx, uint(id)); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags PageAPI
+// @Security ApiKeyAuth
+// @Summary 获取独立页面的历史版本（仅管理员可用）
+// @Param id path uint true "页面ID" minimum(1)
+// @Success 200 {object} util.ResponseResult{data=[]schema.PageRevisionResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages/{id}/revisions [get]
+func (h *PageHandler) GetPageRevisions(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页面ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.PageService.GetPageRevisions(ctx, uint(id))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags PageAPI
+// @Security ApiKeyAuth
+// @Summary 将独立页面恢复到历史版本（仅管理员可用）
+// @Param id path uint true "页面ID" minimum(1)
+// @Param revision_id path uint true "历史版本ID" minimum(1)
+// @Param body body schema.RestorePageRevisionRequest false "修改说明"
+// @Success 200 {object} util.ResponseResult{data=schema.PageResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 409 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/pages/{id}/revisions/{revision_id}/restore [post]
+func (h *PageHandler) RestoreRevision(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的页面ID"))
+		return
+	}
+	revisionID, err := strconv.ParseUint(c.Param("revision_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的历史版本ID"))
+		return
+	}
+
+	var req schema.RestorePageRevisionRequest
+	if c.Request.ContentLength > 0 {
+		if err := util.ParseJSON(c, &req); err != nil {
+			util.ResError(c, err)
+			return
+		}
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.PageService.RestoreRevision(ctx, uint(id), uint(revisionID), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
@@ -0,0 +1,81 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// ShareHandler 文章分享API处理器
+type ShareHandler struct {
+	ShareService *biz.ShareService
+}
+
+// siteURL 站点对外访问地址，未配置时根据请求推断
+func siteURL(c *gin.Context) string {
+	return util.GetSiteURL(c, config.C.SiteURL())
+}
+
+// RenderHead 为爬虫请求的文章页面生成分享元数据标签（静态文件中间件使用）
+func (h *ShareHandler) RenderHead(c *gin.Context) string {
+	ctx := c.Request.Context()
+	head, err := h.ShareService.RenderHead(ctx, siteURL(c), c.Request.URL.Path)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			logging.Context(ctx).Error("生成文章分享元数据失败", zap.Error(err), zap.String("path", c.Request.URL.Path))
+		}
+		return ""
+	}
+	return head
+}
+
+// GetOEmbed 获取文章链接的 oEmbed 数据
+// @Tags ShareAPI
+// @Summary 获取文章链接的 oEmbed 数据
+// @Produce json,xml
+// @Param url query string true "文章链接"
+// @Param maxwidth query int false "最大宽度" minimum(1)
+// @Param maxheight query int false "最大高度" minimum(1)
+// @Param format query string false "响应格式" Enums(json, xml) default(json)
+// @Success 200 {object} schema.OEmbedResponse
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 501 {object} util.ResponseResult
+// @Router /api/blog/oembed [get]
+func (h *ShareHandler) GetOEmbed(c *gin.Context) {
+	var req schema.OEmbedRequest
+	if err := util.ParseQuery(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+	if req.Format == "" {
+		req.Format = schema.OEmbedFormatJSON
+	}
+	if req.Format != schema.OEmbedFormatJSON && req.Format != schema.OEmbedFormatXML {
+		util.ResError(c, errors.NotImplemented("不支持的响应格式：%s", req.Format))
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.ShareService.GetOEmbed(ctx, siteURL(c), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	// oEmbed 响应为规范定义的原始数据，不使用统一的响应包装
+	if req.Format == schema.OEmbedFormatXML {
+		c.XML(http.StatusOK, data)
+		c.Abort()
+		return
+	}
+	util.ResJSON(c, http.StatusOK, data)
+}
@@ -194,3 +194,23 @@ func (h *TagHandler) GetHotTags(c *gin.Context) {
 
 	util.ResSuccess(c, data)
 }
+
+// SuggestTags 标签联想
+// @Tags TagAPI
+// @Summary 标签联想（支持前缀、拼音首字母与模糊匹配）
+// @Param keyword query string true "输入关键词" maxlength(50)
+// @Param limit query int false "返回数量" minimum(1) default(10)
+// @Success 200 {object} util.ResponseResult{data=[]schema.TagResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/blog/tags/suggest [get]
+func (h *TagHandler) SuggestTags(c *gin.Context) {
+	ctx := c.Request.Context()
+	var params schema.TagSuggestParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, h.TagService.SuggestTags(ctx, &params))
+}
@@ -7,11 +7,20 @@ import (
 	"time"
 
 	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	activitypubBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/biz"
 	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	mentionBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/biz"
+	mentionSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
+	notificationBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	notificationSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+	sensitiveBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/biz"
+	webmentionBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/biz"
+	"github.com/codeExpert666/goinkblog-backend/pkg/activitypub"
 	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
 	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/simhash"
 	"github.com/codeExpert666/goinkblog-backend/pkg/util"
 	"github.com/gin-gonic/gin"
 	"go.uber.org/zap"
@@ -19,13 +28,23 @@ import (
 
 // ArticleService 文章业务逻辑层
 type ArticleService struct {
-	ArticleRepository     *dal.ArticleRepository
-	CategoryRepository    *dal.CategoryRepository
-	TagRepository         *dal.TagRepository
-	ArticleTagRepository  *dal.ArticleTagRepository
-	InteractionRepository *dal.InteractionRepository
-	UserRepository        *userDal.UserRepository
-	Trans                 util.Trans
+	ArticleRepository          *dal.ArticleRepository
+	CategoryRepository         *dal.CategoryRepository
+	TagRepository              *dal.TagRepository
+	ArticleTagRepository       *dal.ArticleTagRepository
+	InteractionRepository      *dal.InteractionRepository
+	ReadingProgressRepository  *dal.ReadingProgressRepository
+	FavoriteFolderService      *FavoriteFolderService
+	ArticleReviewRepository    *dal.ArticleReviewRepository
+	ArticleDuplicateRepository *dal.ArticleDuplicateRepository
+	UserRepository             *userDal.UserRepository
+	TagSuggester               *TagSuggester
+	SensitiveFilter            *sensitiveBiz.SensitiveFilter
+	WebmentionService          *webmentionBiz.WebmentionService
+	ActivityPubService         *activitypubBiz.ActivityPubService
+	MentionService             *mentionBiz.MentionService
+	NotificationService        *notificationBiz.NotificationService
+	Trans                      util.Trans
 }
 
 // CreateArticle 创建文章
@@ -46,24 +65,90 @@ func (s *ArticleService) CreateArticle(ctx context.Context, userID uint, req *sc
 		AuthorID:   userID,
 		CategoryID: req.CategoryID,
 		Cover:      req.Cover,
-		Status:     req.Status,
+		Status:     resolveArticleStatus(ctx, "", req.Status),
 	}
 
+	// 敏感词过滤，命中需人工审核的敏感词时申请发布的文章转入待审核
+	review, err := s.screenArticleText(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+	if review && article.Status == schema.ArticleStatusPublished {
+		article.Status = schema.ArticleStatusPending
+	}
+	article.Fingerprint = simhash.Fingerprint(article.Content)
+	markPublished(article)
+
 	if err := s.ArticleRepository.Create(ctx, article); err != nil {
 		return nil, err
 	}
 
+	// 发布或提交审核时进行重复检测
+	if isLiveArticleStatus(article.Status) {
+		s.flagDuplicates(ctx, article)
+	}
+
+	// 需要审核时提交审核
+	if article.Status == schema.ArticleStatusPending {
+		if err := s.submitForReview(ctx, userID, article.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 发布后向正文中链接的外部页面发送 Webmention，并向作者的联邦关注者推送
+	if article.Status == schema.ArticleStatusPublished {
+		s.WebmentionService.EnqueueArticle(ctx, article)
+		s.ActivityPubService.PublishArticle(ctx, article, activitypub.ActivityCreate)
+	}
+	s.syncMentions(ctx, article)
+
 	// 添加标签
 	if len(req.TagIDs) > 0 {
 		s.Trans.Exec(ctx, func(ctx context.Context) error {
 			return s.addArticleTags(ctx, article.ID, req.TagIDs)
 		})
+		s.TagSuggester.MarkDirty(ctx)
 	}
 
 	// 获取文章详情
 	return s.GetArticleByID(ctx, article.ID, userID)
 }
 
+// syncMentions 同步文章正文中的提及，文章发布后才通知被提及的用户
+func (s *ArticleService) syncMentions(ctx context.Context, article *schema.Article) {
+	s.MentionService.SyncMentions(ctx, mentionBiz.Source{
+		Type:        mentionSchema.MentionSourceArticle,
+		ID:          article.ID,
+		ArticleID:   article.ID,
+		MentionerID: article.AuthorID,
+		Content:     article.Content,
+		Visible:     article.Status == schema.ArticleStatusPublished,
+	})
+}
+
+// screenArticleText 使用敏感词过滤器处理文章标题、摘要与正文，review 表示需要转入人工审核
+func (s *ArticleService) screenArticleText(ctx context.Context, article *schema.Article) (bool, error) {
+	fields := []struct {
+		name string
+		text *string
+	}{
+		{"标题", &article.Title},
+		{"摘要", &article.Summary},
+		{"正文", &article.Content},
+	}
+
+	review := false
+	for _, field := range fields {
+		masked, hit, err := s.SensitiveFilter.Screen(ctx, field.name, *field.text)
+		if err != nil {
+			return false, err
+		}
+		*field.text = masked
+		review = review || hit
+	}
+	return review, nil
+}
+
 func (s *ArticleService) addArticleTags(ctx context.Context, articleID uint, tagIDs []uint) error {
 	// 删除现有标签
 	err := s.ArticleTagRepository.DeleteByArticleID(ctx, articleID)
@@ -107,6 +192,7 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, userID uint, id uint
 	}
 
 	// 更新文章字段
+	oldContent := article.Content
 	if req.Title != "" {
 		article.Title = req.Title
 	}
@@ -122,20 +208,68 @@ func (s *ArticleService) UpdateArticle(ctx context.Context, userID uint, id uint
 	if req.Cover != "" {
 		article.Cover = req.Cover
 	}
+	status := article.Status
 	if req.Status != "" {
-		article.Status = req.Status
+		status = resolveArticleStatus(ctx, article.Status, req.Status)
+	}
+
+	// 敏感词过滤，命中需人工审核的敏感词时已发布或申请发布的文章转入待审核
+	review, err := s.screenArticleText(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+	if review && status == schema.ArticleStatusPublished {
+		status = schema.ArticleStatusPending
 	}
+	submitted := status == schema.ArticleStatusPending && article.Status != schema.ArticleStatusPending
+
+	// 内容变更或首次发布、提交审核时需要重新进行重复检测
+	fingerprint := simhash.Fingerprint(article.Content)
+	recheck := isLiveArticleStatus(status) && (fingerprint != article.Fingerprint || !isLiveArticleStatus(article.Status))
+	// 首次发布或已发布文章的正文变更时需要发送 Webmention
+	notify := status == schema.ArticleStatusPublished && (article.Status != schema.ArticleStatusPublished || article.Content != oldContent)
+	wasPublished := article.Status == schema.ArticleStatusPublished
+	article.Status = status
+	article.Fingerprint = fingerprint
+	markPublished(article)
 
 	// 保存更新
 	if err := s.ArticleRepository.Update(ctx, article); err != nil {
 		return nil, err
 	}
 
+	if recheck {
+		s.flagDuplicates(ctx, article)
+	}
+
+	// 需要审核时提交审核
+	if submitted {
+		if err := s.submitForReview(ctx, userID, article.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	if notify {
+		s.WebmentionService.EnqueueArticle(ctx, article)
+	}
+	s.syncMentions(ctx, article)
+
+	// 向作者的联邦关注者推送文章的发布、更新或撤回
+	switch {
+	case status == schema.ArticleStatusPublished && !wasPublished:
+		s.ActivityPubService.PublishArticle(ctx, article, activitypub.ActivityCreate)
+	case status == schema.ArticleStatusPublished:
+		s.ActivityPubService.PublishArticle(ctx, article, activitypub.ActivityUpdate)
+	case wasPublished:
+		s.ActivityPubService.PublishArticle(ctx, article, activitypub.ActivityDelete)
+	}
+
 	// 更新标签
 	if len(req.TagIDs) > 0 {
 		s.Trans.Exec(ctx, func(ctx context.Context) error {
 			return s.addArticleTags(ctx, article.ID, req.TagIDs)
 		})
+		s.TagSuggester.MarkDirty(ctx)
 	}
 
 	// 获取文章详情
@@ -161,11 +295,48 @@ func (s *ArticleService) DeleteArticle(ctx context.Context, userID uint, id uint
 		if err := s.ArticleTagRepository.DeleteByArticleID(ctx, id); err != nil {
 			return err
 		}
+		// 删除文章阅读进度
+		if err := s.ReadingProgressRepository.DeleteByArticleID(ctx, id); err != nil {
+			return err
+		}
+		// 删除文章的收藏夹条目
+		if err := s.FavoriteFolderService.FavoriteFolderRepository.DeleteItemsByArticleID(ctx, id); err != nil {
+			return err
+		}
+		// 删除文章审核历史
+		if err := s.ArticleReviewRepository.DeleteByArticleID(ctx, id); err != nil {
+			return err
+		}
+		// 删除疑似重复文章记录
+		if err := s.ArticleDuplicateRepository.DeleteByArticleID(ctx, id); err != nil {
+			return err
+		}
+		// 删除 Webmention 发送与接收记录
+		if err := s.WebmentionService.DeleteArticleMentions(ctx, id); err != nil {
+			return err
+		}
+		// 删除远程点赞记录
+		if err := s.ActivityPubService.DeleteArticleData(ctx, id); err != nil {
+			return err
+		}
+		// 删除正文及评论中的提及
+		if err := s.MentionService.DeleteArticleMentions(ctx, id); err != nil {
+			return err
+		}
 		// 删除文章
 		return s.ArticleRepository.Delete(ctx, id)
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	// 通知联邦关注者文章已删除
+	if article.Status == schema.ArticleStatusPublished {
+		s.ActivityPubService.PublishArticle(ctx, article, activitypub.ActivityDelete)
+	}
+
+	s.TagSuggester.MarkDirty(ctx)
+	return nil
 }
 
 func (s *ArticleService) UploadCover(c *gin.Context) (*schema.CoverResponse, error) {
@@ -234,6 +405,9 @@ func (s *ArticleService) GetArticleByID(ctx context.Context, articleID uint, use
 	// 获取作者信息
 	s.FillAuthor(ctx, response)
 
+	// 获取正文中被提及的用户
+	response.Mentions = s.MentionService.GetMentionedUsers(ctx, mentionSchema.MentionSourceArticle, []uint{article.ID})[article.ID]
+
 	// 如果用户已登录，获取用户与文章的交互状态
 	if userID > 0 {
 		interactions, err := s.GetArticleInteractions(ctx, userID, articleID)
@@ -249,6 +423,11 @@ func (s *ArticleService) GetArticleByID(ctx context.Context, articleID uint, use
 
 // GetArticleList 获取文章列表
 func (s *ArticleService) GetArticleList(ctx context.Context, params *schema.ArticleQueryParams) (*schema.ArticlePaginationResult, error) {
+	// 草稿及审核中的文章只能在当前用户自己的文章列表中查询，其他情况只列出已发布的文章
+	if params.Author != "current" {
+		params.Status = schema.ArticleStatusPublished
+	}
+
 	// 获取文章列表
 	result, err := s.ArticleRepository.GetList(ctx, params)
 	if err != nil {
@@ -380,6 +559,7 @@ func (s *ArticleService) LikeArticle(ctx context.Context, userID, articleID uint
 		if err := s.ArticleRepository.IncrementLikeCount(ctx, articleID, 1); err != nil {
 			return nil, err
 		}
+		s.notifyInteraction(ctx, notificationSchema.NotificationTypeLike, userID, articleID)
 	} else {
 		return nil, err
 	}
@@ -408,6 +588,10 @@ func (s *ArticleService) FavoriteArticle(ctx context.Context, userID, articleID
 		if err := s.ArticleRepository.IncrementFavoriteCount(ctx, articleID, -1); err != nil {
 			return nil, err
 		}
+		// 移出收藏夹
+		if err := s.FavoriteFolderService.FavoriteFolderRepository.DeleteItem(ctx, userID, articleID); err != nil {
+			return nil, err
+		}
 	} else if errors.IsNotFound(err) { // 未收藏，则添加收藏
 		// 添加收藏记录
 		newInteraction := &schema.UserInteraction{
@@ -422,6 +606,11 @@ func (s *ArticleService) FavoriteArticle(ctx context.Context, userID, articleID
 		if err := s.ArticleRepository.IncrementFavoriteCount(ctx, articleID, 1); err != nil {
 			return nil, err
 		}
+		// 放入默认收藏夹
+		if err := s.FavoriteFolderService.FileToDefaultFolder(ctx, userID, articleID); err != nil {
+			return nil, err
+		}
+		s.notifyInteraction(ctx, notificationSchema.NotificationTypeFavorite, userID, articleID)
 	} else {
 		return nil, err
 	}
@@ -437,6 +626,24 @@ func (s *ArticleService) FavoriteArticle(ctx context.Context, userID, articleID
 	}, nil
 }
 
+// notifyInteraction 通知文章作者文章被点赞或收藏，同一文章的未读通知会合并
+func (s *ArticleService) notifyInteraction(ctx context.Context, typ string, userID, articleID uint) {
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		logging.Context(ctx).Error("获取文章失败", zap.Uint("article_id", articleID), zap.Error(err))
+		return
+	}
+
+	s.NotificationService.Notify(ctx, notificationBiz.Event{
+		Type:       typ,
+		UserID:     article.AuthorID,
+		ActorID:    userID,
+		TargetType: notificationSchema.NotificationTargetArticle,
+		TargetID:   article.ID,
+		ArticleID:  article.ID,
+	})
+}
+
 // GetUserLikedArticles 获取用户点赞的文章
 func (s *ArticleService) GetUserLikedArticles(ctx context.Context, userID uint, page, pageSize int) (*schema.ArticlePaginationResult, error) {
 	result, err := s.ArticleRepository.GetUserLikedArticles(ctx, userID, page, pageSize)
@@ -485,6 +692,61 @@ func (s *ArticleService) GetUserViewHistory(ctx context.Context, userID uint, pa
 	return result, nil
 }
 
+// SaveReadingProgress 上报阅读进度，只记录读到的最远位置
+func (s *ArticleService) SaveReadingProgress(ctx context.Context, userID, articleID uint, req *schema.ReadingProgressRequest) (*schema.ReadingProgressResponse, error) {
+	// 检查文章是否存在
+	if _, err := s.ArticleRepository.GetByID(ctx, articleID); err != nil {
+		return nil, err
+	}
+
+	progress := &schema.ReadingProgress{
+		UserID:    userID,
+		ArticleID: articleID,
+		Progress:  req.Progress,
+		Position:  req.Position,
+	}
+	if err := s.ReadingProgressRepository.Save(ctx, progress); err != nil {
+		return nil, err
+	}
+
+	return s.GetReadingProgress(ctx, userID, articleID)
+}
+
+// GetReadingProgress 获取用户对文章的阅读进度
+func (s *ArticleService) GetReadingProgress(ctx context.Context, userID, articleID uint) (*schema.ReadingProgressResponse, error) {
+	progress, err := s.ReadingProgressRepository.Get(ctx, userID, articleID)
+	if err != nil {
+		if errors.IsNotFound(err) { // 从未阅读过，返回初始进度
+			return &schema.ReadingProgressResponse{ArticleID: articleID}, nil
+		}
+		return nil, err
+	}
+
+	return &schema.ReadingProgressResponse{
+		ArticleID: articleID,
+		Progress:  progress.Progress,
+		Position:  progress.Position,
+		Finished:  progress.Progress >= schema.ReadingFinishedProgress,
+		UpdatedAt: &progress.UpdatedAt,
+	}, nil
+}
+
+// GetContinueReading 获取用户未读完的文章
+func (s *ArticleService) GetContinueReading(ctx context.Context, userID uint, page, pageSize int) (*schema.ArticlePaginationResult, error) {
+	result, err := s.ReadingProgressRepository.GetUnfinishedArticles(ctx, userID, page, pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// 补充文章信息
+	for _, item := range result.Items {
+		s.FillAuthor(ctx, item)
+		s.FillTags(ctx, item)
+	}
+
+	return result, nil
+}
+
 // GetUserCommentedArticles 获取用户评论过的文章
 func (s *ArticleService) GetUserCommentedArticles(ctx context.Context, userID uint, page, pageSize int) (*schema.ArticlePaginationResult, error) {
 	result, err := s.ArticleRepository.GetUserCommentedArticles(ctx, userID, page, pageSize)
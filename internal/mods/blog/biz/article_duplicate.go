@@ -0,0 +1,128 @@
+package biz
+
+import (
+	"context"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/simhash"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// fingerprintBackfillBatch 回填文章指纹时每批处理的文章数量
+const fingerprintBackfillBatch = 200
+
+// isLiveArticleStatus 文章内容是否已发布或已提交审核（需要进行重复检测）
+func isLiveArticleStatus(status string) bool {
+	return status == schema.ArticleStatusPublished || status == schema.ArticleStatusPending
+}
+
+// BackfillFingerprints 为尚未计算指纹的历史文章回填指纹
+func (s *ArticleService) BackfillFingerprints(ctx context.Context) error {
+	var afterID uint
+	count := 0
+	for {
+		articles, err := s.ArticleDuplicateRepository.GetArticlesWithoutFingerprint(ctx, afterID, fingerprintBackfillBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, article := range articles {
+			afterID = article.ID
+			fingerprint := simhash.Fingerprint(article.Content)
+			if fingerprint == 0 {
+				continue
+			}
+			if err := s.ArticleDuplicateRepository.UpdateFingerprint(ctx, article.ID, fingerprint); err != nil {
+				return err
+			}
+			count++
+		}
+
+		if len(articles) < fingerprintBackfillBatch {
+			break
+		}
+	}
+
+	if count > 0 {
+		logging.Context(ctx).Info("历史文章指纹回填完成", zap.Int("article_count", count))
+	}
+	return nil
+}
+
+// flagDuplicates 将文章与已有文章比对，记录相似度超过阈值的若干篇文章
+// 检测失败不影响文章的发布，仅记录日志
+func (s *ArticleService) flagDuplicates(ctx context.Context, article *schema.Article) {
+	if article.Fingerprint == 0 {
+		return
+	}
+
+	fingerprints, err := s.ArticleDuplicateRepository.GetFingerprints(ctx, article.ID)
+	if err != nil {
+		logging.Context(ctx).Error("获取文章指纹失败", zap.Error(err), zap.Uint("article_id", article.ID))
+		return
+	}
+
+	cfg := config.C.Blog.DuplicateCheck
+	var duplicates []*schema.ArticleDuplicate
+	for _, fp := range fingerprints {
+		similarity := simhash.Similarity(article.Fingerprint, fp.Fingerprint)
+		if similarity < cfg.MinSimilarity {
+			continue
+		}
+		duplicates = append(duplicates, &schema.ArticleDuplicate{
+			ArticleID:        article.ID,
+			MatchedArticleID: fp.ID,
+			Distance:         simhash.Distance(article.Fingerprint, fp.Fingerprint),
+			Similarity:       similarity,
+		})
+	}
+
+	// 只保留最相似的若干篇，相似度相同时优先保留较早的文章（更可能是原文）
+	sort.Slice(duplicates, func(i, j int) bool {
+		if duplicates[i].Distance != duplicates[j].Distance {
+			return duplicates[i].Distance < duplicates[j].Distance
+		}
+		return duplicates[i].MatchedArticleID < duplicates[j].MatchedArticleID
+	})
+	if len(duplicates) > cfg.TopN {
+		duplicates = duplicates[:cfg.TopN]
+	}
+
+	if err := s.ArticleDuplicateRepository.ReplaceByArticleID(ctx, article.ID, duplicates); err != nil {
+		logging.Context(ctx).Error("保存疑似重复文章记录失败", zap.Error(err), zap.Uint("article_id", article.ID))
+		return
+	}
+
+	if len(duplicates) > 0 {
+		matched := make([]uint, 0, len(duplicates))
+		for _, d := range duplicates {
+			matched = append(matched, d.MatchedArticleID)
+		}
+		logging.Context(logging.NewTag(ctx, logging.TagKeyOperate)).Warn("检测到疑似重复文章",
+			zap.Uint("article_id", article.ID),
+			zap.Uint("author_id", article.AuthorID),
+			zap.Uints("matched_article_ids", matched),
+			zap.Float64("max_similarity", duplicates[0].Similarity))
+	}
+}
+
+// GetDuplicateReport 获取疑似重复文章报告（仅管理员可用）
+func (s *ArticleService) GetDuplicateReport(ctx context.Context, params *schema.ArticleDuplicateListRequest) (*schema.ArticleDuplicatePaginationResult, error) {
+	if !util.FromIsAdminUser(ctx) {
+		return nil, errors.Forbidden("无权限查看疑似重复文章报告")
+	}
+
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+	return s.ArticleDuplicateRepository.GetReport(ctx, params)
+}
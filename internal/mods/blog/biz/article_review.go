@@ -0,0 +1,188 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	notificationBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	notificationSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/activitypub"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// isTrustedAuthor 判断当前用户发布文章是否无需审核（同时也是可以审核文章的角色）
+func isTrustedAuthor(ctx context.Context) bool {
+	if util.FromIsAdminUser(ctx) {
+		return true
+	}
+	return slices.Contains(config.C.Blog.ArticleReview.TrustedRoles, util.FromUserCache(ctx).Role)
+}
+
+// resolveArticleStatus 根据审核配置确定文章的实际状态
+// 开启审核时，非信任作者申请发布的文章进入待审核状态；已发布文章的后续编辑不再重新审核
+func resolveArticleStatus(ctx context.Context, current, requested string) string {
+	if requested != schema.ArticleStatusPublished || current == schema.ArticleStatusPublished {
+		return requested
+	}
+	if !config.C.Blog.ArticleReview.Enabled || isTrustedAuthor(ctx) {
+		return requested
+	}
+	return schema.ArticleStatusPending
+}
+
+// markPublished 文章首次发布时记录发布时间
+func markPublished(article *schema.Article) {
+	if article.Status == schema.ArticleStatusPublished && article.PublishedAt == nil {
+		now := time.Now()
+		article.PublishedAt = &now
+	}
+}
+
+// submitForReview 记录作者提交审核
+func (s *ArticleService) submitForReview(ctx context.Context, userID, articleID uint) error {
+	return s.ArticleReviewRepository.Create(ctx, &schema.ArticleReview{
+		ArticleID:  articleID,
+		OperatorID: userID,
+		Action:     schema.ArticleReviewActionSubmit,
+	})
+}
+
+// ReviewArticle 审核文章
+func (s *ArticleService) ReviewArticle(ctx context.Context, reviewerID, articleID uint, req *schema.ReviewArticleRequest) error {
+	if !isTrustedAuthor(ctx) {
+		return errors.Forbidden("无权限审核文章")
+	}
+
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return err
+	}
+	if article.Status != schema.ArticleStatusPending {
+		return errors.BadRequest("文章不在待审核状态，不能审核")
+	}
+	if req.Action != schema.ArticleReviewActionApprove && req.Remark == "" {
+		return errors.BadRequest("拒绝或要求修改时必须填写审核备注")
+	}
+
+	switch req.Action {
+	case schema.ArticleReviewActionApprove:
+		article.Status = schema.ArticleStatusPublished
+		markPublished(article)
+	case schema.ArticleReviewActionReject:
+		article.Status = schema.ArticleStatusRejected
+	case schema.ArticleReviewActionRequestChanges:
+		article.Status = schema.ArticleStatusChangesRequested
+	}
+
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		// 更新文章状态
+		if err := s.ArticleRepository.Update(ctx, article); err != nil {
+			return err
+		}
+		// 记录审核历史
+		return s.ArticleReviewRepository.Create(ctx, &schema.ArticleReview{
+			ArticleID:  article.ID,
+			OperatorID: reviewerID,
+			Action:     req.Action,
+			Remark:     req.Remark,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	// 此处只记录审核结果已保存，通知是否送达由通知服务记录，作者关闭审核通知或自审时不会发送
+	logging.Context(logging.NewTag(ctx, logging.TagKeyOperate)).Info("文章审核结果已保存",
+		zap.Uint("article_id", article.ID), zap.Uint("author_id", article.AuthorID),
+		zap.Uint("reviewer_id", reviewerID), zap.String("action", req.Action))
+
+	// 审核通过即发布，向正文中链接的外部页面发送 Webmention，向作者的联邦关注者推送，并通知正文中被提及的用户
+	if article.Status == schema.ArticleStatusPublished {
+		s.WebmentionService.EnqueueArticle(ctx, article)
+		s.ActivityPubService.PublishArticle(ctx, article, activitypub.ActivityCreate)
+		s.syncMentions(ctx, article)
+	}
+
+	// 通知作者审核结果
+	result := notificationSchema.ReviewResultApproved
+	switch req.Action {
+	case schema.ArticleReviewActionReject:
+		result = notificationSchema.ReviewResultRejected
+	case schema.ArticleReviewActionRequestChanges:
+		result = notificationSchema.ReviewResultChangesRequested
+	}
+	s.NotificationService.Notify(ctx, notificationBiz.Event{
+		Type:       notificationSchema.NotificationTypeReview,
+		UserID:     article.AuthorID,
+		ActorID:    reviewerID,
+		TargetType: notificationSchema.NotificationTargetArticle,
+		TargetID:   article.ID,
+		ArticleID:  article.ID,
+		Result:     result,
+		Content:    req.Remark,
+	})
+	return nil
+}
+
+// GetArticleReviews 获取文章的审核历史（仅作者与审核人员可见）
+func (s *ArticleService) GetArticleReviews(ctx context.Context, userID, articleID uint) ([]schema.ArticleReviewResponse, error) {
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if article.AuthorID != userID && !isTrustedAuthor(ctx) {
+		return nil, errors.Forbidden("无权限查看此文章的审核历史")
+	}
+
+	reviews, err := s.ArticleReviewRepository.ListByArticleID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]schema.ArticleReviewResponse, 0, len(reviews))
+	for _, review := range reviews {
+		item := schema.ArticleReviewResponse{
+			ID:         review.ID,
+			ArticleID:  review.ArticleID,
+			OperatorID: review.OperatorID,
+			Action:     review.Action,
+			Remark:     review.Remark,
+			CreatedAt:  review.CreatedAt,
+		}
+		if user, err := s.UserRepository.GetByID(ctx, review.OperatorID); err != nil {
+			logging.Context(ctx).Error("获取审核操作人信息失败", zap.Uint("operator_id", review.OperatorID), zap.Error(err))
+		} else {
+			item.OperatorName = user.Username
+			item.OperatorAvatar = user.Avatar
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
+
+// GetArticlesForReview 获取文章审核队列
+func (s *ArticleService) GetArticlesForReview(ctx context.Context, params *schema.ArticleReviewListRequest) (*schema.ArticlePaginationResult, error) {
+	if !isTrustedAuthor(ctx) {
+		return nil, errors.Forbidden("无权限查看文章审核队列")
+	}
+
+	result, err := s.ArticleReviewRepository.GetQueue(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// 补充文章信息
+	for _, item := range result.Items {
+		s.FillAuthor(ctx, item)
+		s.FillTags(ctx, item)
+	}
+
+	return result, nil
+}
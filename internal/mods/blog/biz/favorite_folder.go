@@ -0,0 +1,306 @@
+package biz
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// FavoriteFolderService 收藏夹业务逻辑层
+type FavoriteFolderService struct {
+	FavoriteFolderRepository *dal.FavoriteFolderRepository
+	ArticleRepository        *dal.ArticleRepository
+	InteractionRepository    *dal.InteractionRepository
+	Trans                    util.Trans
+}
+
+// MigrateUnfiledFavorites 将尚未归入任何收藏夹的历史收藏迁移到各用户的默认收藏夹
+func (s *FavoriteFolderService) MigrateUnfiledFavorites(ctx context.Context) error {
+	if err := s.FavoriteFolderRepository.BackfillDefaultOf(ctx); err != nil {
+		return err
+	}
+
+	userIDs, err := s.FavoriteFolderRepository.GetUsersWithUnfiledFavorites(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		err := s.Trans.Exec(ctx, func(ctx context.Context) error {
+			folder, err := s.EnsureDefaultFolder(ctx, userID)
+			if err != nil {
+				return err
+			}
+			return s.FavoriteFolderRepository.FileUnfiledFavorites(ctx, userID, folder.ID)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(userIDs) > 0 {
+		logging.Context(ctx).Info("历史收藏已迁移到默认收藏夹", zap.Int("user_count", len(userIDs)))
+	}
+	return nil
+}
+
+// EnsureDefaultFolder 获取用户的默认收藏夹，不存在时自动创建，并发创建时返回已创建的默认收藏夹
+func (s *FavoriteFolderService) EnsureDefaultFolder(ctx context.Context, userID uint) (*schema.FavoriteFolder, error) {
+	folder, err := s.FavoriteFolderRepository.GetDefault(ctx, userID)
+	if err == nil {
+		return folder, nil
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	return s.FavoriteFolderRepository.CreateDefault(ctx, &schema.FavoriteFolder{
+		UserID: userID,
+		Name:   schema.DefaultFavoriteFolderName,
+	})
+}
+
+// FileToDefaultFolder 将新收藏的文章放入用户的默认收藏夹
+func (s *FavoriteFolderService) FileToDefaultFolder(ctx context.Context, userID, articleID uint) error {
+	folder, err := s.EnsureDefaultFolder(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.FavoriteFolderRepository.CreateItem(ctx, &schema.FavoriteFolderItem{
+		FolderID:  folder.ID,
+		UserID:    userID,
+		ArticleID: articleID,
+	})
+}
+
+// ListFolders 获取当前用户的收藏夹列表
+func (s *FavoriteFolderService) ListFolders(ctx context.Context, userID uint) ([]schema.FavoriteFolderResponse, error) {
+	// 保证每个用户至少拥有默认收藏夹
+	if _, err := s.EnsureDefaultFolder(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.FavoriteFolderRepository.ListByUserID(ctx, userID, false)
+}
+
+// ListUserFolders 获取指定用户的收藏夹列表，非本人只能看到公开收藏夹
+func (s *FavoriteFolderService) ListUserFolders(ctx context.Context, viewerID, ownerID uint) ([]schema.FavoriteFolderResponse, error) {
+	if viewerID == ownerID {
+		return s.ListFolders(ctx, ownerID)
+	}
+	return s.FavoriteFolderRepository.ListByUserID(ctx, ownerID, true)
+}
+
+// CreateFolder 创建收藏夹
+func (s *FavoriteFolderService) CreateFolder(ctx context.Context, userID uint, req *schema.CreateFavoriteFolderRequest) (*schema.FavoriteFolderResponse, error) {
+	// 检查是否重名
+	exists, err := s.FavoriteFolderRepository.ExistsByName(ctx, userID, req.Name, 0)
+	if err != nil {
+		return nil, err
+	} else if exists {
+		return nil, errors.Conflict("收藏夹名称已存在")
+	}
+
+	folder := &schema.FavoriteFolder{
+		UserID:      userID,
+		Name:        req.Name,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+	}
+	if err := s.FavoriteFolderRepository.Create(ctx, folder); err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(ctx, folder), nil
+}
+
+// UpdateFolder 更新收藏夹
+func (s *FavoriteFolderService) UpdateFolder(ctx context.Context, userID, id uint, req *schema.UpdateFavoriteFolderRequest) (*schema.FavoriteFolderResponse, error) {
+	folder, err := s.getOwnFolder(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// 更新收藏夹字段
+	if req.Name != "" && req.Name != folder.Name {
+		exists, err := s.FavoriteFolderRepository.ExistsByName(ctx, userID, req.Name, folder.ID)
+		if err != nil {
+			return nil, err
+		} else if exists {
+			return nil, errors.Conflict("收藏夹名称已存在")
+		}
+		folder.Name = req.Name
+	}
+	if req.Description != "" {
+		folder.Description = req.Description
+	}
+	if req.IsPublic != nil {
+		folder.IsPublic = *req.IsPublic
+	}
+
+	// 保存更新
+	if err := s.FavoriteFolderRepository.Update(ctx, folder); err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(ctx, folder), nil
+}
+
+// DeleteFolder 删除收藏夹，其中的收藏移回默认收藏夹
+func (s *FavoriteFolderService) DeleteFolder(ctx context.Context, userID, id uint) error {
+	folder, err := s.getOwnFolder(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if folder.IsDefault {
+		return errors.BadRequest("默认收藏夹不能删除")
+	}
+
+	return s.Trans.Exec(ctx, func(ctx context.Context) error {
+		defaultFolder, err := s.EnsureDefaultFolder(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if err := s.FavoriteFolderRepository.MoveAllItems(ctx, folder.ID, defaultFolder.ID); err != nil {
+			return err
+		}
+		return s.FavoriteFolderRepository.Delete(ctx, folder.ID)
+	})
+}
+
+// GetFolderArticles 获取收藏夹中的文章，私有收藏夹仅所有者可见
+func (s *FavoriteFolderService) GetFolderArticles(ctx context.Context, viewerID, id uint, page, pageSize int) (*schema.ArticlePaginationResult, error) {
+	folder, err := s.FavoriteFolderRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !folder.IsPublic && folder.UserID != viewerID {
+		return nil, errors.NotFound("收藏夹不存在")
+	}
+
+	return s.FavoriteFolderRepository.GetFolderArticles(ctx, folder.ID, page, pageSize)
+}
+
+// AddArticle 收藏文章到指定收藏夹
+func (s *FavoriteFolderService) AddArticle(ctx context.Context, userID, id, articleID uint) error {
+	folder, err := s.getOwnFolder(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	// 检查文章是否存在
+	if _, err := s.ArticleRepository.GetByID(ctx, articleID); err != nil {
+		return err
+	}
+
+	// 已收藏的文章只能移动，不能重复收藏
+	item, err := s.FavoriteFolderRepository.GetItem(ctx, userID, articleID)
+	if err == nil {
+		if item.FolderID == folder.ID {
+			return nil
+		}
+		return errors.Conflict("文章已在其他收藏夹中，请使用移动操作")
+	} else if !errors.IsNotFound(err) {
+		return err
+	}
+
+	return s.Trans.Exec(ctx, func(ctx context.Context) error {
+		// 添加收藏记录
+		interaction := &schema.UserInteraction{
+			UserID:    userID,
+			ArticleID: articleID,
+			Type:      "favorite",
+		}
+		if err := s.InteractionRepository.CreateOrUpdate(ctx, interaction); err != nil {
+			return err
+		}
+		// 增加收藏数
+		if err := s.ArticleRepository.IncrementFavoriteCount(ctx, articleID, 1); err != nil {
+			return err
+		}
+		// 放入收藏夹
+		return s.FavoriteFolderRepository.CreateItem(ctx, &schema.FavoriteFolderItem{
+			FolderID:  folder.ID,
+			UserID:    userID,
+			ArticleID: articleID,
+		})
+	})
+}
+
+// MoveArticle 将收藏的文章移动到另一个收藏夹
+func (s *FavoriteFolderService) MoveArticle(ctx context.Context, userID, id, articleID, targetID uint) error {
+	if _, err := s.getOwnItem(ctx, userID, id, articleID); err != nil {
+		return err
+	}
+	target, err := s.getOwnFolder(ctx, userID, targetID)
+	if err != nil {
+		return err
+	}
+
+	return s.FavoriteFolderRepository.MoveItem(ctx, userID, articleID, target.ID)
+}
+
+// RemoveArticle 将文章从收藏夹中移除，即取消收藏
+func (s *FavoriteFolderService) RemoveArticle(ctx context.Context, userID, id, articleID uint) error {
+	if _, err := s.getOwnItem(ctx, userID, id, articleID); err != nil {
+		return err
+	}
+
+	return s.Trans.Exec(ctx, func(ctx context.Context) error {
+		// 删除收藏记录
+		if err := s.InteractionRepository.Delete(ctx, userID, articleID, "favorite"); err != nil {
+			return err
+		}
+		// 减少收藏数
+		if err := s.ArticleRepository.IncrementFavoriteCount(ctx, articleID, -1); err != nil {
+			return err
+		}
+		// 移出收藏夹
+		return s.FavoriteFolderRepository.DeleteItem(ctx, userID, articleID)
+	})
+}
+
+// getOwnFolder 获取属于当前用户的收藏夹
+func (s *FavoriteFolderService) getOwnFolder(ctx context.Context, userID, id uint) (*schema.FavoriteFolder, error) {
+	folder, err := s.FavoriteFolderRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if folder.UserID != userID {
+		return nil, errors.Forbidden("无权限操作此收藏夹")
+	}
+	return folder, nil
+}
+
+// getOwnItem 获取当前用户收藏夹中的文章条目
+func (s *FavoriteFolderService) getOwnItem(ctx context.Context, userID, id, articleID uint) (*schema.FavoriteFolderItem, error) {
+	if _, err := s.getOwnFolder(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	item, err := s.FavoriteFolderRepository.GetItem(ctx, userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if item.FolderID != id {
+		return nil, errors.NotFound("文章不在该收藏夹中")
+	}
+	return item, nil
+}
+
+func (s *FavoriteFolderService) toResponse(ctx context.Context, folder *schema.FavoriteFolder) *schema.FavoriteFolderResponse {
+	return &schema.FavoriteFolderResponse{
+		ID:           folder.ID,
+		UserID:       folder.UserID,
+		Name:         folder.Name,
+		Description:  folder.Description,
+		IsPublic:     folder.IsPublic,
+		IsDefault:    folder.IsDefault,
+		ArticleCount: s.FavoriteFolderRepository.CountItems(ctx, folder.ID),
+		CreatedAt:    folder.CreatedAt,
+		UpdatedAt:    folder.UpdatedAt,
+	}
+}
@@ -0,0 +1,318 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap"
+
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// pageSlugPattern 页面标识格式：小写字母、数字，以单个连字符分隔
+var pageSlugPattern = regexp.MustCompile(`^[a-z0-9]+(?:-[a-z0-9]+)*$`)
+
+// PageService 独立页面业务逻辑层
+type PageService struct {
+	PageRepository *dal.PageRepository
+	UserRepository *userDal.UserRepository
+	Trans          util.Trans
+}
+
+// CreatePage 创建页面
+func (s *PageService) CreatePage(ctx context.Context, req *schema.CreatePageRequest) (*schema.PageResponse, error) {
+	slug, err := s.checkSlug(ctx, 0, req.Slug)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &schema.Page{
+		Title:     strings.TrimSpace(req.Title),
+		Slug:      slug,
+		Content:   req.Content,
+		NavOrder:  req.NavOrder,
+		ShowInNav: true,
+		Status:    schema.PageStatusDraft,
+		Version:   1,
+		EditorID:  util.FromUserID(ctx),
+	}
+	if req.ShowInNav != nil {
+		page.ShowInNav = *req.ShowInNav
+	}
+	if req.Status != "" {
+		page.Status = req.Status
+	}
+
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		if err := s.PageRepository.Create(ctx, page); err != nil {
+			return err
+		}
+		return s.saveRevision(ctx, page, req.Remark)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(ctx, page), nil
+}
+
+// UpdatePage 更新页面，标题、标识或内容发生变化时记录新版本
+func (s *PageService) UpdatePage(ctx context.Context, id uint, req *schema.UpdatePageRequest) (*schema.PageResponse, error) {
+	page, err := s.PageRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	if title := strings.TrimSpace(req.Title); title != "" && title != page.Title {
+		page.Title = title
+		changed = true
+	}
+	if req.Slug != "" && req.Slug != page.Slug {
+		slug, err := s.checkSlug(ctx, page.ID, req.Slug)
+		if err != nil {
+			return nil, err
+		}
+		page.Slug = slug
+		changed = true
+	}
+	if req.Content != "" && req.Content != page.Content {
+		page.Content = req.Content
+		changed = true
+	}
+	if req.NavOrder != nil {
+		page.NavOrder = *req.NavOrder
+	}
+	if req.ShowInNav != nil {
+		page.ShowInNav = *req.ShowInNav
+	}
+	if req.Status != "" {
+		page.Status = req.Status
+	}
+	page.EditorID = util.FromUserID(ctx)
+
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		if changed {
+			page.Version++
+		}
+		if err := s.PageRepository.Update(ctx, page); err != nil {
+			return err
+		}
+		if !changed {
+			return nil
+		}
+		return s.saveRevision(ctx, page, req.Remark)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(ctx, page), nil
+}
+
+// DeletePage 删除页面
+func (s *PageService) DeletePage(ctx context.Context, id uint) error {
+	if _, err := s.PageRepository.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	return s.Trans.Exec(ctx, func(ctx context.Context) error {
+		return s.PageRepository.Delete(ctx, id)
+	})
+}
+
+// GetPage 获取页面详情（管理员，含草稿）
+func (s *PageService) GetPage(ctx context.Context, id uint) (*schema.PageResponse, error) {
+	page, err := s.PageRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toResponse(ctx, page), nil
+}
+
+// GetPageBySlug 通过页面标识获取已发布的页面，草稿仅管理员可见
+func (s *PageService) GetPageBySlug(ctx context.Context, slug string) (*schema.PageResponse, error) {
+	page, err := s.PageRepository.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+	if page.Status != schema.PageStatusPublished && !util.FromIsAdminUser(ctx) {
+		return nil, errors.NotFound("页面不存在")
+	}
+	return s.toResponse(ctx, page), nil
+}
+
+// GetNavPages 获取导航中的页面
+func (s *PageService) GetNavPages(ctx context.Context) ([]schema.PageNavItem, error) {
+	items, err := s.PageRepository.GetNav(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if items == nil {
+		items = []schema.PageNavItem{}
+	}
+	return items, nil
+}
+
+// GetPageList 获取页面列表（管理员）
+func (s *PageService) GetPageList(ctx context.Context, params *schema.PageQueryParams) (*schema.PagePaginationResult, error) {
+	pages, total, err := s.PageRepository.GetList(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &schema.PagePaginationResult{
+		Items:      make([]schema.PageResponse, 0, len(pages)),
+		Total:      total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: int((total + int64(params.PageSize) - 1) / int64(params.PageSize)),
+	}
+	for i := range pages {
+		result.Items = append(result.Items, *s.toResponse(ctx, &pages[i]))
+	}
+
+	return result, nil
+}
+
+// GetPageRevisions 获取页面的历史版本
+func (s *PageService) GetPageRevisions(ctx context.Context, id uint) ([]schema.PageRevisionResponse, error) {
+	if _, err := s.PageRepository.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.PageRepository.GetRevisions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]schema.PageRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		result = append(result, schema.PageRevisionResponse{
+			ID:        revision.ID,
+			PageID:    revision.PageID,
+			Version:   revision.Version,
+			Title:     revision.Title,
+			Slug:      revision.Slug,
+			Content:   revision.Content,
+			EditorID:  revision.EditorID,
+			Editor:    s.editorName(ctx, revision.EditorID),
+			Remark:    revision.Remark,
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+
+	return result, nil
+}
+
+// RestoreRevision 将页面恢复到某个历史版本，恢复本身也会记录为新版本
+func (s *PageService) RestoreRevision(ctx context.Context, id, revisionID uint, req *schema.RestorePageRevisionRequest) (*schema.PageResponse, error) {
+	page, err := s.PageRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.PageRepository.GetRevision(ctx, id, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 历史版本的标识可能已被其他页面占用
+	if revision.Slug != page.Slug {
+		if _, err := s.checkSlug(ctx, page.ID, revision.Slug); err != nil {
+			return nil, err
+		}
+	}
+
+	remark := req.Remark
+	if remark == "" {
+		remark = fmt.Sprintf("恢复至版本 %d", revision.Version)
+	}
+
+	page.Title = revision.Title
+	page.Slug = revision.Slug
+	page.Content = revision.Content
+	page.EditorID = util.FromUserID(ctx)
+	page.Version++
+
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		if err := s.PageRepository.Update(ctx, page); err != nil {
+			return err
+		}
+		return s.saveRevision(ctx, page, remark)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.toResponse(ctx, page), nil
+}
+
+// checkSlug 校验页面标识的格式与唯一性，excludeID 为当前页面ID
+func (s *PageService) checkSlug(ctx context.Context, excludeID uint, slug string) (string, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if !pageSlugPattern.MatchString(slug) {
+		return "", errors.BadRequest("页面标识只能包含小写字母、数字和连字符")
+	}
+
+	existing, err := s.PageRepository.GetBySlug(ctx, slug)
+	if err == nil {
+		if existing.ID != excludeID {
+			return "", errors.Conflict("页面标识已存在")
+		}
+	} else if !errors.IsNotFound(err) {
+		return "", err
+	}
+
+	return slug, nil
+}
+
+// saveRevision 将页面当前内容记录为历史版本
+func (s *PageService) saveRevision(ctx context.Context, page *schema.Page, remark string) error {
+	return s.PageRepository.CreateRevision(ctx, &schema.PageRevision{
+		PageID:   page.ID,
+		Version:  page.Version,
+		Title:    page.Title,
+		Slug:     page.Slug,
+		Content:  page.Content,
+		EditorID: page.EditorID,
+		Remark:   remark,
+	})
+}
+
+// editorName 获取编辑人名称
+func (s *PageService) editorName(ctx context.Context, userID uint) string {
+	if userID == 0 {
+		return ""
+	}
+	user, err := s.UserRepository.GetByID(ctx, userID)
+	if err != nil {
+		logging.Context(ctx).Error("获取页面编辑人信息失败", zap.Uint("editor_id", userID), zap.Error(err))
+		return ""
+	}
+	return user.Username
+}
+
+// toResponse 构造页面响应
+func (s *PageService) toResponse(ctx context.Context, page *schema.Page) *schema.PageResponse {
+	return &schema.PageResponse{
+		ID:        page.ID,
+		Title:     page.Title,
+		Slug:      page.Slug,
+		Content:   page.Content,
+		NavOrder:  page.NavOrder,
+		ShowInNav: page.ShowInNav,
+		Status:    page.Status,
+		Version:   page.Version,
+		EditorID:  page.EditorID,
+		Editor:    s.editorName(ctx, page.EditorID),
+		CreatedAt: page.CreatedAt,
+		UpdatedAt: page.UpdatedAt,
+	}
+}
@@ -0,0 +1,196 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"image"
+	_ "image/jpeg" // 注册 JPEG 解码器，用于读取封面尺寸
+	_ "image/png"  // 注册 PNG 解码器，用于读取封面尺寸
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+)
+
+// shareDescriptionLen 分享描述的最大字符数
+const shareDescriptionLen = 200
+
+// ShareService 文章分享业务逻辑层，提供 Open Graph、Twitter Card 与 oEmbed 元数据
+type ShareService struct {
+	ArticleRepository *dal.ArticleRepository
+	UserRepository    *userDal.UserRepository
+}
+
+// GetShareMeta 获取已发布文章的分享元数据，baseURL 为站点对外访问地址
+func (s *ShareService) GetShareMeta(ctx context.Context, baseURL string, articleID uint) (*schema.ShareMeta, error) {
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if article.Status != schema.ArticleStatusPublished {
+		return nil, errors.NotFound("文章不存在")
+	}
+
+	meta := &schema.ShareMeta{
+		URL:         schema.ArticleURL(baseURL, article.ID),
+		Title:       article.Title,
+		Description: shareDescription(article),
+		SiteName:    config.C.Blog.Share.SiteName,
+		PublishedAt: article.CreatedAt.Format(time.RFC3339),
+		ModifiedAt:  article.UpdatedAt.Format(time.RFC3339),
+	}
+	if article.Cover != "" {
+		meta.Image = absoluteURL(baseURL, article.Cover)
+	}
+	if author, err := s.UserRepository.GetByID(ctx, article.AuthorID); err == nil {
+		meta.Author = author.Username
+	}
+	return meta, nil
+}
+
+// RenderHead 生成注入 index.html <head> 的分享元数据标签，非文章页面返回空字符串
+func (s *ShareService) RenderHead(ctx context.Context, baseURL, path string) (string, error) {
+	articleID, ok := schema.ParseArticlePath(path)
+	if !ok {
+		return "", nil
+	}
+
+	meta, err := s.GetShareMeta(ctx, baseURL, articleID)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	tag := func(attr, key, value string) {
+		if value != "" {
+			fmt.Fprintf(&b, "<meta %s=\"%s\" content=\"%s\">\n", attr, key, html.EscapeString(value))
+		}
+	}
+
+	// Open Graph
+	tag("property", "og:type", "article")
+	tag("property", "og:site_name", meta.SiteName)
+	tag("property", "og:url", meta.URL)
+	tag("property", "og:title", meta.Title)
+	tag("property", "og:description", meta.Description)
+	tag("property", "og:image", meta.Image)
+	tag("property", "article:author", meta.Author)
+	tag("property", "article:published_time", meta.PublishedAt)
+	tag("property", "article:modified_time", meta.ModifiedAt)
+
+	// Twitter Card
+	card := "summary"
+	if meta.Image != "" {
+		card = "summary_large_image"
+	}
+	tag("name", "twitter:card", card)
+	tag("name", "twitter:title", meta.Title)
+	tag("name", "twitter:description", meta.Description)
+	tag("name", "twitter:image", meta.Image)
+	tag("name", "description", meta.Description)
+
+	// oEmbed 自动发现
+	oembedURL := baseURL + "/api/blog/oembed?url=" + url.QueryEscape(meta.URL)
+	fmt.Fprintf(&b, "<link rel=\"alternate\" type=\"application/json+oembed\" href=\"%s\" title=\"%s\">\n",
+		html.EscapeString(oembedURL+"&format=json"), html.EscapeString(meta.Title))
+	fmt.Fprintf(&b, "<link rel=\"alternate\" type=\"text/xml+oembed\" href=\"%s\" title=\"%s\">\n",
+		html.EscapeString(oembedURL+"&format=xml"), html.EscapeString(meta.Title))
+	fmt.Fprintf(&b, "<link rel=\"canonical\" href=\"%s\">\n", html.EscapeString(meta.URL))
+
+	return b.String(), nil
+}
+
+// GetOEmbed 获取文章链接的 oEmbed 数据
+func (s *ShareService) GetOEmbed(ctx context.Context, baseURL string, req *schema.OEmbedRequest) (*schema.OEmbedResponse, error) {
+	u, err := url.Parse(req.URL)
+	if err != nil {
+		return nil, errors.BadRequest("无效的链接")
+	}
+	if site, err := url.Parse(baseURL); err == nil && !strings.EqualFold(u.Host, site.Host) {
+		return nil, errors.NotFound("链接不属于本站")
+	}
+
+	articleID, ok := schema.ParseArticlePath(u.Path)
+	if !ok {
+		return nil, errors.NotFound("链接不是文章链接")
+	}
+
+	meta, err := s.GetShareMeta(ctx, baseURL, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &schema.OEmbedResponse{
+		Type:         schema.OEmbedTypeLink,
+		Version:      schema.OEmbedVersion,
+		Title:        meta.Title,
+		AuthorName:   meta.Author,
+		ProviderName: meta.SiteName,
+		ProviderURL:  baseURL,
+		CacheAge:     config.C.Blog.Share.CacheAge,
+	}
+
+	// 缩略图必须同时给出尺寸，仅对本站存储且尺寸满足限制的封面提供缩略图
+	if meta.Image != "" {
+		if width, height, ok := localImageSize(baseURL, meta.Image); ok &&
+			(req.MaxWidth == 0 || width <= req.MaxWidth) && (req.MaxHeight == 0 || height <= req.MaxHeight) {
+			resp.ThumbnailURL = meta.Image
+			resp.ThumbnailWidth = width
+			resp.ThumbnailHeight = height
+		}
+	}
+	return resp, nil
+}
+
+// shareDescription 生成分享描述，优先使用摘要，否则截取正文开头
+func shareDescription(article *schema.Article) string {
+	text := article.Summary
+	if strings.TrimSpace(text) == "" {
+		text = article.Content
+	}
+	text = strings.Join(strings.Fields(text), " ")
+	if utf8.RuneCountInString(text) > shareDescriptionLen {
+		text = string([]rune(text)[:shareDescriptionLen]) + "..."
+	}
+	return text
+}
+
+// absoluteURL 将站内相对路径转换为绝对地址
+func absoluteURL(baseURL, p string) string {
+	if strings.HasPrefix(p, "http://") || strings.HasPrefix(p, "https://") {
+		return p
+	}
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	return baseURL + p
+}
+
+// localImageSize 读取本站静态目录中图片的尺寸
+func localImageSize(baseURL, imageURL string) (int, int, bool) {
+	dir := config.C.Middleware.Static.Dir
+	if dir == "" || !strings.HasPrefix(imageURL, baseURL+"/") {
+		return 0, 0, false
+	}
+
+	f, err := os.Open(filepath.Join(dir, filepath.FromSlash(strings.TrimPrefix(imageURL, baseURL))))
+	if err != nil {
+		return 0, 0, false
+	}
+	defer f.Close()
+
+	cfg, _, err := image.DecodeConfig(f)
+	if err != nil {
+		return 0, 0, false
+	}
+	return cfg.Width, cfg.Height, true
+}
@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
 	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
@@ -13,6 +14,7 @@ import (
 type TagService struct {
 	TagRepository        *dal.TagRepository
 	ArticleTagRepository *dal.ArticleTagRepository
+	TagSuggester         *TagSuggester
 	Trans                *util.Trans
 }
 
@@ -39,6 +41,7 @@ func (s *TagService) CreateTag(ctx context.Context, req *schema.CreateTagRequest
 		if err := s.TagRepository.Create(ctx, tag); err != nil {
 			return nil, err
 		}
+		s.TagSuggester.MarkDirty(ctx)
 
 		// 构造响应数据
 		response := &schema.TagResponse{
@@ -80,6 +83,7 @@ func (s *TagService) UpdateTag(ctx context.Context, id uint, req *schema.UpdateT
 	if err := s.TagRepository.Update(ctx, tag); err != nil {
 		return nil, err
 	}
+	s.TagSuggester.MarkDirty(ctx)
 
 	// 构造响应数据
 	response := &schema.TagResponse{
@@ -95,7 +99,7 @@ func (s *TagService) UpdateTag(ctx context.Context, id uint, req *schema.UpdateT
 
 // DeleteTag 删除标签
 func (s *TagService) DeleteTag(ctx context.Context, id uint) error {
-	return s.Trans.Exec(ctx, func(ctx context.Context) error {
+	err := s.Trans.Exec(ctx, func(ctx context.Context) error {
 		// 先删除关联关系
 		if err := s.ArticleTagRepository.DeleteByTagID(ctx, id); err != nil {
 			return err
@@ -103,6 +107,12 @@ func (s *TagService) DeleteTag(ctx context.Context, id uint) error {
 		// 再删除标签
 		return s.TagRepository.Delete(ctx, id)
 	})
+	if err != nil {
+		return err
+	}
+
+	s.TagSuggester.MarkDirty(ctx)
+	return nil
 }
 
 // GetTagByID 通过ID获取标签
@@ -139,3 +149,15 @@ func (s *TagService) GetTagList(ctx context.Context, params *schema.TagQueryPara
 func (s *TagService) GetHotTags(ctx context.Context, limit int) ([]schema.TagResponse, error) {
 	return s.TagRepository.GetHotTags(ctx, limit)
 }
+
+// SuggestTags 标签联想（前缀、拼音首字母与模糊匹配）
+func (s *TagService) SuggestTags(ctx context.Context, params *schema.TagSuggestParams) []schema.TagResponse {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	if maxLimit := config.C.Blog.TagSuggest.MaxLimit; limit > maxLimit {
+		limit = maxLimit
+	}
+	return s.TagSuggester.Suggest(ctx, params.Keyword, limit)
+}
@@ -0,0 +1,367 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"github.com/mozillazg/go-pinyin"
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/cachex"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+)
+
+// matchKind 标签联想的匹配方式，数值越小优先级越高
+type matchKind int
+
+const (
+	matchExact        matchKind = iota // 名称完全匹配
+	matchNamePrefix                    // 名称前缀匹配
+	matchPinyinPrefix                  // 拼音全拼或首字母前缀匹配
+	matchFuzzy                         // 模糊匹配（按顺序包含输入的所有字符）
+)
+
+// tagRef 前缀树节点对标签的引用
+type tagRef struct {
+	idx  int       // 标签在索引中的下标
+	kind matchKind // 命中该节点的键的匹配方式
+}
+
+// trieNode 前缀树节点
+type trieNode struct {
+	children map[rune]*trieNode
+	refs     []tagRef // 以该节点结尾的键所对应的标签
+}
+
+// tagEntry 索引中的单个标签
+type tagEntry struct {
+	usage   schema.TagUsage
+	name    string   // 小写名称
+	pinyins []string // 拼音全拼、拼音首字母（与名称相同时不重复保存）
+}
+
+// tagIndex 标签联想索引，构建完成后只读
+type tagIndex struct {
+	root    *trieNode
+	entries []*tagEntry
+}
+
+// TagSuggester 标签联想器，基于内存前缀树提供前缀匹配、拼音首字母匹配与模糊匹配
+type TagSuggester struct {
+	index         *atomic.Value `wire:"-"` // 当前使用的联想索引
+	synced        atomic.Int64  `wire:"-"` // 已同步的标签变更标记
+	dirty         atomic.Bool   `wire:"-"` // 本实例的标签发生变更，需要重建索引
+	worker        outbox.Worker `wire:"-"` // 串行执行索引重建，定期检查其他实例的标签变更
+	Cache         cachex.Cacher // 标签变更同步通知
+	TagRepository *dal.TagRepository
+}
+
+// Load 初始化标签联想器
+func (s *TagSuggester) Load(ctx context.Context) error {
+	s.index = new(atomic.Value)
+	if err := s.reload(ctx); err != nil {
+		return err
+	}
+
+	s.worker.Start(ctx, time.Duration(config.C.Blog.TagSuggest.RefreshInterval)*time.Second, s.sync)
+	return nil
+}
+
+// reload 从数据库重新构建联想索引
+func (s *TagSuggester) reload(ctx context.Context) error {
+	usages, err := s.TagRepository.GetAllUsages(ctx)
+	if err != nil {
+		return err
+	}
+
+	s.index.Store(buildTagIndex(usages))
+	logging.Context(ctx).Debug("标签联想索引构建完成", zap.Int("tag_count", len(usages)))
+	return nil
+}
+
+// sync 在本实例标签变更或同步标记更新（用于多实例部署时的同步）时重建索引
+// 由后台任务串行执行，重建前读取同步标记，重建期间发生的变更会在下一次执行时处理
+func (s *TagSuggester) sync(ctx context.Context, _ bool) {
+	updated := s.syncMarker(ctx)
+	if !s.dirty.Swap(false) && s.synced.Load() >= updated {
+		return
+	}
+
+	if err := s.reload(ctx); err != nil {
+		s.dirty.Store(true)
+		logging.Context(ctx).Error("更新标签联想索引失败", zap.Error(err))
+		return
+	}
+	if s.synced.Load() < updated {
+		s.synced.Store(updated)
+	}
+}
+
+// syncMarker 从缓存中获取标签变更同步标记，获取失败时返回 0
+func (s *TagSuggester) syncMarker(ctx context.Context) int64 {
+	val, ok, err := s.Cache.Get(ctx, config.CacheNSForBlog, config.CacheKeyForSyncToTagSuggester)
+	if err != nil {
+		logging.Context(ctx).Error("从缓存中获取标签联想索引的同步标记失败", zap.Error(err),
+			zap.String("cache_key", config.CacheKeyForSyncToTagSuggester))
+		return 0
+	} else if !ok {
+		return 0
+	}
+
+	updated, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		logging.Context(ctx).Error("解析标签联想索引的同步标记失败", zap.Error(err), zap.String("val", val))
+		return 0
+	}
+	return updated
+}
+
+// MarkDirty 通知标签或文章标签关联发生变更，本实例立即异步重建索引，其他实例通过同步标记感知
+func (s *TagSuggester) MarkDirty(ctx context.Context) {
+	if err := s.Cache.Set(ctx, config.CacheNSForBlog, config.CacheKeyForSyncToTagSuggester,
+		fmt.Sprintf("%d", time.Now().Unix())); err != nil {
+		logging.Context(ctx).Error("向缓存中存入标签联想索引同步标记失败", zap.Error(err))
+	}
+
+	s.dirty.Store(true)
+	s.worker.Kick()
+}
+
+// Suggest 根据输入返回联想标签，按匹配方式、使用热度与最近使用时间排序
+func (s *TagSuggester) Suggest(ctx context.Context, keyword string, limit int) []schema.TagResponse {
+	result := make([]schema.TagResponse, 0)
+	if s.index == nil {
+		return result
+	}
+	index, _ := s.index.Load().(*tagIndex)
+	if index == nil {
+		return result
+	}
+
+	query := normalizeTagKey(keyword)
+	if query == "" {
+		return result
+	}
+
+	// 前缀匹配：同一标签取优先级最高的匹配方式
+	kinds := make(map[int]matchKind)
+	if node := index.root.find(query); node != nil {
+		node.walk(func(ref tagRef) {
+			kind := ref.kind
+			if kind == matchNamePrefix && index.entries[ref.idx].name == query {
+				kind = matchExact
+			}
+			if k, ok := kinds[ref.idx]; !ok || kind < k {
+				kinds[ref.idx] = kind
+			}
+		})
+	}
+
+	// 前缀匹配数量不足时补充模糊匹配（单个字符的模糊匹配意义不大，跳过）
+	if len(kinds) < limit && len([]rune(query)) > 1 {
+		for idx, entry := range index.entries {
+			if _, ok := kinds[idx]; ok {
+				continue
+			}
+			if isSubsequence(query, entry.name) {
+				kinds[idx] = matchFuzzy
+				continue
+			}
+			for _, key := range entry.pinyins {
+				if isSubsequence(query, key) {
+					kinds[idx] = matchFuzzy
+					break
+				}
+			}
+		}
+	}
+
+	// 排序
+	now := time.Now()
+	candidates := make([]int, 0, len(kinds))
+	for idx := range kinds {
+		candidates = append(candidates, idx)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := index.entries[candidates[i]], index.entries[candidates[j]]
+		if ka, kb := kinds[candidates[i]], kinds[candidates[j]]; ka != kb {
+			return ka < kb
+		}
+		if ha, hb := a.heat(now), b.heat(now); ha != hb {
+			return ha > hb
+		}
+		if ra, rb := a.recency(), b.recency(); !ra.Equal(rb) {
+			return ra.After(rb)
+		}
+		return a.usage.ID < b.usage.ID
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	for _, idx := range candidates {
+		usage := index.entries[idx].usage
+		result = append(result, schema.TagResponse{
+			ID:           usage.ID,
+			Name:         usage.Name,
+			ArticleCount: usage.ArticleCount,
+			CreatedAt:    usage.CreatedAt,
+			UpdatedAt:    usage.UpdatedAt,
+		})
+	}
+
+	return result
+}
+
+// Release 释放资源
+func (s *TagSuggester) Release(ctx context.Context) error {
+	s.worker.Stop()
+	return nil
+}
+
+// buildTagIndex 根据标签使用情况构建联想索引
+func buildTagIndex(usages []schema.TagUsage) *tagIndex {
+	index := &tagIndex{
+		root:    newTrieNode(),
+		entries: make([]*tagEntry, 0, len(usages)),
+	}
+
+	for _, usage := range usages {
+		name := normalizeTagKey(usage.Name)
+		if name == "" {
+			continue
+		}
+
+		entry := &tagEntry{usage: usage, name: name}
+		idx := len(index.entries)
+		index.entries = append(index.entries, entry)
+		index.root.insert(name, tagRef{idx: idx, kind: matchNamePrefix})
+
+		full, initials := tagPinyinKeys(name)
+		for _, key := range []string{full, initials} {
+			if key == name || key == "" || slices.Contains(entry.pinyins, key) {
+				continue
+			}
+			entry.pinyins = append(entry.pinyins, key)
+			index.root.insert(key, tagRef{idx: idx, kind: matchPinyinPrefix})
+		}
+	}
+
+	return index
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{children: make(map[rune]*trieNode)}
+}
+
+// insert 插入键
+func (n *trieNode) insert(key string, ref tagRef) {
+	node := n
+	for _, r := range key {
+		child, ok := node.children[r]
+		if !ok {
+			child = newTrieNode()
+			node.children[r] = child
+		}
+		node = child
+	}
+	node.refs = append(node.refs, ref)
+}
+
+// find 查找前缀对应的节点
+func (n *trieNode) find(prefix string) *trieNode {
+	node := n
+	for _, r := range prefix {
+		child, ok := node.children[r]
+		if !ok {
+			return nil
+		}
+		node = child
+	}
+	return node
+}
+
+// walk 遍历子树中的所有标签引用
+func (n *trieNode) walk(fn func(ref tagRef)) {
+	for _, ref := range n.refs {
+		fn(ref)
+	}
+	for _, child := range n.children {
+		child.walk(fn)
+	}
+}
+
+// heat 标签热度：文章数量按最近使用时间衰减（每 30 天衰减一半）
+func (e *tagEntry) heat(now time.Time) float64 {
+	days := now.Sub(e.recency()).Hours() / 24
+	if days < 0 {
+		days = 0
+	}
+	return float64(e.usage.ArticleCount) * math.Pow(0.5, days/30)
+}
+
+// recency 标签最近一次被使用的时间，从未被使用时取更新时间
+func (e *tagEntry) recency() time.Time {
+	if e.usage.LastUsedAt != nil && e.usage.LastUsedAt.After(e.usage.UpdatedAt) {
+		return *e.usage.LastUsedAt
+	}
+	return e.usage.UpdatedAt
+}
+
+// normalizeTagKey 统一大小写并去除空白字符
+func normalizeTagKey(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// tagPinyinKeys 生成标签的拼音全拼与首字母键，非汉字字符原样保留
+func tagPinyinKeys(name string) (string, string) {
+	args := pinyin.NewArgs()
+	var full, initials strings.Builder
+	for _, r := range name {
+		if !unicode.Is(unicode.Han, r) {
+			full.WriteRune(r)
+			initials.WriteRune(r)
+			continue
+		}
+		py := pinyin.SinglePinyin(r, args)
+		if len(py) == 0 || py[0] == "" {
+			full.WriteRune(r)
+			initials.WriteRune(r)
+			continue
+		}
+		full.WriteString(py[0])
+		initials.WriteByte(py[0][0])
+	}
+	return full.String(), initials.String()
+}
+
+// isSubsequence 判断 query 中的字符是否按顺序出现在 target 中
+func isSubsequence(query, target string) bool {
+	q := []rune(query)
+	i := 0
+	for _, r := range target {
+		if i < len(q) && q[i] == r {
+			i++
+		}
+	}
+	return i == len(q)
+}
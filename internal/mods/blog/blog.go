@@ -16,10 +16,13 @@ import (
 
 // Blog 博客模块
 type Blog struct {
-	DB              *gorm.DB
-	ArticleHandler  *api.ArticleHandler
-	CategoryHandler *api.CategoryHandler
-	TagHandler      *api.TagHandler
+	DB                    *gorm.DB
+	ArticleHandler        *api.ArticleHandler
+	CategoryHandler       *api.CategoryHandler
+	TagHandler            *api.TagHandler
+	FavoriteFolderHandler *api.FavoriteFolderHandler
+	ShareHandler          *api.ShareHandler
+	PageHandler           *api.PageHandler
 }
 
 // Set 注入博客模块
@@ -40,12 +43,36 @@ var Set = wire.NewSet(
 	wire.Struct(new(api.TagHandler), "*"),
 	wire.Struct(new(biz.TagService), "*"),
 	wire.Struct(new(dal.TagRepository), "*"),
+	wire.Struct(new(biz.TagSuggester), "*"),
+
+	// 文章审核相关结构体
+	wire.Struct(new(dal.ArticleReviewRepository), "*"),
+
+	// 重复文章检测相关结构体
+	wire.Struct(new(dal.ArticleDuplicateRepository), "*"),
 
 	// 文章标签关联相关结构体
 	wire.Struct(new(dal.ArticleTagRepository), "*"),
 
 	// 用户交互相关结构体
 	wire.Struct(new(dal.InteractionRepository), "*"),
+
+	// 收藏夹相关结构体
+	wire.Struct(new(api.FavoriteFolderHandler), "*"),
+	wire.Struct(new(biz.FavoriteFolderService), "*"),
+	wire.Struct(new(dal.FavoriteFolderRepository), "*"),
+
+	// 文章分享相关结构体
+	wire.Struct(new(api.ShareHandler), "*"),
+	wire.Struct(new(biz.ShareService), "*"),
+
+	// 阅读进度相关结构体
+	wire.Struct(new(dal.ReadingProgressRepository), "*"),
+
+	// 独立页面相关结构体
+	wire.Struct(new(api.PageHandler), "*"),
+	wire.Struct(new(biz.PageService), "*"),
+	wire.Struct(new(dal.PageRepository), "*"),
 )
 
 // AutoMigrate 自动迁移数据库
@@ -56,6 +83,13 @@ func (b *Blog) AutoMigrate(ctx context.Context) error {
 		&schema.Tag{},
 		&schema.ArticleTag{},
 		&schema.UserInteraction{},
+		&schema.ReadingProgress{},
+		&schema.FavoriteFolder{},
+		&schema.FavoriteFolderItem{},
+		&schema.ArticleReview{},
+		&schema.ArticleDuplicate{},
+		&schema.Page{},
+		&schema.PageRevision{},
 	)
 }
 
@@ -66,7 +100,19 @@ func (b *Blog) Init(ctx context.Context) error {
 			return err
 		}
 	}
-	return nil
+
+	// 将历史收藏迁移到默认收藏夹
+	if err := b.FavoriteFolderHandler.FavoriteFolderService.MigrateUnfiledFavorites(ctx); err != nil {
+		return err
+	}
+
+	// 为历史文章回填内容指纹
+	if err := b.ArticleHandler.ArticleService.BackfillFingerprints(ctx); err != nil {
+		return err
+	}
+
+	// 构建标签联想索引
+	return b.TagHandler.TagService.TagSuggester.Load(ctx)
 }
 
 // RegisterRouters 注册路由
@@ -85,6 +131,15 @@ func (b *Blog) RegisterRouters(ctx context.Context, blog *gin.RouterGroup) error
 		articles.GET("/liked", b.ArticleHandler.GetUserLikedArticles)
 		articles.GET("/favorites", b.ArticleHandler.GetUserFavoriteArticles)
 		articles.GET("/history", b.ArticleHandler.GetUserViewHistory)
+		articles.GET("/continue", b.ArticleHandler.GetContinueReading)
+		articles.GET("/:id/progress", b.ArticleHandler.GetReadingProgress)
+		articles.POST("/:id/progress", b.ArticleHandler.SaveReadingProgress)
+		articles.GET("/:id/reviews", b.ArticleHandler.GetArticleReviews)
+		// 审核人员接口
+		articles.GET("/review", b.ArticleHandler.GetArticlesForReview)
+		articles.POST("/:id/review", b.ArticleHandler.ReviewArticle)
+		// 管理员接口
+		articles.GET("/duplicates", b.ArticleHandler.GetDuplicateReport)
 		articles.GET("/commented", b.ArticleHandler.GetUserCommentedArticles)
 		articles.GET("/hot", b.ArticleHandler.GetHotArticles)
 		articles.GET("/latest", b.ArticleHandler.GetLatestArticles)
@@ -111,6 +166,39 @@ func (b *Blog) RegisterRouters(ctx context.Context, blog *gin.RouterGroup) error
 		tags.PUT("/:id", b.TagHandler.UpdateTag)
 		tags.DELETE("/:id", b.TagHandler.DeleteTag)
 		tags.GET("/hot", b.TagHandler.GetHotTags)
+		tags.GET("/suggest", b.TagHandler.SuggestTags)
+	}
+
+	// 独立页面接口
+	pages := blog.Group("/pages")
+	{
+		pages.GET("/nav", b.PageHandler.GetNavPages)
+		pages.GET("/slug/:slug", b.PageHandler.GetPageBySlug)
+		// 管理员接口
+		pages.GET("", b.PageHandler.GetPageList)
+		pages.POST("", b.PageHandler.CreatePage)
+		pages.GET("/:id", b.PageHandler.GetPage)
+		pages.PUT("/:id", b.PageHandler.UpdatePage)
+		pages.DELETE("/:id", b.PageHandler.DeletePage)
+		pages.GET("/:id/revisions", b.PageHandler.GetPageRevisions)
+		pages.POST("/:id/revisions/:revision_id/restore", b.PageHandler.RestoreRevision)
+	}
+
+	// oEmbed 接口
+	blog.GET("/oembed", b.ShareHandler.GetOEmbed)
+
+	// 收藏夹接口
+	folders := blog.Group("/folders")
+	{
+		folders.GET("", b.FavoriteFolderHandler.ListFolders)
+		folders.POST("", b.FavoriteFolderHandler.CreateFolder)
+		folders.GET("/user/:user_id", b.FavoriteFolderHandler.ListUserFolders)
+		folders.PUT("/:id", b.FavoriteFolderHandler.UpdateFolder)
+		folders.DELETE("/:id", b.FavoriteFolderHandler.DeleteFolder)
+		folders.GET("/:id/articles", b.FavoriteFolderHandler.GetFolderArticles)
+		folders.POST("/:id/articles", b.FavoriteFolderHandler.AddArticle)
+		folders.PUT("/:id/articles/:article_id", b.FavoriteFolderHandler.MoveArticle)
+		folders.DELETE("/:id/articles/:article_id", b.FavoriteFolderHandler.RemoveArticle)
 	}
 
 	return nil
@@ -118,5 +206,5 @@ func (b *Blog) RegisterRouters(ctx context.Context, blog *gin.RouterGroup) error
 
 // Release 释放资源
 func (b *Blog) Release(ctx context.Context) error {
-	return nil
+	return b.TagHandler.TagService.TagSuggester.Release(ctx)
 }
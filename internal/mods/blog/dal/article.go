@@ -361,7 +361,7 @@ func (r *ArticleRepository) GetUserCommentedArticles(ctx context.Context, userID
 	// 定义子查询SQL，获取每篇文章的最新评论时间
 	commentName := new(commentSchema.Comment).TableName()
 	subQuerySQL := fmt.Sprintf(
-		"(SELECT article_id, MAX(created_at) as latest_comment_time FROM %s WHERE author_id = ? GROUP BY article_id)",
+		"(SELECT article_id, MAX(created_at) as latest_comment_time FROM %s WHERE author_id = ? AND deleted_at IS NULL GROUP BY article_id)",
 		commentName,
 	)
 
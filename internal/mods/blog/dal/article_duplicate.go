@@ -0,0 +1,127 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	userSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/schema"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetArticleDuplicateDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.ArticleDuplicate{})
+}
+
+// ArticleDuplicateRepository 疑似重复文章数据访问层
+type ArticleDuplicateRepository struct {
+	DB *gorm.DB
+}
+
+// GetFingerprints 获取除指定文章外所有已计算指纹的文章
+func (r *ArticleDuplicateRepository) GetFingerprints(ctx context.Context, excludeID uint) ([]schema.ArticleFingerprint, error) {
+	var fingerprints []schema.ArticleFingerprint
+	err := GetArticleDB(ctx, r.DB).Model(&schema.Article{}).
+		Select("id, fingerprint").
+		Where("id <> ? AND fingerprint <> 0", excludeID).
+		Find(&fingerprints).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return fingerprints, nil
+}
+
+// GetArticlesWithoutFingerprint 获取尚未计算指纹的文章（用于回填）
+func (r *ArticleDuplicateRepository) GetArticlesWithoutFingerprint(ctx context.Context, afterID uint, limit int) ([]schema.Article, error) {
+	var articles []schema.Article
+	err := GetArticleDB(ctx, r.DB).Model(&schema.Article{}).
+		Select("id, content").
+		Where("id > ? AND fingerprint = 0", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&articles).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return articles, nil
+}
+
+// UpdateFingerprint 更新文章指纹，不修改文章的更新时间
+func (r *ArticleDuplicateRepository) UpdateFingerprint(ctx context.Context, articleID uint, fingerprint uint64) error {
+	result := GetArticleDB(ctx, r.DB).Model(&schema.Article{}).
+		Where("id = ?", articleID).
+		UpdateColumn("fingerprint", fingerprint)
+	return errors.WithStack(result.Error)
+}
+
+// ReplaceByArticleID 替换文章的疑似重复记录
+func (r *ArticleDuplicateRepository) ReplaceByArticleID(ctx context.Context, articleID uint, duplicates []*schema.ArticleDuplicate) error {
+	if err := GetArticleDuplicateDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.ArticleDuplicate{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+	result := GetArticleDuplicateDB(ctx, r.DB).Create(duplicates)
+	return errors.WithStack(result.Error)
+}
+
+// DeleteByArticleID 删除与文章相关的所有疑似重复记录（包括文章作为被匹配方的记录）
+func (r *ArticleDuplicateRepository) DeleteByArticleID(ctx context.Context, articleID uint) error {
+	result := GetArticleDuplicateDB(ctx, r.DB).
+		Where("article_id = ? OR matched_article_id = ?", articleID, articleID).
+		Delete(&schema.ArticleDuplicate{})
+	return errors.WithStack(result.Error)
+}
+
+// GetReport 获取疑似重复文章报告，按检测时间倒序、相似度倒序排列
+func (r *ArticleDuplicateRepository) GetReport(ctx context.Context, params *schema.ArticleDuplicateListRequest) (*schema.ArticleDuplicatePaginationResult, error) {
+	var result schema.ArticleDuplicatePaginationResult
+
+	duplicateTableName := new(schema.ArticleDuplicate).TableName()
+	articleTableName := new(schema.Article).TableName()
+	userTableName := new(userSchema.User).TableName()
+
+	db := GetArticleDuplicateDB(ctx, r.DB).Table(fmt.Sprintf("%s AS d", duplicateTableName)).
+		Joins(fmt.Sprintf("JOIN %s AS a ON d.article_id = a.id", articleTableName)).
+		Joins(fmt.Sprintf("JOIN %s AS m ON d.matched_article_id = m.id", articleTableName))
+	if params.ArticleID != nil {
+		db = db.Where("d.article_id = ?", *params.ArticleID)
+	}
+	if params.MinSimilarity > 0 {
+		db = db.Where("d.similarity >= ?", params.MinSimilarity)
+	}
+
+	// 计算总数
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 查询数据
+	var items []schema.ArticleDuplicateItem
+	offset := (params.Page - 1) * params.PageSize
+	err := db.Select("d.article_id, a.title AS article_title, a.author_id AS article_author_id, au.username AS article_author, " +
+		"a.status AS article_status, d.matched_article_id, m.title AS matched_title, m.author_id AS matched_author_id, " +
+		"mu.username AS matched_author, m.status AS matched_status, m.created_at AS matched_created_at, " +
+		"d.distance, d.similarity, d.created_at").
+		Joins(fmt.Sprintf("LEFT JOIN %s AS au ON a.author_id = au.id", userTableName)).
+		Joins(fmt.Sprintf("LEFT JOIN %s AS mu ON m.author_id = mu.id", userTableName)).
+		Order("d.created_at DESC, d.similarity DESC").
+		Offset(offset).Limit(params.PageSize).
+		Scan(&items).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = params.Page
+	result.PageSize = params.PageSize
+	result.TotalPages = int((total + int64(params.PageSize) - 1) / int64(params.PageSize))
+
+	return &result, nil
+}
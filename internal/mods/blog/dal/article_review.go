@@ -0,0 +1,122 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetArticleReviewDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.ArticleReview{})
+}
+
+// ArticleReviewRepository 文章审核数据访问层
+type ArticleReviewRepository struct {
+	DB *gorm.DB
+}
+
+// Create 创建审核记录
+func (r *ArticleReviewRepository) Create(ctx context.Context, review *schema.ArticleReview) error {
+	result := GetArticleReviewDB(ctx, r.DB).Create(review)
+	return errors.WithStack(result.Error)
+}
+
+// ListByArticleID 获取文章的审核历史，按时间先后排序
+func (r *ArticleReviewRepository) ListByArticleID(ctx context.Context, articleID uint) ([]schema.ArticleReview, error) {
+	var reviews []schema.ArticleReview
+	err := GetArticleReviewDB(ctx, r.DB).
+		Where("article_id = ?", articleID).
+		Order("created_at ASC, id ASC").
+		Find(&reviews).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return reviews, nil
+}
+
+// DeleteByArticleID 删除文章的审核历史
+func (r *ArticleReviewRepository) DeleteByArticleID(ctx context.Context, articleID uint) error {
+	result := GetArticleReviewDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.ArticleReview{})
+	return errors.WithStack(result.Error)
+}
+
+// GetQueue 获取文章审核队列
+func (r *ArticleReviewRepository) GetQueue(ctx context.Context, params *schema.ArticleReviewListRequest) (*schema.ArticlePaginationResult, error) {
+	var result schema.ArticlePaginationResult
+
+	// 默认值
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+	status := params.Status
+	if status == "" {
+		status = schema.ArticleStatusPending
+	}
+
+	articleName := new(schema.Article).TableName()
+	db := GetArticleDB(ctx, r.DB).Table(fmt.Sprintf("%s AS a", articleName)).
+		Where("a.status = ?", status)
+	if params.AuthorID != nil {
+		db = db.Where("a.author_id = ?", *params.AuthorID)
+	}
+	if params.Keyword != "" {
+		db = db.Where("a.title LIKE ?", "%"+params.Keyword+"%")
+	}
+
+	// 计算总数
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 默认先提交的先审核
+	if params.SortOrder == "desc" {
+		db = db.Order("a.updated_at DESC")
+	} else {
+		db = db.Order("a.updated_at ASC")
+	}
+
+	// 分页
+	offset := (params.Page - 1) * params.PageSize
+	var articles []schema.Article
+	if err := db.Offset(offset).Limit(params.PageSize).Find(&articles).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 构造响应数据
+	var items []*schema.ArticleListItem
+	for _, article := range articles {
+		item := &schema.ArticleListItem{
+			ID:              article.ID,
+			Title:           article.Title,
+			Summary:         article.Summary,
+			AuthorID:        article.AuthorID,
+			CategoryID:      article.CategoryID,
+			Cover:           article.Cover,
+			Status:          article.Status,
+			ViewCount:       article.ViewCount,
+			LikeCount:       article.LikeCount,
+			CommentCount:    article.CommentCount,
+			FavoriteCount:   article.FavoriteCount,
+			CreatedAt:       article.CreatedAt,
+			InteractionTime: article.UpdatedAt,
+		}
+		items = append(items, item)
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = params.Page
+	result.PageSize = params.PageSize
+	result.TotalPages = int((total + int64(params.PageSize) - 1) / int64(params.PageSize))
+
+	return &result, nil
+}
@@ -0,0 +1,309 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetFavoriteFolderDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.FavoriteFolder{})
+}
+
+func GetFavoriteFolderItemDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.FavoriteFolderItem{})
+}
+
+// FavoriteFolderRepository 收藏夹数据访问层
+type FavoriteFolderRepository struct {
+	DB *gorm.DB
+}
+
+// Create 创建收藏夹
+func (r *FavoriteFolderRepository) Create(ctx context.Context, folder *schema.FavoriteFolder) error {
+	result := GetFavoriteFolderDB(ctx, r.DB).Create(folder)
+	return errors.WithStack(result.Error)
+}
+
+// CreateDefault 创建用户的默认收藏夹，并发创建时以唯一索引去重，返回最终生效的默认收藏夹
+func (r *FavoriteFolderRepository) CreateDefault(ctx context.Context, folder *schema.FavoriteFolder) (*schema.FavoriteFolder, error) {
+	folder.IsDefault = true
+	folder.DefaultOf = &folder.UserID
+	result := GetFavoriteFolderDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(folder)
+	if result.Error != nil {
+		return nil, errors.WithStack(result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return folder, nil
+	}
+
+	// 已由其他请求创建，使用锁定读读取最新提交的数据，避免事务快照中看不到该记录
+	var existing schema.FavoriteFolder
+	err := GetFavoriteFolderDB(ctx, r.DB).Clauses(clause.Locking{Strength: "SHARE"}).
+		Where("default_of = ?", folder.UserID).First(&existing).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &existing, nil
+}
+
+// BackfillDefaultOf 为历史默认收藏夹补全唯一标记，同一用户存在多个默认收藏夹时只保留最早创建的一个
+func (r *FavoriteFolderRepository) BackfillDefaultOf(ctx context.Context) error {
+	var folders []schema.FavoriteFolder
+	err := GetFavoriteFolderDB(ctx, r.DB).
+		Where("is_default = ? AND default_of IS NULL", true).
+		Order("id ASC").Find(&folders).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	seen := make(map[uint]bool, len(folders))
+	for _, folder := range folders {
+		updates := map[string]interface{}{"default_of": folder.UserID}
+		if seen[folder.UserID] {
+			updates = map[string]interface{}{"is_default": false}
+		}
+		seen[folder.UserID] = true
+		if err := GetFavoriteFolderDB(ctx, r.DB).Where("id = ?", folder.ID).UpdateColumns(updates).Error; err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return nil
+}
+
+// Update 更新收藏夹
+func (r *FavoriteFolderRepository) Update(ctx context.Context, folder *schema.FavoriteFolder) error {
+	result := GetFavoriteFolderDB(ctx, r.DB).Where("id = ?", folder.ID).Select("*").Omit("created_at").Updates(folder)
+	return errors.WithStack(result.Error)
+}
+
+// Delete 删除收藏夹
+func (r *FavoriteFolderRepository) Delete(ctx context.Context, id uint) error {
+	result := GetFavoriteFolderDB(ctx, r.DB).Where("id = ?", id).Delete(&schema.FavoriteFolder{})
+	return errors.WithStack(result.Error)
+}
+
+// GetByID 通过ID获取收藏夹
+func (r *FavoriteFolderRepository) GetByID(ctx context.Context, id uint) (*schema.FavoriteFolder, error) {
+	var folder schema.FavoriteFolder
+	err := GetFavoriteFolderDB(ctx, r.DB).Where("id = ?", id).First(&folder).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("收藏夹不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &folder, nil
+}
+
+// GetDefault 获取用户的默认收藏夹
+func (r *FavoriteFolderRepository) GetDefault(ctx context.Context, userID uint) (*schema.FavoriteFolder, error) {
+	var folder schema.FavoriteFolder
+	err := GetFavoriteFolderDB(ctx, r.DB).Where("user_id = ? AND is_default = ?", userID, true).First(&folder).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("用户 %d 的默认收藏夹不存在", userID)
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &folder, nil
+}
+
+// ExistsByName 检查用户是否已有同名收藏夹
+func (r *FavoriteFolderRepository) ExistsByName(ctx context.Context, userID uint, name string, excludeID uint) (bool, error) {
+	var count int64
+	db := GetFavoriteFolderDB(ctx, r.DB).Where("user_id = ? AND name = ?", userID, name)
+	if excludeID > 0 {
+		db = db.Where("id <> ?", excludeID)
+	}
+	if err := db.Count(&count).Error; err != nil {
+		return false, errors.WithStack(err)
+	}
+	return count > 0, nil
+}
+
+// ListByUserID 获取用户的收藏夹列表（含文章数量），默认收藏夹排在最前
+func (r *FavoriteFolderRepository) ListByUserID(ctx context.Context, userID uint, onlyPublic bool) ([]schema.FavoriteFolderResponse, error) {
+	result := make([]schema.FavoriteFolderResponse, 0)
+
+	folderName := new(schema.FavoriteFolder).TableName()
+	itemName := new(schema.FavoriteFolderItem).TableName()
+	db := GetFavoriteFolderDB(ctx, r.DB).Table(fmt.Sprintf("%s AS f", folderName)).
+		Select("f.*", "COUNT(i.id) AS article_count").
+		Joins(fmt.Sprintf("LEFT JOIN %s AS i ON f.id = i.folder_id", itemName)).
+		Where("f.user_id = ?", userID)
+	if onlyPublic {
+		db = db.Where("f.is_public = ?", true)
+	}
+
+	err := db.Group("f.id").
+		Order("f.is_default DESC").
+		Order("f.created_at ASC").
+		Scan(&result).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return result, nil
+}
+
+// CountItems 获取收藏夹中的文章数量
+func (r *FavoriteFolderRepository) CountItems(ctx context.Context, folderID uint) int64 {
+	var count int64
+	GetFavoriteFolderItemDB(ctx, r.DB).Where("folder_id = ?", folderID).Count(&count)
+	return count
+}
+
+// CreateItem 将文章放入收藏夹
+func (r *FavoriteFolderRepository) CreateItem(ctx context.Context, item *schema.FavoriteFolderItem) error {
+	result := GetFavoriteFolderItemDB(ctx, r.DB).Create(item)
+	return errors.WithStack(result.Error)
+}
+
+// GetItem 获取用户对文章的收藏所在的收藏夹条目
+func (r *FavoriteFolderRepository) GetItem(ctx context.Context, userID, articleID uint) (*schema.FavoriteFolderItem, error) {
+	var item schema.FavoriteFolderItem
+	err := GetFavoriteFolderItemDB(ctx, r.DB).Where("user_id = ? AND article_id = ?", userID, articleID).First(&item).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("文章不在收藏夹中")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &item, nil
+}
+
+// MoveItem 将收藏移动到另一个收藏夹
+func (r *FavoriteFolderRepository) MoveItem(ctx context.Context, userID, articleID, targetFolderID uint) error {
+	result := GetFavoriteFolderItemDB(ctx, r.DB).
+		Where("user_id = ? AND article_id = ?", userID, articleID).
+		Update("folder_id", targetFolderID)
+	return errors.WithStack(result.Error)
+}
+
+// MoveAllItems 将收藏夹中的所有收藏移动到另一个收藏夹
+func (r *FavoriteFolderRepository) MoveAllItems(ctx context.Context, folderID, targetFolderID uint) error {
+	result := GetFavoriteFolderItemDB(ctx, r.DB).
+		Where("folder_id = ?", folderID).
+		Update("folder_id", targetFolderID)
+	return errors.WithStack(result.Error)
+}
+
+// DeleteItem 将文章从收藏夹中移除
+func (r *FavoriteFolderRepository) DeleteItem(ctx context.Context, userID, articleID uint) error {
+	result := GetFavoriteFolderItemDB(ctx, r.DB).
+		Where("user_id = ? AND article_id = ?", userID, articleID).
+		Delete(&schema.FavoriteFolderItem{})
+	return errors.WithStack(result.Error)
+}
+
+// DeleteItemsByArticleID 删除文章的所有收藏夹条目
+func (r *FavoriteFolderRepository) DeleteItemsByArticleID(ctx context.Context, articleID uint) error {
+	result := GetFavoriteFolderItemDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.FavoriteFolderItem{})
+	return errors.WithStack(result.Error)
+}
+
+// GetUsersWithUnfiledFavorites 获取存在未归入任何收藏夹的收藏的用户
+func (r *FavoriteFolderRepository) GetUsersWithUnfiledFavorites(ctx context.Context) ([]uint, error) {
+	var userIDs []uint
+
+	interactionName := new(schema.UserInteraction).TableName()
+	itemName := new(schema.FavoriteFolderItem).TableName()
+	err := GetInteractionDB(ctx, r.DB).Table(fmt.Sprintf("%s AS u", interactionName)).
+		Distinct("u.user_id").
+		Joins(fmt.Sprintf("LEFT JOIN %s AS i ON u.user_id = i.user_id AND u.article_id = i.article_id", itemName)).
+		Where("u.type = ? AND i.id IS NULL", "favorite").
+		Pluck("u.user_id", &userIDs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return userIDs, nil
+}
+
+// FileUnfiledFavorites 将用户未归入收藏夹的收藏全部放入指定收藏夹，保留原收藏时间
+func (r *FavoriteFolderRepository) FileUnfiledFavorites(ctx context.Context, userID, folderID uint) error {
+	interactionName := new(schema.UserInteraction).TableName()
+	itemName := new(schema.FavoriteFolderItem).TableName()
+	result := util.GetDB(ctx, r.DB).Exec(fmt.Sprintf(
+		"INSERT INTO %s (folder_id, user_id, article_id, created_at) "+
+			"SELECT ?, u.user_id, u.article_id, u.created_at FROM %s AS u "+
+			"LEFT JOIN %s AS i ON u.user_id = i.user_id AND u.article_id = i.article_id "+
+			"WHERE u.user_id = ? AND u.type = ? AND i.id IS NULL",
+		itemName, interactionName, itemName), folderID, userID, "favorite")
+	return errors.WithStack(result.Error)
+}
+
+// GetFolderArticles 获取收藏夹中的文章
+func (r *FavoriteFolderRepository) GetFolderArticles(ctx context.Context, folderID uint, page, pageSize int) (*schema.ArticlePaginationResult, error) {
+	var result schema.ArticlePaginationResult
+
+	// 默认值
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	articleName := new(schema.Article).TableName()
+	db := GetArticleDB(ctx, r.DB).Table(fmt.Sprintf("%s AS a", articleName))
+
+	itemName := new(schema.FavoriteFolderItem).TableName()
+	db = db.Select("a.*", "i.created_at as interaction_time").
+		Joins(fmt.Sprintf("JOIN %s AS i ON a.id = i.article_id", itemName)).
+		Where("i.folder_id = ?", folderID)
+
+	// 计算总数
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	var articles []struct {
+		schema.Article
+		InteractionTime time.Time `json:"interaction_time"`
+	}
+
+	if err := db.Offset(offset).Limit(pageSize).
+		Order("i.created_at DESC").
+		Scan(&articles).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 构造响应数据
+	var items []*schema.ArticleListItem
+	for _, article := range articles {
+		item := &schema.ArticleListItem{
+			ID:              article.ID,
+			Title:           article.Title,
+			Summary:         article.Summary,
+			AuthorID:        article.AuthorID,
+			CategoryID:      article.CategoryID,
+			Cover:           article.Cover,
+			Status:          article.Status,
+			ViewCount:       article.ViewCount,
+			LikeCount:       article.LikeCount,
+			CommentCount:    article.CommentCount,
+			FavoriteCount:   article.FavoriteCount,
+			CreatedAt:       article.CreatedAt,
+			InteractionTime: article.InteractionTime,
+		}
+		items = append(items, item)
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = page
+	result.PageSize = pageSize
+	result.TotalPages = int((total + int64(pageSize) - 1) / int64(pageSize))
+
+	return &result, nil
+}
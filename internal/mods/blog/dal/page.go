@@ -0,0 +1,147 @@
+package dal
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetPageDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.Page{})
+}
+
+func GetPageRevisionDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.PageRevision{})
+}
+
+// PageRepository 独立页面数据访问层
+type PageRepository struct {
+	DB *gorm.DB
+}
+
+// Create 创建页面
+func (r *PageRepository) Create(ctx context.Context, page *schema.Page) error {
+	result := GetPageDB(ctx, r.DB).Create(page)
+	return errors.WithStack(result.Error)
+}
+
+// Update 更新页面
+func (r *PageRepository) Update(ctx context.Context, page *schema.Page) error {
+	result := GetPageDB(ctx, r.DB).Where("id = ?", page.ID).Select("*").Omit("created_at").Updates(page)
+	return errors.WithStack(result.Error)
+}
+
+// Delete 删除页面及其历史版本
+func (r *PageRepository) Delete(ctx context.Context, id uint) error {
+	if err := GetPageRevisionDB(ctx, r.DB).Where("page_id = ?", id).Delete(&schema.PageRevision{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+	result := GetPageDB(ctx, r.DB).Where("id = ?", id).Delete(&schema.Page{})
+	return errors.WithStack(result.Error)
+}
+
+// GetByID 通过ID获取页面
+func (r *PageRepository) GetByID(ctx context.Context, id uint) (*schema.Page, error) {
+	var page schema.Page
+	err := GetPageDB(ctx, r.DB).Where("id = ?", id).First(&page).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("页面不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &page, nil
+}
+
+// GetBySlug 通过页面标识获取页面
+func (r *PageRepository) GetBySlug(ctx context.Context, slug string) (*schema.Page, error) {
+	var page schema.Page
+	err := GetPageDB(ctx, r.DB).Where("slug = ?", slug).First(&page).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("页面不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &page, nil
+}
+
+// GetList 获取页面列表（带分页，按导航顺序排列，不含页面内容）
+func (r *PageRepository) GetList(ctx context.Context, params *schema.PageQueryParams) ([]schema.Page, int64, error) {
+	// 默认值
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+
+	query := GetPageDB(ctx, r.DB)
+	if params.Status != "" {
+		query = query.Where("status = ?", params.Status)
+	}
+	if params.Keyword != "" {
+		keyword := "%" + params.Keyword + "%"
+		query = query.Where("title LIKE ? OR slug LIKE ?", keyword, keyword)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	var pages []schema.Page
+	offset := (params.Page - 1) * params.PageSize
+	err := query.Omit("content").Order("nav_order ASC, id ASC").Offset(offset).Limit(params.PageSize).Find(&pages).Error
+	if err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	return pages, total, nil
+}
+
+// GetNav 获取导航中展示的已发布页面
+func (r *PageRepository) GetNav(ctx context.Context) ([]schema.PageNavItem, error) {
+	var items []schema.PageNavItem
+	err := GetPageDB(ctx, r.DB).
+		Select("title, slug, nav_order").
+		Where("status = ? AND show_in_nav = ?", schema.PageStatusPublished, true).
+		Order("nav_order ASC, id ASC").
+		Scan(&items).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return items, nil
+}
+
+// CreateRevision 记录页面历史版本
+func (r *PageRepository) CreateRevision(ctx context.Context, revision *schema.PageRevision) error {
+	result := GetPageRevisionDB(ctx, r.DB).Create(revision)
+	return errors.WithStack(result.Error)
+}
+
+// GetRevisions 获取页面的历史版本（按版本号倒序）
+func (r *PageRepository) GetRevisions(ctx context.Context, pageID uint) ([]schema.PageRevision, error) {
+	var revisions []schema.PageRevision
+	if err := GetPageRevisionDB(ctx, r.DB).Where("page_id = ?", pageID).Order("version DESC").Find(&revisions).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return revisions, nil
+}
+
+// GetRevision 获取页面的某个历史版本
+func (r *PageRepository) GetRevision(ctx context.Context, pageID, revisionID uint) (*schema.PageRevision, error) {
+	var revision schema.PageRevision
+	err := GetPageRevisionDB(ctx, r.DB).Where("id = ? AND page_id = ?", revisionID, pageID).First(&revision).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("页面历史版本不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &revision, nil
+}
@@ -0,0 +1,132 @@
+package dal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetReadingProgressDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.ReadingProgress{})
+}
+
+// ReadingProgressRepository 阅读进度数据访问层
+type ReadingProgressRepository struct {
+	DB *gorm.DB
+}
+
+// Save 保存阅读进度，只有比已记录的位置更远时才覆盖
+func (r *ReadingProgressRepository) Save(ctx context.Context, progress *schema.ReadingProgress) error {
+	result := GetReadingProgressDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}, {Name: "article_id"}},
+		DoUpdates: clause.Set{
+			{Column: clause.Column{Name: "position"}, Value: gorm.Expr("IF(VALUES(progress) >= progress, VALUES(position), position)")},
+			{Column: clause.Column{Name: "progress"}, Value: gorm.Expr("GREATEST(progress, VALUES(progress))")},
+			{Column: clause.Column{Name: "updated_at"}, Value: gorm.Expr("VALUES(updated_at)")},
+		},
+	}).Create(progress)
+	return errors.WithStack(result.Error)
+}
+
+// Get 获取用户对文章的阅读进度
+func (r *ReadingProgressRepository) Get(ctx context.Context, userID, articleID uint) (*schema.ReadingProgress, error) {
+	var progress schema.ReadingProgress
+	err := GetReadingProgressDB(ctx, r.DB).
+		Where("user_id = ? AND article_id = ?", userID, articleID).
+		First(&progress).Error
+
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("用户 %d 对文章 %d 的阅读进度不存在", userID, articleID)
+		}
+		return nil, errors.WithStack(err)
+	}
+
+	return &progress, nil
+}
+
+// DeleteByArticleID 删除文章的所有阅读进度
+func (r *ReadingProgressRepository) DeleteByArticleID(ctx context.Context, articleID uint) error {
+	result := GetReadingProgressDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.ReadingProgress{})
+	return errors.WithStack(result.Error)
+}
+
+// GetUnfinishedArticles 获取用户未读完的文章（继续阅读），按最近阅读时间倒序
+func (r *ReadingProgressRepository) GetUnfinishedArticles(ctx context.Context, userID uint, page, pageSize int) (*schema.ArticlePaginationResult, error) {
+	var result schema.ArticlePaginationResult
+
+	// 默认值
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	articleName := new(schema.Article).TableName()
+	db := GetArticleDB(ctx, r.DB).Table(fmt.Sprintf("%s AS a", articleName))
+
+	progressName := new(schema.ReadingProgress).TableName()
+	db = db.Select("a.*", "p.updated_at as interaction_time", "p.progress as read_progress", "p.position as read_position").
+		Joins(fmt.Sprintf("JOIN %s AS p ON a.id = p.article_id", progressName)).
+		Where("p.user_id = ? AND p.progress < ? AND a.status = ?", userID, schema.ReadingFinishedProgress, "published")
+
+	// 计算总数
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 分页查询
+	offset := (page - 1) * pageSize
+	var articles []struct {
+		schema.Article
+		InteractionTime time.Time `json:"interaction_time"`
+		ReadProgress    float64   `json:"read_progress"`
+		ReadPosition    int       `json:"read_position"`
+	}
+
+	if err := db.Offset(offset).Limit(pageSize).
+		Order("p.updated_at DESC").
+		Scan(&articles).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 构造响应数据
+	var items []*schema.ArticleListItem
+	for _, article := range articles {
+		item := &schema.ArticleListItem{
+			ID:              article.ID,
+			Title:           article.Title,
+			Summary:         article.Summary,
+			AuthorID:        article.AuthorID,
+			CategoryID:      article.CategoryID,
+			Cover:           article.Cover,
+			Status:          article.Status,
+			ViewCount:       article.ViewCount,
+			LikeCount:       article.LikeCount,
+			CommentCount:    article.CommentCount,
+			FavoriteCount:   article.FavoriteCount,
+			CreatedAt:       article.CreatedAt,
+			InteractionTime: article.InteractionTime,
+			ReadProgress:    article.ReadProgress,
+			ReadPosition:    article.ReadPosition,
+		}
+		items = append(items, item)
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = page
+	result.PageSize = pageSize
+	result.TotalPages = int((total + int64(pageSize) - 1) / int64(pageSize))
+
+	return &result, nil
+}
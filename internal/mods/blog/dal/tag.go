@@ -221,6 +221,25 @@ func (r *TagRepository) GetHotTags(ctx context.Context, limit int) ([]schema.Tag
 	return result, nil
 }
 
+// GetAllUsages 获取所有标签及其文章数量、最近使用时间
+func (r *TagRepository) GetAllUsages(ctx context.Context) ([]schema.TagUsage, error) {
+	var result []schema.TagUsage
+
+	tagName := new(schema.Tag).TableName()
+	articleTagName := new(schema.ArticleTag).TableName()
+	err := GetTagDB(ctx, r.DB).
+		Select(fmt.Sprintf("%s.id, %s.name, COUNT(a.article_id) as article_count, MAX(a.created_at) as last_used_at, %s.created_at, %s.updated_at", tagName, tagName, tagName, tagName)).
+		Joins(fmt.Sprintf("LEFT JOIN %s a ON %s.id = a.tag_id", articleTagName, tagName)).
+		Group(fmt.Sprintf("%s.id, %s.name, %s.created_at, %s.updated_at", tagName, tagName, tagName, tagName)).
+		Scan(&result).Error
+
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return result, nil
+}
+
 // GetTagArticleCount 获取指定标签下的文章数量
 func (r *TagRepository) GetTagArticleCount(ctx context.Context, tagID uint) int {
 	var articleCount int64
@@ -4,24 +4,27 @@ import (
 	"time"
 
 	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	mentionSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
 )
 
 // Article 文章模型
 type Article struct {
-	ID            uint      `json:"id" gorm:"index;primaryKey"`
-	Title         string    `json:"title" gorm:"size:255;not null;comment:文章标题"`
-	Content       string    `json:"content" gorm:"type:text;not null;comment:文章内容"`
-	Summary       string    `json:"summary" gorm:"type:text;comment:文章摘要"`
-	AuthorID      uint      `json:"author_id" gorm:"index;not null;comment:作者ID"`
-	CategoryID    *uint     `json:"category_id" gorm:"index;comment:分类ID"`
-	Cover         string    `json:"cover" gorm:"size:255;comment:封面图URL"`
-	Status        string    `json:"status" gorm:"size:20;not null;default:draft;comment:状态"`
-	ViewCount     int       `json:"view_count" gorm:"default:0;comment:浏览次数"`
-	LikeCount     int       `json:"like_count" gorm:"default:0;comment:点赞次数"`
-	CommentCount  int       `json:"comment_count" gorm:"default:0;comment:评论次数"`
-	FavoriteCount int       `json:"favorite_count" gorm:"default:0;comment:收藏次数"`
-	CreatedAt     time.Time `json:"created_at" gorm:"index;comment:创建时间"`
-	UpdatedAt     time.Time `json:"updated_at" gorm:"comment:更新时间"`
+	ID            uint       `json:"id" gorm:"index;primaryKey"`
+	Title         string     `json:"title" gorm:"size:255;not null;comment:文章标题"`
+	Content       string     `json:"content" gorm:"type:text;not null;comment:文章内容"`
+	Summary       string     `json:"summary" gorm:"type:text;comment:文章摘要"`
+	AuthorID      uint       `json:"author_id" gorm:"index;not null;comment:作者ID"`
+	CategoryID    *uint      `json:"category_id" gorm:"index;comment:分类ID"`
+	Cover         string     `json:"cover" gorm:"size:255;comment:封面图URL"`
+	Status        string     `json:"status" gorm:"size:20;not null;default:draft;comment:状态"`
+	ViewCount     int        `json:"view_count" gorm:"default:0;comment:浏览次数"`
+	LikeCount     int        `json:"like_count" gorm:"default:0;comment:点赞次数"`
+	CommentCount  int        `json:"comment_count" gorm:"default:0;comment:评论次数"`
+	FavoriteCount int        `json:"favorite_count" gorm:"default:0;comment:收藏次数"`
+	Fingerprint   uint64     `json:"-" gorm:"not null;default:0;comment:内容SimHash指纹"`
+	PublishedAt   *time.Time `json:"published_at" gorm:"comment:首次发布时间"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"index;comment:创建时间"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"comment:更新时间"`
 }
 
 func (a *Article) TableName() string {
@@ -30,25 +33,26 @@ func (a *Article) TableName() string {
 
 // ArticleResponse 文章响应结构
 type ArticleResponse struct {
-	ID            uint                 `json:"id"`
-	Title         string               `json:"title"`
-	Content       string               `json:"content"`
-	Summary       string               `json:"summary"`
-	AuthorID      uint                 `json:"author_id"`
-	Author        string               `json:"author,omitempty"`        // 作者名称
-	AuthorAvatar  string               `json:"author_avatar,omitempty"` // 作者头像
-	CategoryID    *uint                `json:"category_id"`
-	CategoryName  string               `json:"category_name,omitempty"` // 分类名称
-	Cover         string               `json:"cover"`
-	Status        string               `json:"status"`
-	ViewCount     int                  `json:"view_count"`
-	LikeCount     int                  `json:"like_count"`
-	CommentCount  int                  `json:"comment_count"`
-	FavoriteCount int                  `json:"favorite_count"`
-	Tags          []uint               `json:"tags,omitempty"` // 标签列表
-	CreatedAt     time.Time            `json:"created_at"`
-	UpdatedAt     time.Time            `json:"updated_at"`
-	Interactions  *InteractionResponse `json:"interactions,omitempty"` // 交互状态
+	ID            uint                          `json:"id"`
+	Title         string                        `json:"title"`
+	Content       string                        `json:"content"`
+	Summary       string                        `json:"summary"`
+	AuthorID      uint                          `json:"author_id"`
+	Author        string                        `json:"author,omitempty"`        // 作者名称
+	AuthorAvatar  string                        `json:"author_avatar,omitempty"` // 作者头像
+	CategoryID    *uint                         `json:"category_id"`
+	CategoryName  string                        `json:"category_name,omitempty"` // 分类名称
+	Cover         string                        `json:"cover"`
+	Status        string                        `json:"status"`
+	ViewCount     int                           `json:"view_count"`
+	LikeCount     int                           `json:"like_count"`
+	CommentCount  int                           `json:"comment_count"`
+	FavoriteCount int                           `json:"favorite_count"`
+	Tags          []uint                        `json:"tags,omitempty"` // 标签列表
+	CreatedAt     time.Time                     `json:"created_at"`
+	UpdatedAt     time.Time                     `json:"updated_at"`
+	Interactions  *InteractionResponse          `json:"interactions,omitempty"` // 交互状态
+	Mentions      []mentionSchema.MentionedUser `json:"mentions,omitempty"`     // 正文中被提及的用户
 }
 
 // ArticleListItem 文章列表项
@@ -67,9 +71,11 @@ type ArticleListItem struct {
 	LikeCount       int       `json:"like_count"`
 	CommentCount    int       `json:"comment_count"`
 	FavoriteCount   int       `json:"favorite_count"`
-	Tags            []uint     `json:"tags,omitempty"` // 标签列表
+	Tags            []uint    `json:"tags,omitempty"` // 标签列表
 	CreatedAt       time.Time `json:"created_at"`
 	InteractionTime time.Time `json:"interaction_time,omitempty"` // 交互时间（用于历史记录等）
+	ReadProgress    float64   `json:"read_progress,omitempty"`    // 阅读进度（用于继续阅读）
+	ReadPosition    int       `json:"read_position,omitempty"`    // 滚动位置（用于继续阅读）
 }
 
 // CreateArticleRequest 创建文章请求
@@ -101,7 +107,7 @@ type ArticleQueryParams struct {
 	CategoryIDs []uint `form:"category_ids" binding:"omitempty,dive,min=1"`
 	TagIDs      []uint `form:"tag_ids" binding:"omitempty,dive,min=1"`
 	Author      string `form:"author"`
-	Status      string `form:"status" binding:"omitempty,oneof=published draft"`
+	Status      string `form:"status" binding:"omitempty,oneof=published draft pending rejected changes_requested"`
 	SortBy      string `form:"sort_by" binding:"omitempty,oneof=newest views likes favorites comments"`
 	Keyword     string `form:"keyword"`
 	TimeRange   string `form:"time_range" binding:"omitempty,oneof=today week month year all"`
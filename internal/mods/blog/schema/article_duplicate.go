@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// ArticleDuplicate 疑似重复文章记录，每篇文章保留与其最相似的若干篇已有文章
+type ArticleDuplicate struct {
+	ID               uint      `json:"id" gorm:"primaryKey"`
+	ArticleID        uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_article_matched;comment:被检测的文章ID"`
+	MatchedArticleID uint      `json:"matched_article_id" gorm:"not null;uniqueIndex:idx_article_matched;index;comment:相似的已有文章ID"`
+	Distance         int       `json:"distance" gorm:"not null;comment:指纹汉明距离"`
+	Similarity       float64   `json:"similarity" gorm:"not null;index;comment:相似度"`
+	CreatedAt        time.Time `json:"created_at" gorm:"index;comment:检测时间"`
+}
+
+// TableName 表名
+func (a *ArticleDuplicate) TableName() string {
+	return config.C.FormatTableName("article_duplicate")
+}
+
+// ArticleFingerprint 文章指纹（用于相似度比对）
+type ArticleFingerprint struct {
+	ID          uint   `json:"id"`
+	Fingerprint uint64 `json:"fingerprint"`
+}
+
+// ArticleDuplicateListRequest 疑似重复文章报告请求
+type ArticleDuplicateListRequest struct {
+	ArticleID     *uint   `form:"article_id" binding:"omitempty,min=1"`
+	MinSimilarity float64 `form:"min_similarity" binding:"omitempty,min=0,max=1"`
+	Page          int     `form:"page" binding:"omitempty,min=1"`
+	PageSize      int     `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
+
+// ArticleDuplicateItem 疑似重复文章报告项
+type ArticleDuplicateItem struct {
+	ArticleID        uint      `json:"article_id"`
+	ArticleTitle     string    `json:"article_title"`
+	ArticleAuthorID  uint      `json:"article_author_id"`
+	ArticleAuthor    string    `json:"article_author"`
+	ArticleStatus    string    `json:"article_status"`
+	MatchedArticleID uint      `json:"matched_article_id"`
+	MatchedTitle     string    `json:"matched_title"`
+	MatchedAuthorID  uint      `json:"matched_author_id"`
+	MatchedAuthor    string    `json:"matched_author"`
+	MatchedStatus    string    `json:"matched_status"`
+	MatchedCreatedAt time.Time `json:"matched_created_at"`
+	Distance         int       `json:"distance"`
+	Similarity       float64   `json:"similarity"`
+	CreatedAt        time.Time `json:"created_at"` // 检测时间
+}
+
+// ArticleDuplicatePaginationResult 疑似重复文章报告分页结果
+type ArticleDuplicatePaginationResult struct {
+	Items      []ArticleDuplicateItem `json:"items"`
+	Total      int64                  `json:"total"`
+	Page       int                    `json:"page"`
+	PageSize   int                    `json:"page_size"`
+	TotalPages int                    `json:"total_pages"`
+}
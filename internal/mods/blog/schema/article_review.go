@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 文章状态常量
+const (
+	ArticleStatusDraft            = "draft"             // 草稿
+	ArticleStatusPublished        = "published"         // 已发布
+	ArticleStatusPending          = "pending"           // 待审核
+	ArticleStatusRejected         = "rejected"          // 审核拒绝
+	ArticleStatusChangesRequested = "changes_requested" // 需要修改
+)
+
+// 文章审核操作常量
+const (
+	ArticleReviewActionSubmit         = "submit"          // 作者提交审核
+	ArticleReviewActionApprove        = "approve"         // 审核通过
+	ArticleReviewActionReject         = "reject"          // 审核拒绝
+	ArticleReviewActionRequestChanges = "request_changes" // 要求修改
+)
+
+// ArticleReview 文章审核记录模型
+type ArticleReview struct {
+	ID         uint      `json:"id" gorm:"primaryKey"`
+	ArticleID  uint      `json:"article_id" gorm:"not null;index;comment:文章ID"`
+	OperatorID uint      `json:"operator_id" gorm:"not null;index;comment:操作人ID（提交时为作者，审核时为审核员）"`
+	Action     string    `json:"action" gorm:"size:20;not null;comment:审核操作"`
+	Remark     string    `json:"remark" gorm:"type:varchar(255);comment:审核备注"`
+	CreatedAt  time.Time `json:"created_at" gorm:"index;comment:创建时间"`
+}
+
+// TableName 表名
+func (a *ArticleReview) TableName() string {
+	return config.C.FormatTableName("article_review")
+}
+
+// ArticleReviewResponse 文章审核记录响应结构
+type ArticleReviewResponse struct {
+	ID             uint      `json:"id"`
+	ArticleID      uint      `json:"article_id"`
+	OperatorID     uint      `json:"operator_id"`
+	OperatorName   string    `json:"operator_name,omitempty"`   // 操作人名称
+	OperatorAvatar string    `json:"operator_avatar,omitempty"` // 操作人头像
+	Action         string    `json:"action"`
+	Remark         string    `json:"remark,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// ReviewArticleRequest 文章审核请求
+type ReviewArticleRequest struct {
+	Action string `json:"action" binding:"required,oneof=approve reject request_changes"` // 审核操作：approve-通过，reject-拒绝，request_changes-要求修改
+	Remark string `json:"remark" binding:"max=255"`                                       // 审核备注，拒绝或要求修改时必填
+}
+
+// ArticleReviewListRequest 文章审核队列查询请求
+type ArticleReviewListRequest struct {
+	Status    string `form:"status" binding:"omitempty,oneof=pending rejected changes_requested"` // 文章状态，默认为待审核
+	AuthorID  *uint  `form:"author_id"`                                                           // 作者ID
+	Keyword   string `form:"keyword"`                                                             // 标题关键词
+	SortOrder string `form:"sort_order" binding:"omitempty,oneof=desc asc"`                       // 按提交时间排序，默认先提交先审核
+	Page      int    `form:"page" binding:"omitempty,min=1"`
+	PageSize  int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// DefaultFavoriteFolderName 默认收藏夹名称
+const DefaultFavoriteFolderName = "默认收藏夹"
+
+// FavoriteFolder 收藏夹模型
+type FavoriteFolder struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	UserID      uint      `json:"user_id" gorm:"not null;index;comment:所属用户ID"`
+	Name        string    `json:"name" gorm:"size:50;not null;comment:收藏夹名称"`
+	Description string    `json:"description" gorm:"size:255;comment:收藏夹描述"`
+	IsPublic    bool      `json:"is_public" gorm:"not null;default:false;comment:是否公开"`
+	IsDefault   bool      `json:"is_default" gorm:"not null;default:false;comment:是否为默认收藏夹"`
+	DefaultOf   *uint     `json:"-" gorm:"uniqueIndex;comment:默认收藏夹所属用户ID,非默认收藏夹为空,保证每个用户只有一个默认收藏夹"`
+	CreatedAt   time.Time `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt   time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *FavoriteFolder) TableName() string {
+	return config.C.FormatTableName("favorite_folder")
+}
+
+// FavoriteFolderItem 收藏夹中的文章，每条收藏只属于一个收藏夹
+type FavoriteFolderItem struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	FolderID  uint      `json:"folder_id" gorm:"not null;index;comment:收藏夹ID"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_user_article;comment:用户ID"`
+	ArticleID uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_user_article;index;comment:文章ID"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *FavoriteFolderItem) TableName() string {
+	return config.C.FormatTableName("favorite_folder_item")
+}
+
+// FavoriteFolderResponse 收藏夹响应结构
+type FavoriteFolderResponse struct {
+	ID           uint      `json:"id"`
+	UserID       uint      `json:"user_id"`
+	Name         string    `json:"name"`
+	Description  string    `json:"description"`
+	IsPublic     bool      `json:"is_public"`
+	IsDefault    bool      `json:"is_default"`
+	ArticleCount int64     `json:"article_count"` // 收藏夹中的文章数量
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// CreateFavoriteFolderRequest 创建收藏夹请求
+type CreateFavoriteFolderRequest struct {
+	Name        string `json:"name" binding:"required,max=50"`
+	Description string `json:"description" binding:"max=255"`
+	IsPublic    bool   `json:"is_public"`
+}
+
+// UpdateFavoriteFolderRequest 更新收藏夹请求
+type UpdateFavoriteFolderRequest struct {
+	Name        string `json:"name" binding:"omitempty,max=50"`
+	Description string `json:"description" binding:"max=255"`
+	IsPublic    *bool  `json:"is_public"`
+}
+
+// AddFavoriteFolderItemRequest 向收藏夹添加文章请求
+type AddFavoriteFolderItemRequest struct {
+	ArticleID uint `json:"article_id" binding:"required"`
+}
+
+// MoveFavoriteFolderItemRequest 移动收藏夹中的文章请求
+type MoveFavoriteFolderItemRequest struct {
+	TargetFolderID uint `json:"target_folder_id" binding:"required"`
+}
@@ -0,0 +1,132 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 独立页面状态常量
+const (
+	PageStatusDraft     = "draft"     // 草稿
+	PageStatusPublished = "published" // 已发布
+)
+
+// Page 独立页面模型（如关于、友链、隐私政策），不属于文章，不出现在文章列表与统计中
+type Page struct {
+	ID        uint      `json:"id" gorm:"index;primaryKey"`
+	Title     string    `json:"title" gorm:"size:255;not null;comment:页面标题"`
+	Slug      string    `json:"slug" gorm:"size:100;not null;uniqueIndex;comment:页面标识,用于访问路径"`
+	Content   string    `json:"content" gorm:"type:text;not null;comment:页面内容(Markdown)"`
+	NavOrder  int       `json:"nav_order" gorm:"not null;default:0;index;comment:导航排序,越小越靠前"`
+	ShowInNav bool      `json:"show_in_nav" gorm:"not null;default:true;comment:是否显示在导航中"`
+	Status    string    `json:"status" gorm:"size:20;not null;default:draft;index;comment:状态"`
+	Version   int       `json:"version" gorm:"not null;default:1;comment:当前版本号"`
+	EditorID  uint      `json:"editor_id" gorm:"not null;comment:最后编辑人ID"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *Page) TableName() string {
+	return config.C.FormatTableName("page")
+}
+
+// PageRevision 独立页面的历史版本，每次创建、更新或恢复都会记录一个版本
+type PageRevision struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	PageID    uint      `json:"page_id" gorm:"not null;uniqueIndex:idx_page_version;comment:页面ID"`
+	Version   int       `json:"version" gorm:"not null;uniqueIndex:idx_page_version;comment:版本号"`
+	Title     string    `json:"title" gorm:"size:255;not null;comment:页面标题"`
+	Slug      string    `json:"slug" gorm:"size:100;not null;comment:页面标识"`
+	Content   string    `json:"content" gorm:"type:text;not null;comment:页面内容(Markdown)"`
+	EditorID  uint      `json:"editor_id" gorm:"not null;comment:编辑人ID"`
+	Remark    string    `json:"remark" gorm:"size:255;comment:修改说明"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *PageRevision) TableName() string {
+	return config.C.FormatTableName("page_revision")
+}
+
+// PageResponse 独立页面响应结构
+type PageResponse struct {
+	ID        uint      `json:"id"`
+	Title     string    `json:"title"`
+	Slug      string    `json:"slug"`
+	Content   string    `json:"content,omitempty"`
+	NavOrder  int       `json:"nav_order"`
+	ShowInNav bool      `json:"show_in_nav"`
+	Status    string    `json:"status"`
+	Version   int       `json:"version"`
+	EditorID  uint      `json:"editor_id"`
+	Editor    string    `json:"editor,omitempty"` // 最后编辑人名称
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// PageNavItem 导航中的独立页面
+type PageNavItem struct {
+	Title    string `json:"title"`
+	Slug     string `json:"slug"`
+	NavOrder int    `json:"nav_order"`
+}
+
+// PageRevisionResponse 独立页面历史版本响应结构
+type PageRevisionResponse struct {
+	ID        uint      `json:"id"`
+	PageID    uint      `json:"page_id"`
+	Version   int       `json:"version"`
+	Title     string    `json:"title"`
+	Slug      string    `json:"slug"`
+	Content   string    `json:"content"`
+	EditorID  uint      `json:"editor_id"`
+	Editor    string    `json:"editor,omitempty"` // 编辑人名称
+	Remark    string    `json:"remark"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreatePageRequest 创建独立页面请求
+type CreatePageRequest struct {
+	Title     string `json:"title" binding:"required,max=255"`
+	Slug      string `json:"slug" binding:"required,max=100"`
+	Content   string `json:"content" binding:"required"`
+	NavOrder  int    `json:"nav_order"`
+	ShowInNav *bool  `json:"show_in_nav"`                                      // 不传时默认显示在导航中
+	Status    string `json:"status" binding:"omitempty,oneof=published draft"` // 不传时为草稿
+	Remark    string `json:"remark" binding:"max=255"`                         // 修改说明
+}
+
+// UpdatePageRequest 更新独立页面请求，未传入的字段保持不变
+type UpdatePageRequest struct {
+	Title     string `json:"title" binding:"max=255"`
+	Slug      string `json:"slug" binding:"max=100"`
+	Content   string `json:"content"`
+	NavOrder  *int   `json:"nav_order"`
+	ShowInNav *bool  `json:"show_in_nav"`
+	Status    string `json:"status" binding:"omitempty,oneof=published draft"`
+	Remark    string `json:"remark" binding:"max=255"` // 修改说明
+}
+
+// RestorePageRevisionRequest 恢复历史版本请求
+type RestorePageRevisionRequest struct {
+	Remark string `json:"remark" binding:"max=255"` // 修改说明
+}
+
+// PageQueryParams 独立页面查询请求
+type PageQueryParams struct {
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Status   string `form:"status" binding:"omitempty,oneof=published draft"`
+	Keyword  string `form:"keyword"`
+}
+
+// PagePaginationResult 独立页面分页结果
+type PagePaginationResult struct {
+	Items      []PageResponse `json:"items"`
+	Total      int64          `json:"total"`
+	Page       int            `json:"page"`
+	PageSize   int            `json:"page_size"`
+	TotalPages int            `json:"total_pages"`
+}
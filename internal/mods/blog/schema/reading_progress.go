@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// ReadingFinishedProgress 阅读进度达到该百分比即视为读完
+const ReadingFinishedProgress = 95
+
+// ReadingProgress 用户文章阅读进度模型，只保存用户读到的最远位置
+type ReadingProgress struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_user_article;comment:用户ID"`
+	ArticleID uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_user_article;index;comment:文章ID"`
+	Progress  float64   `json:"progress" gorm:"not null;default:0;comment:阅读百分比"`
+	Position  int       `json:"position" gorm:"not null;default:0;comment:滚动位置"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"index;comment:更新时间"`
+}
+
+// TableName 表名
+func (a *ReadingProgress) TableName() string {
+	return config.C.FormatTableName("reading_progress")
+}
+
+// ReadingProgressRequest 上报阅读进度请求
+type ReadingProgressRequest struct {
+	Progress float64 `json:"progress" binding:"min=0,max=100"` // 阅读百分比
+	Position int     `json:"position" binding:"min=0"`         // 滚动位置（像素）
+}
+
+// ReadingProgressResponse 阅读进度响应
+type ReadingProgressResponse struct {
+	ArticleID uint       `json:"article_id"`
+	Progress  float64    `json:"progress"`
+	Position  int        `json:"position"`
+	Finished  bool       `json:"finished"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"` // 从未阅读时为空
+}
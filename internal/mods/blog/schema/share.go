@@ -0,0 +1,70 @@
+package schema
+
+import (
+	"encoding/xml"
+	"strconv"
+	"strings"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// oEmbed 相关常量
+const (
+	OEmbedVersion    = "1.0"
+	OEmbedTypeLink   = "link"
+	OEmbedFormatJSON = "json"
+	OEmbedFormatXML  = "xml"
+)
+
+// OEmbedRequest oEmbed 请求
+type OEmbedRequest struct {
+	URL       string `form:"url" binding:"required,url"`
+	MaxWidth  int    `form:"maxwidth" binding:"omitempty,min=1"`
+	MaxHeight int    `form:"maxheight" binding:"omitempty,min=1"`
+	Format    string `form:"format"`
+}
+
+// OEmbedResponse oEmbed 响应
+type OEmbedResponse struct {
+	XMLName         xml.Name `json:"-" xml:"oembed"`
+	Type            string   `json:"type" xml:"type"`
+	Version         string   `json:"version" xml:"version"`
+	Title           string   `json:"title,omitempty" xml:"title,omitempty"`
+	AuthorName      string   `json:"author_name,omitempty" xml:"author_name,omitempty"`
+	ProviderName    string   `json:"provider_name,omitempty" xml:"provider_name,omitempty"`
+	ProviderURL     string   `json:"provider_url,omitempty" xml:"provider_url,omitempty"`
+	CacheAge        int      `json:"cache_age,omitempty" xml:"cache_age,omitempty"`
+	ThumbnailURL    string   `json:"thumbnail_url,omitempty" xml:"thumbnail_url,omitempty"`
+	ThumbnailWidth  int      `json:"thumbnail_width,omitempty" xml:"thumbnail_width,omitempty"`
+	ThumbnailHeight int      `json:"thumbnail_height,omitempty" xml:"thumbnail_height,omitempty"`
+}
+
+// ShareMeta 文章分享元数据（用于生成 Open Graph 与 Twitter Card 标签）
+type ShareMeta struct {
+	URL         string
+	Title       string
+	Description string
+	Image       string
+	Author      string
+	SiteName    string
+	PublishedAt string
+	ModifiedAt  string
+}
+
+// ArticleURL 文章详情页的对外访问地址，baseURL 为站点对外访问地址
+func ArticleURL(baseURL string, articleID uint) string {
+	return baseURL + config.C.Blog.Share.ArticlePath + strconv.FormatUint(uint64(articleID), 10)
+}
+
+// ParseArticlePath 从前端文章详情页路径中解析文章ID
+func ParseArticlePath(path string) (uint, bool) {
+	prefix := config.C.Blog.Share.ArticlePath
+	if prefix == "" || !strings.HasPrefix(path, prefix) {
+		return 0, false
+	}
+	id, err := strconv.ParseUint(strings.Trim(path[len(prefix):], "/"), 10, 32)
+	if err != nil || id == 0 {
+		return 0, false
+	}
+	return uint(id), true
+}
@@ -38,6 +38,22 @@ type UpdateTagRequest struct {
 	Name string `json:"name" binding:"required"`
 }
 
+// TagSuggestParams 标签联想请求
+type TagSuggestParams struct {
+	Keyword string `form:"keyword" binding:"required,max=50"`
+	Limit   int    `form:"limit" binding:"omitempty,min=1"`
+}
+
+// TagUsage 标签使用情况（用于构建联想索引）
+type TagUsage struct {
+	ID           uint       `json:"id"`
+	Name         string     `json:"name"`
+	ArticleCount int        `json:"article_count"`
+	LastUsedAt   *time.Time `json:"last_used_at"` // 最近一次被文章引用的时间
+	CreatedAt    time.Time  `json:"created_at"`
+	UpdatedAt    time.Time  `json:"updated_at"`
+}
+
 // TagQueryParams 标签查询请求
 type TagQueryParams struct {
 	Page               int    `form:"page" binding:"omitempty,min=1"`
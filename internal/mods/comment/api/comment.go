@@ -24,14 +24,16 @@ type CommentHandler struct {
 // @Param parent_id query uint false "父评论ID"
 // @Param root_id query uint false "根评论ID"
 // @Param level query int false "评论层级"
-// @Param status query int false "评论状态：0-待审核，1-已通过，2-已拒绝" Enums(0, 1, 2)
+// @Param status query int false "评论状态：0-待审核，1-已通过，2-已拒绝，3-访客邮箱待验证" Enums(0, 1, 2, 3)
 // @Param keyword query string false "关键词"
 // @Param create_start_time query string false "创建开始时间，格式：2006-01-02 15:04:05"
 // @Param create_end_time query string false "创建结束时间，格式：2006-01-02 15:04:05"
 // @Param review_start_time query string false "审核开始时间，格式：2006-01-02 15:04:05"
 // @Param review_end_time query string false "审核结束时间，格式：2006-01-02 15:04:05"
 // @Param reviewer_id query uint false "审核人员ID"
-// @Param sort_by query string false "排序字段：create-创建时间，review-审核时间" Enums(create, review) default(create)
+// @Param min_spam_score query int false "最低垃圾评论评分" minimum(0) maximum(100)
+// @Param unreviewed query bool false "只查询尚未经审核的评论（待审核及先发后审的评论）"
+// @Param sort_by query string false "排序字段：create-创建时间，review-审核时间，spam-垃圾评论评分" Enums(create, review, spam) default(create)
 // @Param sort_order query string false "排序方式：desc-降序，asc-升序" Enums(desc, asc) default(desc)
 // @Param page query int true "页码" minimum(1) default(1)
 // @Param page_size query int true "页容量" minimum(1) maximum(100) default(10)
@@ -58,10 +60,11 @@ func (h *CommentHandler) GetCommentsForReview(c *gin.Context) {
 
 // @Tags CommentAPI
 // @Security ApiKeyAuth
-// @Summary 审核评论（仅管理员可用）
+// @Summary 审核评论，指定 rereview 时可修改已审核评论的审核结论（仅管理员可用）
 // @Param comment_id body uint true "评论ID" minimum(1)
 // @Param status body int true "审核状态：1-通过，2-拒绝" Enums(1, 2)
 // @Param review_remark body string false "审核备注"
+// @Param rereview body bool false "是否修改已审核评论的审核结论"
 // @Success 200 {object} util.ResponseResult
 // @Failure 400 {object} util.ResponseResult
 // @Failure 404 {object} util.ResponseResult
@@ -85,11 +88,39 @@ func (h *CommentHandler) ReviewComment(c *gin.Context) {
 	util.ResOK(c)
 }
 
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 批量审核评论，按评论ID或按审核列表的筛选条件选择评论，在同一事务中审核（仅管理员可用）
+// @Param body body schema.BatchReviewCommentRequest true "批量审核请求"
+// @Success 200 {object} util.ResponseResult{data=schema.BatchReviewCommentResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/review/batch [post]
+func (h *CommentHandler) BatchReviewComments(c *gin.Context) {
+	var req schema.BatchReviewCommentRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.CommentService.BatchReviewComments(ctx, userID, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
 // @Tags CommentAPI
 // @Summary 获取文章的顶级评论
 // @Param article_id path uint true "文章ID" minimum(1)
 // @Param page query int false "页码" minimum(1) default(1)
 // @Param page_size query int false "页容量" minimum(1) maximum(30) default(10)
+// @Param sort_by query string false "排序字段：create-创建时间，top-表态总数" Enums(create, top) default(create)
 // @Param sort_by_create query string false "排序方式" Enums(asc, desc) default(desc)
 // @Success 200 {object} util.ResponseResult{data=schema.CommentPaginationResult}
 // @Failure 400 {object} util.ResponseResult
@@ -121,6 +152,45 @@ func (h *CommentHandler) GetArticleComments(c *gin.Context) {
 	util.ResSuccess(c, data)
 }
 
+// @Tags CommentAPI
+// @Summary 获取文章的评论树（根评论分页，每条根评论附带嵌套的回复，未展示的回复以 more_replies 标记）
+// @Param article_id path uint true "文章ID" minimum(1)
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "页容量" minimum(1) maximum(30) default(10)
+// @Param sort_by query string false "根评论排序字段：create-创建时间，top-表态总数" Enums(create, top) default(create)
+// @Param sort_by_create query string false "根评论排序方式" Enums(asc, desc) default(desc)
+// @Param max_depth query int false "最大递归深度，未指定时为 5" minimum(0) maximum(20) default(5)
+// @Param max_children query int false "每条评论最多展示的直接回复数" minimum(1) maximum(100) default(10)
+// @Success 200 {object} util.ResponseResult{data=schema.CommentTreeResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/article/{article_id}/tree [get]
+func (h *CommentHandler) GetCommentTree(c *gin.Context) {
+	// 解析文章ID（路径参数）
+	articleID, err := strconv.ParseUint(c.Param("article_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	// 绑定并验证查询参数
+	var req schema.CommentTreeRequest
+	if err := util.ParseQuery(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	// 查询数据
+	ctx := c.Request.Context()
+	data, err := h.CommentService.GetCommentTree(ctx, uint(articleID), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
 // @Tags CommentAPI
 // @Summary 获取评论详情
 // @Param id path uint true "评论ID" minimum(1)
@@ -148,7 +218,7 @@ func (h *CommentHandler) GetComment(c *gin.Context) {
 
 // @Tags CommentAPI
 // @Security ApiKeyAuth
-// @Summary 创建评论（会增加文章评论数、响应刚创建评论的全部信息）
+// @Summary 创建评论（非管理员的评论按垃圾评论评分自动通过、自动拒绝或进入待审核，响应刚创建评论的全部信息）
 // @Param article_id body uint true "文章ID" minimum(1)
 // @Param content body string true "评论内容"
 // @Param parent_id body integer false "父评论ID" minimum(1)
@@ -178,7 +248,7 @@ func (h *CommentHandler) CreateComment(c *gin.Context) {
 
 // @Tags CommentAPI
 // @Security ApiKeyAuth
-// @Summary 删除评论（回复也会被删除、文章评论数也会减少）
+// @Summary 删除评论（仍有回复时保留为"该评论已删除"的占位，否则直接删除）
 // @Param id path uint true "评论ID" minimum(1)
 // @Success 200 {object} util.ResponseResult
 // @Failure 400 {object} util.ResponseResult
@@ -270,3 +340,98 @@ func (h *CommentHandler) GetUserComments(c *gin.Context) {
 
 	util.ResSuccess(c, data)
 }
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 对评论表态/取消表态（点赞及固定的表情集合）
+// @Param id path uint true "评论ID" minimum(1)
+// @Param body body schema.ReactCommentRequest true "表态类型：like-👍，heart-❤️，laugh-😄，hooray-🎉，confused-😕"
+// @Success 200 {object} util.ResponseResult{data=schema.CommentReactionResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/{id}/reactions [post]
+func (h *CommentHandler) ReactComment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的评论ID"))
+		return
+	}
+
+	var req schema.ReactCommentRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.CommentService.ReactComment(ctx, userID, uint(id), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 编辑评论（仅作者可在发表后的编辑时限内编辑，编辑前的内容会保留为历史版本）
+// @Param id path uint true "评论ID" minimum(1)
+// @Param body body schema.UpdateCommentRequest true "评论内容"
+// @Success 200 {object} util.ResponseResult{data=schema.CommentResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/{id} [put]
+func (h *CommentHandler) UpdateComment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的评论ID"))
+		return
+	}
+
+	var req schema.UpdateCommentRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.CommentService.UpdateComment(ctx, userID, uint(id), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 获取评论的历史版本（仅管理员可用）
+// @Param id path uint true "评论ID" minimum(1)
+// @Success 200 {object} util.ResponseResult{data=[]schema.CommentRevisionResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/{id}/revisions [get]
+func (h *CommentHandler) GetCommentRevisions(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的评论ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.CommentService.GetCommentRevisions(ctx, uint(id))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
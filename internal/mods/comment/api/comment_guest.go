@@ -0,0 +1,115 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// @Tags CommentAPI
+// @Summary 以访客身份发表评论（需验证码），评论在访客通过邮件中的链接验证邮箱后才进入审核
+// @Param body body schema.CreateGuestCommentRequest true "访客评论"
+// @Success 200 {object} util.ResponseResult{data=schema.CommentResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 409 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/guest [post]
+func (h *CommentHandler) CreateGuestComment(c *gin.Context) {
+	var req schema.CreateGuestCommentRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.CommentService.CreateGuestComment(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags CommentAPI
+// @Summary 使用验证邮件中的令牌验证访客邮箱，验证后评论按文章的审核模式进入审核
+// @Param body body schema.GuestTokenRequest true "验证令牌"
+// @Success 200 {object} util.ResponseResult{data=schema.CommentResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/guest/verify [post]
+func (h *CommentHandler) VerifyGuestComment(c *gin.Context) {
+	var req schema.GuestTokenRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.CommentService.VerifyGuestComment(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 向当前用户的邮箱发送认领邮件，用于将使用该邮箱发表的访客评论关联到账号
+// @Param locale query string false "邮件语言，为空时使用默认语言"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/guest/claim/send [post]
+func (h *CommentHandler) SendGuestClaim(c *gin.Context) {
+	var req schema.SendGuestClaimRequest
+	if err := util.ParseQuery(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.CommentService.SendGuestClaim(ctx, userID, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 使用认领邮件中的令牌将访客评论关联到当前用户的账号
+// @Param body body schema.GuestTokenRequest true "认领令牌"
+// @Success 200 {object} util.ResponseResult{data=schema.ClaimGuestCommentsResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/guest/claim [post]
+func (h *CommentHandler) ClaimGuestComments(c *gin.Context) {
+	var req schema.GuestTokenRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.CommentService.ClaimGuestComments(ctx, userID, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
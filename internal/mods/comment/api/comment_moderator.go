@@ -0,0 +1,224 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户可管理的评论列表，即本人及指定其为协管员的作者的文章下的评论
+// @Param article_id query uint false "文章ID"
+// @Param author_id query uint false "评论作者ID"
+// @Param parent_id query uint false "父评论ID"
+// @Param root_id query uint false "根评论ID"
+// @Param level query int false "评论层级"
+// @Param status query int false "评论状态：0-待审核，1-已通过，2-已拒绝，3-访客邮箱待验证" Enums(0, 1, 2, 3)
+// @Param keyword query string false "关键词"
+// @Param create_start_time query string false "创建开始时间，格式：2006-01-02 15:04:05"
+// @Param create_end_time query string false "创建结束时间，格式：2006-01-02 15:04:05"
+// @Param review_start_time query string false "审核开始时间，格式：2006-01-02 15:04:05"
+// @Param review_end_time query string false "审核结束时间，格式：2006-01-02 15:04:05"
+// @Param reviewer_id query uint false "审核人员ID"
+// @Param min_spam_score query int false "最低垃圾评论评分" minimum(0) maximum(100)
+// @Param unreviewed query bool false "只查询尚未经审核的评论（待审核及先发后审的评论）"
+// @Param sort_by query string false "排序字段：create-创建时间，review-审核时间，spam-垃圾评论评分" Enums(create, review, spam) default(create)
+// @Param sort_order query string false "排序方式：desc-降序，asc-升序" Enums(desc, asc) default(desc)
+// @Param page query int true "页码" minimum(1) default(1)
+// @Param page_size query int true "页容量" minimum(1) maximum(100) default(10)
+// @Success 200 {object} util.ResponseResult{data=schema.CommentPaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/moderation/queue [get]
+func (h *CommentHandler) GetModerationQueue(c *gin.Context) {
+	var req schema.CommentReviewListRequest
+	if err := util.ParseQuery(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.CommentService.GetModerationQueue(ctx, userID, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 文章作者或协管员审核评论，指定 rereview 时可修改已审核评论的审核结论
+// @Param comment_id body uint true "评论ID" minimum(1)
+// @Param status body int true "审核状态：1-通过，2-拒绝" Enums(1, 2)
+// @Param review_remark body string false "审核备注"
+// @Param rereview body bool false "是否修改已审核评论的审核结论，管理员或系统拒绝的评论不能由作者或协管员恢复"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/moderation/review [post]
+func (h *CommentHandler) ModerateComment(c *gin.Context) {
+	var req schema.ReviewCommentRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.CommentService.ModerateComment(ctx, userID, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 文章作者或协管员隐藏已通过的评论，评论下的回复随之隐藏
+// @Param id path uint true "评论ID" minimum(1)
+// @Param body body schema.HideCommentRequest false "隐藏原因"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/moderation/{id}/hide [post]
+func (h *CommentHandler) HideComment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的评论ID"))
+		return
+	}
+
+	var req schema.HideCommentRequest
+	if c.Request.ContentLength > 0 {
+		if err := util.ParseJSON(c, &req); err != nil {
+			util.ResError(c, err)
+			return
+		}
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.CommentService.HideComment(ctx, userID, uint(id), &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 文章作者或协管员置顶或取消置顶文章的顶级评论
+// @Param id path uint true "评论ID" minimum(1)
+// @Param body body schema.PinCommentRequest true "是否置顶"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/moderation/{id}/pin [put]
+func (h *CommentHandler) PinComment(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的评论ID"))
+		return
+	}
+
+	var req schema.PinCommentRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.CommentService.PinComment(ctx, userID, uint(id), &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户指定的评论协管员
+// @Success 200 {object} util.ResponseResult{data=[]schema.CommentModeratorResponse}
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/moderators [get]
+func (h *CommentHandler) GetModerators(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.CommentService.GetModerators(ctx, userID)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 指定评论协管员，协管员可管理当前用户所有文章下的评论
+// @Param body body schema.AddCommentModeratorRequest true "协管员用户ID"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/moderators [post]
+func (h *CommentHandler) AddModerator(c *gin.Context) {
+	var req schema.AddCommentModeratorRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.CommentService.AddModerator(ctx, userID, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 移除评论协管员
+// @Param user_id path uint true "协管员用户ID" minimum(1)
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/moderators/{user_id} [delete]
+func (h *CommentHandler) RemoveModerator(c *gin.Context) {
+	moderatorID, err := strconv.ParseUint(c.Param("user_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的用户ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	if err := h.CommentService.RemoveModerator(ctx, userID, uint(moderatorID)); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// @Tags CommentAPI
+// @Summary 获取文章生效的评论设置（评论开关与审核模式），未单独设置时为站点默认设置
+// @Param article_id path uint true "文章ID" minimum(1)
+// @Success 200 {object} util.ResponseResult{data=schema.CommentSettingResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/article/{article_id}/settings [get]
+func (h *CommentHandler) GetCommentSetting(c *gin.Context) {
+	articleID, err := strconv.ParseUint(c.Param("article_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.CommentService.GetCommentSetting(ctx, uint(articleID))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags CommentAPI
+// @Security ApiKeyAuth
+// @Summary 更新文章的评论设置，字段为空表示使用站点默认设置（仅文章作者与管理员可用）
+// @Param article_id path uint true "文章ID" minimum(1)
+// @Param body body schema.UpdateCommentSettingRequest true "评论设置"
+// @Success 200 {object} util.ResponseResult{data=schema.CommentSettingResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/comment/article/{article_id}/settings [put]
+func (h *CommentHandler) UpdateCommentSetting(c *gin.Context) {
+	articleID, err := strconv.ParseUint(c.Param("article_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+
+	var req schema.UpdateCommentSettingRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.CommentService.UpdateCommentSetting(ctx, userID, uint(articleID), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
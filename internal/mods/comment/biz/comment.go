@@ -2,30 +2,135 @@ package biz
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	authBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/biz"
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
 	articleDal "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	mailBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/mail/biz"
+	mentionBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/biz"
+	mentionSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
+	notificationBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	sensitiveBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/biz"
 	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
 	"github.com/codeExpert666/goinkblog-backend/pkg/util"
 )
 
 // CommentService 评论业务逻辑层
 type CommentService struct {
-	CommentRepository *dal.CommentRepository
-	ArticleRepository *articleDal.ArticleRepository
-	Trans             util.Trans
+	CommentRepository           *dal.CommentRepository
+	CommentReactionRepository   *dal.CommentReactionRepository
+	CommentRevisionRepository   *dal.CommentRevisionRepository
+	CommentSpamRepository       *dal.CommentSpamRepository
+	CommentModeratorRepository  *dal.CommentModeratorRepository
+	CommentSettingRepository    *dal.CommentSettingRepository
+	CommentGuestTokenRepository *dal.CommentGuestTokenRepository
+	ArticleRepository           *articleDal.ArticleRepository
+	UserRepository              *userDal.UserRepository
+	AuthService                 *authBiz.AuthService
+	MailService                 *mailBiz.MailService
+	SensitiveFilter             *sensitiveBiz.SensitiveFilter
+	MentionService              *mentionBiz.MentionService
+	NotificationService         *notificationBiz.NotificationService
+	Trans                       util.Trans
 }
 
 // CreateComment 创建评论
 func (s *CommentService) CreateComment(ctx context.Context, userID uint, req *schema.CreateCommentRequest) (*schema.CommentResponse, error) {
+	comment, setting, trusted, review, err := s.prepareComment(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	s.decideStatus(ctx, comment, setting.Moderation, trusted, review)
+
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		// 联邦回复以来源地址去重：锁定文章使同一文章的并发投递串行执行，再检查回复是否已保存
+		if comment.Type == schema.CommentTypeActivityPub {
+			if _, err := s.ArticleRepository.GetByID(util.NewRowLock(ctx), comment.ArticleID); err != nil {
+				return err
+			}
+			_, err := s.CommentRepository.GetBySourceURL(ctx, comment.Type, comment.ArticleID, comment.SourceURL)
+			if err == nil {
+				return errors.Conflict("回复已存在")
+			} else if !errors.IsNotFound(err) {
+				return err
+			}
+		}
+
+		// 创建评论
+		err := s.CommentRepository.Create(ctx, comment)
+		if err != nil {
+			return err
+		}
+
+		// 如果评论状态为已通过，则增加文章评论数
+		if comment.Status == schema.CommentStatusApproved {
+			return s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, 1)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.syncMentions(ctx, comment)
+	if comment.Status == schema.CommentStatusApproved {
+		s.notifyPublished(ctx, comment)
+	}
+
+	return s.newCommentResponse(ctx, comment), nil
+}
+
+// prepareComment 过滤评论内容并检查文章的评论设置与父评论，返回待保存的评论、文章生效的评论设置、
+// 评论者是否受信任以及内容是否命中需人工审核的敏感词
+func (s *CommentService) prepareComment(ctx context.Context, userID uint, req *schema.CreateCommentRequest) (*schema.Comment, *schema.CommentSettingResponse, bool, bool, error) {
+	// 敏感词过滤
+	content, review, err := s.SensitiveFilter.Screen(ctx, "评论内容", req.Content)
+	if err != nil {
+		return nil, nil, false, false, err
+	}
+
 	// 初始化评论对象
 	comment := &schema.Comment{
-		Content:   req.Content,
-		AuthorID:  userID,
-		ArticleID: req.ArticleID,
-		Level:     1, // 默认为顶级评论
+		Content:      content,
+		Type:         schema.CommentTypeComment,
+		SourceURL:    req.SourceURL,
+		SourceAuthor: req.SourceAuthor,
+		AuthorID:     userID,
+		ArticleID:    req.ArticleID,
+		Level:        1, // 默认为顶级评论
+	}
+	if req.Type != "" {
+		comment.Type = req.Type
+	}
+
+	// 检查文章的评论设置
+	article, err := s.ArticleRepository.GetByID(ctx, req.ArticleID)
+	if err != nil {
+		return nil, nil, false, false, err
+	}
+	setting, err := s.articleSetting(ctx, article)
+	if err != nil {
+		return nil, nil, false, false, err
+	}
+	if setting.Closed {
+		return nil, nil, false, false, errors.Forbidden("该文章已关闭评论")
+	}
+	// 联邦回复无法在发表前拒绝，仅限受信任用户评论的文章下的联邦回复不拒绝，而是进入待审核
+	trusted := false
+	if setting.Moderation == schema.CommentModerationTrusted {
+		if trusted, err = s.isTrustedCommenter(ctx, userID, article); err != nil {
+			return nil, nil, false, false, err
+		}
+		if !trusted && comment.Type != schema.CommentTypeActivityPub {
+			return nil, nil, false, false, errors.Forbidden("该文章仅允许受信任的用户评论")
+		}
 	}
 
 	// 检查父评论是否存在
@@ -33,19 +138,22 @@ func (s *CommentService) CreateComment(ctx context.Context, userID uint, req *sc
 		parentComment, err := s.CommentRepository.GetByID(ctx, *req.ParentID)
 		if err != nil {
 			if errors.IsNotFound(err) {
-				return nil, errors.NotFound("父评论不存在")
+				return nil, nil, false, false, errors.NotFound("父评论不存在")
 			}
-			return nil, errors.WithStack(err)
+			return nil, nil, false, false, errors.WithStack(err)
 		}
 
 		// 确保回复的是当前文章的评论
 		if parentComment.ArticleID != req.ArticleID {
-			return nil, errors.Conflict("当前评论与父评论不属于同一文章")
+			return nil, nil, false, false, errors.Conflict("当前评论与父评论不属于同一文章")
 		}
 
 		// 检查父评论是否已审核通过
-		if parentComment.Status != schema.CommentStatusApproved {
-			return nil, errors.BadRequest("不能回复未审核通过的评论")
+		if parentComment.IsDeleted() {
+			return nil, nil, false, false, errors.BadRequest("不能回复已删除的评论")
+		}
+		if parentComment.Status != schema.CommentStatusApproved || parentComment.HiddenBy != nil {
+			return nil, nil, false, false, errors.BadRequest("不能回复未审核通过的评论")
 		}
 
 		// 设置父评论ID
@@ -65,44 +173,60 @@ func (s *CommentService) CreateComment(ctx context.Context, userID uint, req *sc
 		// 检查评论层级是否过深
 		maxLevel := 20 // 最大支持20层评论
 		if comment.Level > maxLevel {
-			return nil, errors.BadRequest("评论层级过深，请直接回复根评论")
+			return nil, nil, false, false, errors.BadRequest("评论层级过深，请直接回复根评论")
 		}
 	}
 
-	// 管理员发表的评论自动通过审核
-	if util.FromIsAdminUser(ctx) {
-		now := time.Now()
+	return comment, setting, trusted, review, nil
+}
+
+// decideStatus 决定新评论的审核状态
+// 管理员（访客评论除外）与受信任用户发表的评论自动通过审核，命中需人工审核的敏感词时除外
+// 其他评论按垃圾评论评分自动通过、自动拒绝或进入待审核，没有站内作者的评论只会被自动拒绝，不会自动通过；
+// 先发后审的文章下待审核的评论直接公开，站外来源的评论除外
+func (s *CommentService) decideStatus(ctx context.Context, comment *schema.Comment, moderation string, trusted, review bool) {
+	now := time.Now()
+	if comment.AuthorID > 0 && util.FromIsAdminUser(ctx) && !review {
 		comment.Status = schema.CommentStatusApproved
 		comment.ReviewedAt = &now
-		comment.ReviewerID = &userID
+		comment.ReviewerID = &comment.AuthorID
 		comment.ReviewRemark = "管理员自动通过"
+	} else if trusted && !review {
+		comment.Status = schema.CommentStatusApproved
+		comment.ReviewedAt = &now
+		comment.ReviewRemark = "受信任用户自动通过"
 	} else {
 		comment.Status = schema.CommentStatusPending
-	}
-
-	err := s.Trans.Exec(ctx, func(ctx context.Context) error {
-		// 创建评论
-		err := s.CommentRepository.Create(ctx, comment)
-		if err != nil {
-			return err
+		if cfg := config.C.Comment.Spam; cfg.Enabled {
+			result := s.applySpamScore(ctx, comment)
+			switch {
+			case result.Score > cfg.RejectAbove:
+				comment.Status = schema.CommentStatusRejected
+				comment.ReviewedAt = &now
+				comment.ReviewRemark = fmt.Sprintf("疑似垃圾评论（评分 %d），自动拒绝", result.Score)
+			case result.Score < cfg.ApproveBelow && !review && !comment.IsOffSite():
+				comment.Status = schema.CommentStatusApproved
+				comment.ReviewedAt = &now
+				comment.ReviewRemark = fmt.Sprintf("垃圾评论评分 %d，自动通过", result.Score)
+			}
 		}
 
-		// 如果评论状态为已通过，则增加文章评论数
-		if comment.Status == schema.CommentStatusApproved {
-			return s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, 1)
+		// 先发后审的评论未记录审核时间，等待审核员复核
+		if moderation == schema.CommentModerationPost && comment.Status == schema.CommentStatusPending && !review && !comment.IsOffSite() {
+			comment.Status = schema.CommentStatusApproved
+			comment.ReviewRemark = "先发后审"
 		}
-
-		return nil
-	})
-
-	if err != nil {
-		return nil, err
 	}
+}
 
-	// 构造响应数据
+// newCommentResponse 构造新发表或刚验证的评论的响应数据
+func (s *CommentService) newCommentResponse(ctx context.Context, comment *schema.Comment) *schema.CommentResponse {
 	response := &schema.CommentResponse{
 		ID:           comment.ID,
 		Content:      comment.Content,
+		Type:         comment.Type,
+		SourceURL:    comment.SourceURL,
+		SourceAuthor: comment.SourceAuthor,
 		AuthorID:     comment.AuthorID,
 		ArticleID:    comment.ArticleID,
 		ParentID:     comment.ParentID,
@@ -113,6 +237,8 @@ func (s *CommentService) CreateComment(ctx context.Context, userID uint, req *sc
 		ReviewerID:   comment.ReviewerID,
 		ReviewRemark: comment.ReviewRemark,
 		ReplyCount:   0, // 新评论没有回复
+		Reactions:    comment.Reactions(),
+		Hidden:       comment.HiddenBy != nil,
 		CreatedAt:    comment.CreatedAt,
 	}
 
@@ -120,8 +246,9 @@ func (s *CommentService) CreateComment(ctx context.Context, userID uint, req *sc
 	s.CommentRepository.FillReviewerInfo(ctx, response)
 	s.CommentRepository.FillArticleInfo(ctx, response)
 	s.CommentRepository.FillParentCommentInfo(ctx, response)
+	response.Mentions = s.MentionService.GetMentionedUsers(ctx, mentionSchema.MentionSourceComment, []uint{comment.ID})[comment.ID]
 
-	return response, nil
+	return response
 }
 
 // DeleteComment 删除评论
@@ -137,26 +264,69 @@ func (s *CommentService) DeleteComment(ctx context.Context, userID uint, id uint
 		return errors.Forbidden("无权限删除此评论")
 	}
 
+	if comment.IsDeleted() {
+		return errors.NotFound("评论不存在")
+	}
+
 	return s.Trans.Exec(ctx, func(ctx context.Context) error {
-		// 递归删除所有回复，replyNum 为删除的已审核通过的回复数量
-		replyNum, err := s.CommentRepository.DeleteByParentID(ctx, id)
-		if err != nil {
+		return s.removeComment(ctx, comment)
+	})
+}
+
+// removeComment 删除评论：仍有回复时替换为墓碑以保留评论树，否则物理删除，并清理因此不再有回复的祖先墓碑
+// 文章评论数只统计审核通过且未删除的评论，墓碑在替换时即已扣除，物理删除墓碑时不再扣除
+func (s *CommentService) removeComment(ctx context.Context, comment *schema.Comment) error {
+	children, err := s.CommentRepository.CountChildren(ctx, comment.ID)
+	if err != nil {
+		return err
+	}
+	if err := s.MentionService.DeleteSourceMentions(ctx, mentionSchema.MentionSourceComment, comment.ID); err != nil {
+		return err
+	}
+
+	if children > 0 {
+		if err := s.CommentRepository.Tombstone(ctx, comment.ID, time.Now()); err != nil {
+			return err
+		}
+	} else {
+		if err := s.CommentRepository.DeleteByID(ctx, comment.ID); err != nil {
+			return err
+		}
+		if err := s.pruneTombstones(ctx, comment.ParentID); err != nil {
 			return err
 		}
+	}
 
-		// 删除评论
-		err = s.CommentRepository.DeleteByID(ctx, id)
+	if comment.Status == schema.CommentStatusApproved && comment.HiddenBy == nil {
+		return s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, -1)
+	}
+	return nil
+}
+
+// pruneTombstones 自下而上物理删除已没有回复的墓碑
+func (s *CommentService) pruneTombstones(ctx context.Context, parentID *uint) error {
+	for parentID != nil {
+		parent, err := s.CommentRepository.GetByID(ctx, *parentID)
 		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
 			return err
 		}
+		if !parent.IsDeleted() {
+			return nil
+		}
 
-		// 减少文章评论数
-		if comment.Status == schema.CommentStatusApproved {
-			return s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, -int(replyNum+1))
+		children, err := s.CommentRepository.CountChildren(ctx, parent.ID)
+		if err != nil || children > 0 {
+			return err
 		}
-		// 评论未审核通过，不占用文章评论数，同时也不会有回复，故不需要减少文章评论数
-		return nil
-	})
+		if err := s.CommentRepository.DeleteByID(ctx, parent.ID); err != nil {
+			return err
+		}
+		parentID = parent.ParentID
+	}
+	return nil
 }
 
 // GetCommentByID 通过ID获取评论
@@ -169,18 +339,28 @@ func (s *CommentService) GetCommentByID(ctx context.Context, id uint) (*schema.C
 
 	// 构造响应数据
 	response := &schema.CommentResponse{
-		ID:           comment.ID,
-		Content:      comment.Content,
-		AuthorID:     comment.AuthorID,
-		ArticleID:    comment.ArticleID,
-		ParentID:     comment.ParentID,
-		RootID:       comment.RootID,
-		Level:        comment.Level,
-		Status:       comment.Status,
-		ReviewedAt:   comment.ReviewedAt,
-		ReviewerID:   comment.ReviewerID,
-		ReviewRemark: comment.ReviewRemark,
-		CreatedAt:    comment.CreatedAt,
+		ID:            comment.ID,
+		Content:       comment.Content,
+		Type:          comment.Type,
+		SourceURL:     comment.SourceURL,
+		SourceAuthor:  comment.SourceAuthor,
+		AuthorID:      comment.AuthorID,
+		ArticleID:     comment.ArticleID,
+		ParentID:      comment.ParentID,
+		RootID:        comment.RootID,
+		Level:         comment.Level,
+		Status:        comment.Status,
+		ReviewedAt:    comment.ReviewedAt,
+		ReviewerID:    comment.ReviewerID,
+		ReviewRemark:  comment.ReviewRemark,
+		Reactions:     comment.Reactions(),
+		ReactionCount: comment.ReactionCount,
+		Edited:        comment.EditedAt != nil,
+		EditedAt:      comment.EditedAt,
+		Deleted:       comment.IsDeleted(),
+		Hidden:        comment.HiddenBy != nil,
+		Pinned:        comment.PinnedAt != nil,
+		CreatedAt:     comment.CreatedAt,
 	}
 
 	s.CommentRepository.FillAuthorInfo(ctx, response)
@@ -188,6 +368,10 @@ func (s *CommentService) GetCommentByID(ctx context.Context, id uint) (*schema.C
 	s.CommentRepository.FillArticleInfo(ctx, response)
 	s.CommentRepository.FillParentCommentInfo(ctx, response)
 	response.ReplyCount, _ = s.CommentRepository.CountReplies(ctx, id)
+	response.MyReactions = s.userReactions(ctx, id)[id]
+	if !response.Deleted {
+		response.Mentions = s.MentionService.GetMentionedUsers(ctx, mentionSchema.MentionSourceComment, []uint{id})[id]
+	}
 
 	return response, nil
 }
@@ -209,8 +393,13 @@ func (s *CommentService) GetArticleComments(ctx context.Context, articleID uint,
 		req.IncludePending = true
 	}
 
-	// 管理员可以查看待审核评论
-	return s.CommentRepository.GetArticleComments(ctx, articleID, req)
+	result, err := s.CommentRepository.GetArticleComments(ctx, articleID, req)
+	if err != nil {
+		return nil, err
+	}
+	s.fillMyReactions(ctx, result.Items)
+	s.fillMentions(ctx, result.Items)
+	return result, nil
 }
 
 // GetCommentReplies 获取评论的回复，扁平化列表
@@ -233,12 +422,24 @@ func (s *CommentService) GetCommentReplies(ctx context.Context, commentID uint,
 		req.IncludePending = true
 	}
 
-	return s.CommentRepository.GetCommentReplies(ctx, commentID, req)
+	result, err := s.CommentRepository.GetCommentReplies(ctx, commentID, req)
+	if err != nil {
+		return nil, err
+	}
+	s.fillMyReactions(ctx, result.Items)
+	s.fillMentions(ctx, result.Items)
+	return result, nil
 }
 
 // GetUserComments 获取用户的评论
 func (s *CommentService) GetUserComments(ctx context.Context, userID uint, req *schema.UserCommentsRequest) (*schema.CommentPaginationResult, error) {
-	return s.CommentRepository.GetUserComments(ctx, userID, req)
+	result, err := s.CommentRepository.GetUserComments(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+	s.fillMyReactions(ctx, result.Items)
+	s.fillMentions(ctx, result.Items)
+	return result, nil
 }
 
 // GetCommentsForReview 获取评论审核列表
@@ -246,33 +447,54 @@ func (s *CommentService) GetCommentsForReview(ctx context.Context, req *schema.C
 	return s.CommentRepository.GetCommentsForReview(ctx, req)
 }
 
-// ReviewComment 审核评论
+// ReviewComment 审核评论，指定 Rereview 时可修改已审核评论的审核结论
 func (s *CommentService) ReviewComment(ctx context.Context, reviewerID uint, req *schema.ReviewCommentRequest) error {
-	// 获取评论
 	comment, err := s.CommentRepository.GetByID(ctx, req.CommentID)
 	if err != nil {
 		return err
 	}
+	return s.review(ctx, reviewerID, comment, req, false)
+}
 
-	if comment.Status != schema.CommentStatusPending {
-		return errors.BadRequest("评论已审核，不能重复审核")
+// review 保存审核结论并执行审核后的处理，byModerator 表示由文章作者或协管员而非站点管理员做出
+func (s *CommentService) review(ctx context.Context, reviewerID uint, comment *schema.Comment, req *schema.ReviewCommentRequest, byModerator bool) error {
+	if err := checkReviewable(comment, req.Status, req.Rereview); err != nil {
+		return err
 	}
 
-	// 更新评论审核状态
-	now := time.Now()
-	return s.Trans.Exec(ctx, func(ctx context.Context) error {
-		// 更新评论状态
-		err := s.CommentRepository.UpdateCommentStatus(ctx, reviewerID, req, &now)
-		if err != nil {
-			return err
-		}
+	prevStatus := comment.Status
+	err := s.Trans.Exec(ctx, func(ctx context.Context) error {
+		return s.applyReview(ctx, comment, &reviewerID, req)
+	})
+	if err != nil {
+		return err
+	}
 
-		// 如果评论审核通过，需要增加文章评论数
-		if req.Status == schema.CommentStatusApproved {
-			// 增加文章评论数
-			return s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, 1)
-		}
+	s.afterReview(ctx, reviewerID, comment, prevStatus, req, byModerator)
+	return nil
+}
 
-		return nil
+// AutoReviewComment 由系统自动审核待审核评论（如 AI 审核），不记录审核员，也不用于训练垃圾评论分类器
+func (s *CommentService) AutoReviewComment(ctx context.Context, req *schema.ReviewCommentRequest) error {
+	comment, err := s.CommentRepository.GetByID(ctx, req.CommentID)
+	if err != nil {
+		return err
+	}
+	if err := checkReviewable(comment, req.Status, false); err != nil {
+		return err
+	}
+
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		return s.applyReview(ctx, comment, nil, req)
 	})
+	if err != nil {
+		return err
+	}
+
+	if comment.Status == schema.CommentStatusApproved {
+		s.syncMentions(ctx, comment)
+		s.notifyPublished(ctx, comment)
+	}
+	s.notifyReviewed(ctx, 0, comment, req, false)
+	return nil
 }
@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// UpdateComment 编辑评论，仅作者可在发表后的编辑时限内编辑（管理员不受时限限制）
+// 编辑前的内容记录为历史版本；非管理员编辑已通过的评论且命中需人工审核的敏感词或垃圾评论评分过高时，评论重新进入审核
+func (s *CommentService) UpdateComment(ctx context.Context, userID, id uint, req *schema.UpdateCommentRequest) (*schema.CommentResponse, error) {
+	comment, err := s.CommentRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if comment.IsDeleted() {
+		return nil, errors.NotFound("评论不存在")
+	}
+
+	// 检查权限
+	if comment.AuthorID == 0 || comment.AuthorID != userID {
+		return nil, errors.Forbidden("无权限编辑此评论")
+	}
+	if comment.Status == schema.CommentStatusRejected {
+		return nil, errors.BadRequest("已拒绝的评论不能编辑")
+	}
+
+	isAdmin := util.FromIsAdminUser(ctx)
+	if window := config.C.Comment.EditWindow; !isAdmin && window >= 0 &&
+		time.Since(comment.CreatedAt) > time.Duration(window)*time.Minute {
+		return nil, errors.Forbidden("评论发表已超过 %d 分钟，不能再编辑", window)
+	}
+
+	// 敏感词过滤
+	content, review, err := s.SensitiveFilter.Screen(ctx, "评论内容", req.Content)
+	if err != nil {
+		return nil, err
+	}
+	if content == comment.Content {
+		return s.GetCommentByID(ctx, id)
+	}
+
+	revision := &schema.CommentRevision{
+		CommentID: comment.ID,
+		Content:   comment.Content,
+		Status:    comment.Status,
+		EditorID:  userID,
+	}
+
+	now := time.Now()
+	comment.Content = content
+	comment.EditedAt = &now
+	comment.EditCount++
+
+	// 非管理员编辑后重新计算垃圾评论评分，评分过高的已通过评论同样重新进入审核
+	if cfg := config.C.Comment.Spam; cfg.Enabled && !isAdmin {
+		if result := s.applySpamScore(ctx, comment); result.Score > cfg.RejectAbove {
+			review = true
+		}
+	}
+
+	requeue := comment.Status == schema.CommentStatusApproved && review && !isAdmin
+	if requeue {
+		comment.Status = schema.CommentStatusPending
+		comment.ReviewedAt = nil
+		comment.ReviewerID = nil
+		comment.ReviewRemark = "编辑后需重新审核"
+	}
+
+	// 待审核评论的内容已变化，原 AI 审核建议作废，重新排队等待 AI 审核
+	if comment.Status == schema.CommentStatusPending {
+		resetAIModeration(comment)
+	}
+
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		if err := s.CommentRevisionRepository.Create(ctx, revision); err != nil {
+			return err
+		}
+		if err := s.CommentRepository.UpdateEdited(ctx, comment); err != nil {
+			return err
+		}
+
+		// 重新进入审核的评论不再占用文章评论数，审核通过后重新计入
+		if requeue && comment.HiddenBy == nil {
+			return s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, -1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.syncMentions(ctx, comment)
+	return s.GetCommentByID(ctx, id)
+}
+
+// GetCommentRevisions 获取评论的历史版本（仅管理员可用）
+func (s *CommentService) GetCommentRevisions(ctx context.Context, id uint) ([]schema.CommentRevisionResponse, error) {
+	if _, err := s.CommentRepository.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.CommentRevisionRepository.ListByCommentID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]schema.CommentRevisionResponse, 0, len(revisions))
+	for _, revision := range revisions {
+		item := schema.CommentRevisionResponse{
+			ID:        revision.ID,
+			CommentID: revision.CommentID,
+			Content:   revision.Content,
+			Status:    revision.Status,
+			EditorID:  revision.EditorID,
+			CreatedAt: revision.CreatedAt,
+		}
+		// 外部引用随来源页面更新时没有编辑人
+		if revision.EditorID == 0 {
+			result = append(result, item)
+			continue
+		}
+		if user, err := s.UserRepository.GetByID(ctx, revision.EditorID); err != nil {
+			logging.Context(ctx).Error("获取评论编辑人信息失败", zap.Uint("editor_id", revision.EditorID), zap.Error(err))
+		} else {
+			item.Editor = user.Username
+		}
+		result = append(result, item)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,279 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	userSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/schema"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	mailSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/mail/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+)
+
+// checkGuestEnabled 检查是否允许访客评论，验证邮箱需要发送邮件并生成站点链接
+func checkGuestEnabled() error {
+	if !config.C.Comment.Guest.Enabled || !config.C.Mailer.Enabled || config.C.Blog.Share.SiteURL == "" {
+		return errors.Forbidden("未开放访客评论，请登录后评论")
+	}
+	return nil
+}
+
+// hashGuestToken 计算令牌的摘要，数据库中只保存摘要
+func hashGuestToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// issueGuestToken 生成并保存令牌，返回包含令牌的前端页面链接
+func (s *CommentService) issueGuestToken(ctx context.Context, guestToken *schema.CommentGuestToken, path string) (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", errors.WithStack(err)
+	}
+	token := hex.EncodeToString(buf)
+
+	guestToken.Token = hashGuestToken(token)
+	guestToken.ExpiresAt = time.Now().Add(time.Duration(config.C.Comment.Guest.TokenExpire) * time.Hour)
+	if err := s.CommentGuestTokenRepository.Create(ctx, guestToken); err != nil {
+		return "", err
+	}
+	return config.C.SiteURL() + path + "?token=" + url.QueryEscape(token), nil
+}
+
+// purgeExpiredGuestTokens 清理过期的令牌，并删除逾期未验证邮箱的访客评论
+func (s *CommentService) purgeExpiredGuestTokens(ctx context.Context) {
+	err := s.Trans.Exec(ctx, func(ctx context.Context) error {
+		ids, err := s.CommentGuestTokenRepository.DeleteExpired(ctx, time.Now())
+		if err != nil {
+			return err
+		}
+		return s.CommentRepository.DeleteUnverified(ctx, ids)
+	})
+	if err != nil {
+		logging.Context(ctx).Error("清理过期的访客令牌失败", zap.Error(err))
+	}
+}
+
+// CreateGuestComment 创建访客评论：校验验证码后保存为邮箱待验证状态，并向访客邮箱发送验证链接，
+// 验证后评论才按文章的审核模式进入审核
+func (s *CommentService) CreateGuestComment(ctx context.Context, req *schema.CreateGuestCommentRequest) (*schema.CommentResponse, error) {
+	if err := checkGuestEnabled(); err != nil {
+		return nil, err
+	}
+	if !s.AuthService.VerifyCaptcha(req.CaptchaID, req.Captcha) {
+		return nil, errors.BadRequest("验证码错误")
+	}
+
+	// 访客名称公开展示，命中任何敏感词均拒绝
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.BadRequest("访客名称不能为空")
+	}
+	if result := s.SensitiveFilter.Check(ctx, name); len(result.Hits) > 0 {
+		return nil, errors.BadRequest("访客名称包含敏感词，请更换")
+	}
+
+	// 已注册的邮箱需登录后评论
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	if _, err := s.UserRepository.GetByEmail(ctx, email); err == nil {
+		return nil, errors.BadRequest("该邮箱已注册，请登录后评论")
+	} else if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	s.purgeExpiredGuestTokens(ctx)
+
+	// 访客不属于受信任用户，仅限受信任用户评论的文章在此拒绝
+	comment, _, _, _, err := s.prepareComment(ctx, 0, &req.CreateCommentRequest)
+	if err != nil {
+		return nil, err
+	}
+	comment.Type = schema.CommentTypeGuest
+	comment.SourceAuthor = name
+	comment.GuestEmail = email
+	comment.Status = schema.CommentStatusUnverified
+
+	var link string
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		if err := s.CommentRepository.Create(ctx, comment); err != nil {
+			return err
+		}
+
+		var err error
+		link, err = s.issueGuestToken(ctx, &schema.CommentGuestToken{
+			Purpose:   schema.GuestTokenPurposeVerify,
+			CommentID: comment.ID,
+			Email:     email,
+		}, config.C.Comment.Guest.VerifyPath)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.newCommentResponse(ctx, comment)
+	data := map[string]any{
+		"AppName":      config.C.General.AppName,
+		"Name":         name,
+		"ArticleTitle": response.ArticleTitle,
+		"Link":         link,
+		"ExpireHours":  config.C.Comment.Guest.TokenExpire,
+	}
+	if err := s.MailService.Enqueue(ctx, email, mailSchema.TemplateCommentVerify, req.Locale, data); err != nil {
+		// 评论保留至验证链接过期后清理
+		logging.Context(ctx).Error("发送访客评论验证邮件失败", zap.Error(err), zap.Uint("comment_id", comment.ID))
+		return nil, errors.InternalServerError("发送验证邮件失败，请稍后重试")
+	}
+
+	return response, nil
+}
+
+// VerifyGuestComment 使用验证邮件中的令牌验证访客邮箱，验证后评论按文章当前的审核模式进入审核
+func (s *CommentService) VerifyGuestComment(ctx context.Context, req *schema.GuestTokenRequest) (*schema.CommentResponse, error) {
+	guestToken, err := s.CommentGuestTokenRepository.GetValid(ctx, hashGuestToken(req.Token), schema.GuestTokenPurposeVerify, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	comment, err := s.CommentRepository.GetByID(ctx, guestToken.CommentID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 验证期间文章可能已关闭评论
+	article, err := s.ArticleRepository.GetByID(ctx, comment.ArticleID)
+	if err != nil {
+		return nil, err
+	}
+	setting, err := s.articleSetting(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+	if setting.Closed {
+		return nil, errors.Forbidden("该文章已关闭评论")
+	}
+
+	// 评论内容在发表时已过滤，此处只重新判断是否需要人工审核
+	_, review, err := s.SensitiveFilter.Screen(ctx, "评论内容", comment.Content)
+	if err != nil {
+		return nil, err
+	}
+	s.decideStatus(ctx, comment, setting.Moderation, false, review)
+
+	// 验证期间父评论可能已被拒绝，此时评论随之隐藏，不计入文章评论数
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		if err := s.CommentRepository.UpdateVerified(ctx, comment); err != nil {
+			return err
+		}
+		if err := s.CommentGuestTokenRepository.Delete(ctx, guestToken.ID); err != nil {
+			return err
+		}
+		if comment.Status == schema.CommentStatusApproved && comment.HiddenBy == nil {
+			return s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, 1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.syncMentions(ctx, comment)
+	if comment.Status == schema.CommentStatusApproved && comment.HiddenBy == nil {
+		s.notifyPublished(ctx, comment)
+	}
+
+	return s.newCommentResponse(ctx, comment), nil
+}
+
+// OnUserRegistered 用户注册后，若其邮箱发表过访客评论，则发送认领邮件
+// 注册时不验证邮箱，因此需通过邮件确认后才关联评论
+func (s *CommentService) OnUserRegistered(ctx context.Context, user *userSchema.User) {
+	if !config.C.Mailer.Enabled || config.C.Blog.Share.SiteURL == "" {
+		return
+	}
+	if err := s.sendGuestClaim(ctx, user, ""); err != nil && !errors.IsNotFound(err) {
+		logging.Context(ctx).Error("发送访客评论认领邮件失败", zap.Error(err), zap.Uint("user_id", user.ID))
+	}
+}
+
+// SendGuestClaim 向当前用户的邮箱发送认领访客评论的邮件
+func (s *CommentService) SendGuestClaim(ctx context.Context, userID uint, req *schema.SendGuestClaimRequest) error {
+	if !config.C.Mailer.Enabled || config.C.Blog.Share.SiteURL == "" {
+		return errors.BadRequest("未开启邮件发送，无法认领访客评论")
+	}
+	user, err := s.UserRepository.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return s.sendGuestClaim(ctx, user, req.Locale)
+}
+
+// sendGuestClaim 用户邮箱有可认领的访客评论时发送认领邮件，没有时返回 NotFound
+func (s *CommentService) sendGuestClaim(ctx context.Context, user *userSchema.User, locale string) error {
+	email := strings.ToLower(user.Email)
+	count, err := s.CommentRepository.CountGuestByEmail(ctx, email)
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return errors.NotFound("没有可认领的访客评论")
+	}
+
+	link, err := s.issueGuestToken(ctx, &schema.CommentGuestToken{
+		Purpose: schema.GuestTokenPurposeClaim,
+		UserID:  user.ID,
+		Email:   email,
+	}, config.C.Comment.Guest.ClaimPath)
+	if err != nil {
+		return err
+	}
+
+	data := map[string]any{
+		"AppName":     config.C.General.AppName,
+		"Username":    user.Username,
+		"Count":       count,
+		"Link":        link,
+		"ExpireHours": config.C.Comment.Guest.TokenExpire,
+	}
+	return s.MailService.Enqueue(ctx, user.Email, mailSchema.TemplateCommentClaim, locale, data)
+}
+
+// ClaimGuestComments 使用认领邮件中的令牌将访客评论关联到当前用户的账号
+// 令牌只能由收到邮件的用户本人使用，且用户邮箱在此期间未变更
+func (s *CommentService) ClaimGuestComments(ctx context.Context, userID uint, req *schema.GuestTokenRequest) (*schema.ClaimGuestCommentsResponse, error) {
+	guestToken, err := s.CommentGuestTokenRepository.GetValid(ctx, hashGuestToken(req.Token), schema.GuestTokenPurposeClaim, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if guestToken.UserID != userID {
+		return nil, errors.Forbidden("该认领链接不属于当前用户")
+	}
+	user, err := s.UserRepository.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.ToLower(user.Email) != guestToken.Email {
+		return nil, errors.BadRequest("账号邮箱已变更，请重新发送认领邮件")
+	}
+
+	result := &schema.ClaimGuestCommentsResponse{}
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		var err error
+		if result.Claimed, err = s.CommentRepository.LinkGuestComments(ctx, guestToken.Email, userID); err != nil {
+			return err
+		}
+		return s.CommentGuestTokenRepository.Delete(ctx, guestToken.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
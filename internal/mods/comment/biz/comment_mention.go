@@ -0,0 +1,40 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	mentionBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/biz"
+	mentionSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
+)
+
+// syncMentions 同步评论中的提及，评论审核通过后才通知被提及的用户
+func (s *CommentService) syncMentions(ctx context.Context, comment *schema.Comment) {
+	s.MentionService.SyncMentions(ctx, mentionBiz.Source{
+		Type:        mentionSchema.MentionSourceComment,
+		ID:          comment.ID,
+		ArticleID:   comment.ArticleID,
+		MentionerID: comment.AuthorID,
+		Content:     comment.Content,
+		Visible:     comment.Status == schema.CommentStatusApproved && !comment.IsDeleted() && comment.HiddenBy == nil,
+	})
+}
+
+// fillMentions 为评论列表填充被提及的用户
+func (s *CommentService) fillMentions(ctx context.Context, items []schema.CommentResponse) {
+	if len(items) == 0 {
+		return
+	}
+
+	ids := make([]uint, 0, len(items))
+	for _, item := range items {
+		if !item.Deleted {
+			ids = append(ids, item.ID)
+		}
+	}
+
+	mentions := s.MentionService.GetMentionedUsers(ctx, mentionSchema.MentionSourceComment, ids)
+	for i := range items {
+		items[i].Mentions = mentions[items[i].ID]
+	}
+}
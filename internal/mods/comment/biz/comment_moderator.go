@@ -0,0 +1,201 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// checkModerator 检查当前用户能否管理文章下的评论：管理员、文章作者及作者指定的协管员可以管理
+func (s *CommentService) checkModerator(ctx context.Context, userID, articleID uint) error {
+	if util.FromIsAdminUser(ctx) {
+		return nil
+	}
+
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return err
+	}
+	if article.AuthorID == userID {
+		return nil
+	}
+
+	ok, err := s.CommentModeratorRepository.Exists(ctx, article.AuthorID, userID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return errors.Forbidden("无权限管理此文章的评论")
+	}
+	return nil
+}
+
+// getModeratedComment 获取当前用户有权管理的评论
+func (s *CommentService) getModeratedComment(ctx context.Context, userID, commentID uint) (*schema.Comment, error) {
+	comment, err := s.CommentRepository.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkModerator(ctx, userID, comment.ArticleID); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// moderate 保存文章作者或协管员的审核结论，管理员经此入口操作时仍按站点审核处理
+// 作者与协管员可以隐藏已通过的评论，但只能推翻本文章作者或协管员做出的拒绝，管理员或系统拒绝的评论不能由其恢复
+func (s *CommentService) moderate(ctx context.Context, userID uint, comment *schema.Comment, req *schema.ReviewCommentRequest) error {
+	if util.FromIsAdminUser(ctx) {
+		return s.review(ctx, userID, comment, req, false)
+	}
+
+	if req.Rereview && comment.Status == schema.CommentStatusRejected && comment.ReviewedAt != nil {
+		ok, err := s.rejectedByModerator(ctx, comment)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return errors.Forbidden("该评论已由管理员或系统拒绝，无权修改审核结论")
+		}
+	}
+	return s.review(ctx, userID, comment, req, true)
+}
+
+// rejectedByModerator 判断评论是否由其所在文章的作者或协管员拒绝
+func (s *CommentService) rejectedByModerator(ctx context.Context, comment *schema.Comment) (bool, error) {
+	if comment.ReviewerID == nil {
+		return false, nil
+	}
+	article, err := s.ArticleRepository.GetByID(ctx, comment.ArticleID)
+	if err != nil {
+		return false, err
+	}
+	if *comment.ReviewerID == article.AuthorID {
+		return true, nil
+	}
+	return s.CommentModeratorRepository.Exists(ctx, article.AuthorID, *comment.ReviewerID)
+}
+
+// GetModerationQueue 获取当前用户可管理的评论列表，即本人及指定其为协管员的作者的文章下的评论
+func (s *CommentService) GetModerationQueue(ctx context.Context, userID uint, req *schema.CommentReviewListRequest) (*schema.CommentPaginationResult, error) {
+	authorIDs, err := s.CommentModeratorRepository.ListAuthorIDsByModerator(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	req.ArticleAuthorIDs = append(authorIDs, userID)
+	return s.CommentRepository.GetCommentsForReview(ctx, req)
+}
+
+// ModerateComment 文章作者或协管员审核评论，可修改已审核评论的审核结论
+func (s *CommentService) ModerateComment(ctx context.Context, userID uint, req *schema.ReviewCommentRequest) error {
+	comment, err := s.getModeratedComment(ctx, userID, req.CommentID)
+	if err != nil {
+		return err
+	}
+	return s.moderate(ctx, userID, comment, req)
+}
+
+// HideComment 文章作者或协管员隐藏已通过的评论，即将其审核结论改为拒绝，评论下的回复随之隐藏
+func (s *CommentService) HideComment(ctx context.Context, userID, commentID uint, req *schema.HideCommentRequest) error {
+	comment, err := s.getModeratedComment(ctx, userID, commentID)
+	if err != nil {
+		return err
+	}
+	if comment.Status != schema.CommentStatusApproved {
+		return errors.BadRequest("只能隐藏已通过的评论")
+	}
+
+	remark := req.ReviewRemark
+	if remark == "" {
+		remark, err = s.hideRemark(ctx, userID, comment.ArticleID)
+		if err != nil {
+			return err
+		}
+	}
+	return s.moderate(ctx, userID, comment, &schema.ReviewCommentRequest{
+		CommentID:    commentID,
+		Status:       schema.CommentStatusRejected,
+		ReviewRemark: remark,
+		Rereview:     true,
+	})
+}
+
+// hideRemark 未填写隐藏原因时的默认备注，注明由谁隐藏
+func (s *CommentService) hideRemark(ctx context.Context, userID, articleID uint) (string, error) {
+	if util.FromIsAdminUser(ctx) {
+		return "由管理员隐藏", nil
+	}
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return "", err
+	}
+	if article.AuthorID == userID {
+		return "由文章作者隐藏", nil
+	}
+	return "由文章协管员隐藏", nil
+}
+
+// PinComment 文章作者或协管员置顶或取消置顶文章的顶级评论
+func (s *CommentService) PinComment(ctx context.Context, userID, commentID uint, req *schema.PinCommentRequest) error {
+	comment, err := s.getModeratedComment(ctx, userID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if !req.Pinned {
+		if comment.PinnedAt == nil {
+			return nil
+		}
+		return s.CommentRepository.SetPinned(ctx, commentID, nil)
+	}
+
+	if comment.PinnedAt != nil {
+		return nil
+	}
+	if comment.Level != 1 {
+		return errors.BadRequest("只能置顶顶级评论")
+	}
+	if comment.IsDeleted() || comment.Status != schema.CommentStatusApproved {
+		return errors.BadRequest("只能置顶已通过的评论")
+	}
+
+	count, err := s.CommentRepository.CountPinned(ctx, comment.ArticleID)
+	if err != nil {
+		return err
+	}
+	if limit := config.C.Comment.MaxPinned; int(count) >= limit {
+		return errors.BadRequest("每篇文章最多置顶 %d 条评论", limit)
+	}
+
+	now := time.Now()
+	return s.CommentRepository.SetPinned(ctx, commentID, &now)
+}
+
+// GetModerators 获取作者指定的评论协管员
+func (s *CommentService) GetModerators(ctx context.Context, authorID uint) ([]schema.CommentModeratorResponse, error) {
+	return s.CommentModeratorRepository.ListByAuthor(ctx, authorID)
+}
+
+// AddModerator 作者指定评论协管员，协管员可管理作者所有文章下的评论
+func (s *CommentService) AddModerator(ctx context.Context, authorID uint, req *schema.AddCommentModeratorRequest) error {
+	if req.UserID == authorID {
+		return errors.BadRequest("不能将自己设为协管员")
+	}
+	if _, err := s.UserRepository.GetByID(ctx, req.UserID); err != nil {
+		return err
+	}
+
+	return s.CommentModeratorRepository.Create(ctx, &schema.CommentModerator{
+		AuthorID:    authorID,
+		ModeratorID: req.UserID,
+	})
+}
+
+// RemoveModerator 作者移除评论协管员
+func (s *CommentService) RemoveModerator(ctx context.Context, authorID, moderatorID uint) error {
+	return s.CommentModeratorRepository.Delete(ctx, authorID, moderatorID)
+}
@@ -0,0 +1,75 @@
+package biz
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	notificationBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	notificationSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+)
+
+// notifyPublished 评论审核通过后通知被回复评论的作者，顶级评论通知文章作者
+func (s *CommentService) notifyPublished(ctx context.Context, comment *schema.Comment) {
+	event := notificationBiz.Event{
+		Type:      notificationSchema.NotificationTypeReply,
+		ActorID:   comment.AuthorID,
+		CommentID: comment.ID,
+		ArticleID: comment.ArticleID,
+		Content:   comment.Content,
+	}
+	if comment.AuthorID == 0 {
+		// 外部来源的评论以来源作者或来源链接作为触发者
+		event.ActorName = comment.SourceAuthor
+		if event.ActorName == "" {
+			event.ActorName = comment.SourceURL
+		}
+	}
+
+	if comment.ParentID != nil {
+		parent, err := s.CommentRepository.GetByID(ctx, *comment.ParentID)
+		if err != nil {
+			logging.Context(ctx).Error("获取父评论失败", zap.Uint("comment_id", comment.ID), zap.Error(err))
+			return
+		}
+		event.UserID = parent.AuthorID
+		event.TargetType = notificationSchema.NotificationTargetComment
+		event.TargetID = parent.ID
+	} else {
+		article, err := s.ArticleRepository.GetByID(ctx, comment.ArticleID)
+		if err != nil {
+			logging.Context(ctx).Error("获取评论所属文章失败", zap.Uint("comment_id", comment.ID), zap.Error(err))
+			return
+		}
+		event.UserID = article.AuthorID
+		event.TargetType = notificationSchema.NotificationTargetArticle
+		event.TargetID = article.ID
+	}
+
+	s.NotificationService.Notify(ctx, event)
+}
+
+// notifyReviewed 通知评论作者审核结果，文章作者或协管员拒绝评论时以隐藏通知，与站点审核区分
+func (s *CommentService) notifyReviewed(ctx context.Context, reviewerID uint, comment *schema.Comment, req *schema.ReviewCommentRequest, byModerator bool) {
+	result := notificationSchema.ReviewResultApproved
+	if req.Status == schema.CommentStatusRejected {
+		result = notificationSchema.ReviewResultRejected
+		if byModerator {
+			result = notificationSchema.ReviewResultHidden
+		}
+	}
+
+	s.NotificationService.Notify(ctx, notificationBiz.Event{
+		Type:       notificationSchema.NotificationTypeReview,
+		UserID:     comment.AuthorID,
+		ActorID:    reviewerID,
+		TargetType: notificationSchema.NotificationTargetComment,
+		TargetID:   comment.ID,
+		CommentID:  comment.ID,
+		ArticleID:  comment.ArticleID,
+		Result:     result,
+		Content:    req.ReviewRemark,
+	})
+}
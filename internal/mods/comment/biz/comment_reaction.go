@@ -0,0 +1,104 @@
+package biz
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// ReactComment 对评论表态/取消表态，已有该表态时取消，否则添加
+func (s *CommentService) ReactComment(ctx context.Context, userID, commentID uint, req *schema.ReactCommentRequest) (*schema.CommentReactionResponse, error) {
+	comment, err := s.CommentRepository.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.IsDeleted() {
+		return nil, errors.BadRequest("不能对已删除的评论表态")
+	}
+	if comment.Status != schema.CommentStatusApproved || comment.HiddenBy != nil {
+		return nil, errors.BadRequest("不能对未审核通过的评论表态")
+	}
+
+	reacted, err := s.CommentReactionRepository.Exists(ctx, commentID, userID, req.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Trans.Exec(ctx, func(ctx context.Context) error {
+		// 仅在表态确实新增或删除时更新计数，避免并发请求导致计数偏差
+		if reacted {
+			deleted, err := s.CommentReactionRepository.Delete(ctx, commentID, userID, req.Type)
+			if err != nil || !deleted {
+				return err
+			}
+			return s.CommentRepository.IncrementReactionCount(ctx, commentID, req.Type, -1)
+		}
+
+		created, err := s.CommentReactionRepository.Create(ctx, &schema.CommentReaction{
+			CommentID: commentID,
+			UserID:    userID,
+			Type:      req.Type,
+		})
+		if err != nil || !created {
+			return err
+		}
+		return s.CommentRepository.IncrementReactionCount(ctx, commentID, req.Type, 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取最新的表态状态
+	comment, err = s.CommentRepository.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	myReactions := s.userReactions(ctx, commentID)[commentID]
+	if myReactions == nil {
+		myReactions = []string{}
+	}
+
+	return &schema.CommentReactionResponse{
+		Reacted:       !reacted,
+		Reactions:     comment.Reactions(),
+		ReactionCount: comment.ReactionCount,
+		MyReactions:   myReactions,
+	}, nil
+}
+
+// userReactions 获取当前用户对评论的表态，未登录时返回空结果
+func (s *CommentService) userReactions(ctx context.Context, commentIDs ...uint) map[uint][]string {
+	userID := util.FromUserID(ctx)
+	if userID == 0 {
+		return nil
+	}
+
+	reactions, err := s.CommentReactionRepository.GetUserReactions(ctx, userID, commentIDs)
+	if err != nil {
+		logging.Context(ctx).Error("获取用户的评论表态失败", zap.Uint("user_id", userID), zap.Error(err))
+		return nil
+	}
+	return reactions
+}
+
+// fillMyReactions 为评论列表填充当前用户的表态
+func (s *CommentService) fillMyReactions(ctx context.Context, items []schema.CommentResponse) {
+	if len(items) == 0 {
+		return
+	}
+
+	ids := make([]uint, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	reactions := s.userReactions(ctx, ids...)
+	for i := range items {
+		items[i].MyReactions = reactions[items[i].ID]
+	}
+}
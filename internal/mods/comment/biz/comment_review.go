@@ -0,0 +1,197 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	mentionSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+)
+
+// BatchReviewComments 在同一事务中批量审核评论，按评论ID选择时任一评论不能审核则全部不审核，
+// 按筛选条件选择时只审核可以审核的评论
+func (s *CommentService) BatchReviewComments(ctx context.Context, reviewerID uint, req *schema.BatchReviewCommentRequest) (*schema.BatchReviewCommentResponse, error) {
+	limit := config.C.Comment.BatchReview
+	if (len(req.CommentIDs) > 0) == (req.Filter != nil) {
+		return nil, errors.BadRequest("评论ID列表与筛选条件只能指定其一")
+	}
+	if len(req.CommentIDs) > limit {
+		return nil, errors.BadRequest("单次最多审核 %d 条评论", limit)
+	}
+
+	var comments []schema.Comment
+	if req.Filter != nil {
+		// 已是目标状态的评论无需审核，先发后审的评论除外
+		statuses := []int{schema.CommentStatusPending}
+		if req.Rereview {
+			statuses = []int{schema.CommentStatusPending, schema.CommentStatusApproved, schema.CommentStatusRejected}
+		}
+		statuses = slices.DeleteFunc(statuses, func(status int) bool { return status == req.Status })
+
+		var err error
+		comments, err = s.CommentRepository.FindForBatchReview(ctx, req.Filter, statuses, limit)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		comments, err = s.CommentRepository.GetByIDs(ctx, req.CommentIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make(map[uint]bool, len(comments))
+		for i := range comments {
+			found[comments[i].ID] = true
+			if err := checkReviewable(&comments[i], req.Status, req.Rereview); err != nil {
+				return nil, errors.BadRequest("评论 %d 不能审核：%s", comments[i].ID, errors.FromError(err).Message())
+			}
+		}
+		for _, id := range req.CommentIDs {
+			if !found[id] {
+				return nil, errors.NotFound("评论 %d 不存在", id)
+			}
+		}
+	}
+
+	prevStatuses := make([]int, len(comments))
+	err := s.Trans.Exec(ctx, func(ctx context.Context) error {
+		for i := range comments {
+			// 重新读取评论，同批审核的祖先评论可能已改变其隐藏状态
+			comment, err := s.CommentRepository.GetByID(ctx, comments[i].ID)
+			if err != nil {
+				return err
+			}
+			comments[i] = *comment
+			prevStatuses[i] = comment.Status
+			err = s.applyReview(ctx, &comments[i], &reviewerID, &schema.ReviewCommentRequest{
+				CommentID:    comments[i].ID,
+				Status:       req.Status,
+				ReviewRemark: req.ReviewRemark,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &schema.BatchReviewCommentResponse{CommentIDs: make([]uint, 0, len(comments))}
+	for i := range comments {
+		s.afterReview(ctx, reviewerID, &comments[i], prevStatuses[i], &schema.ReviewCommentRequest{
+			CommentID:    comments[i].ID,
+			Status:       req.Status,
+			ReviewRemark: req.ReviewRemark,
+		}, false)
+		result.CommentIDs = append(result.CommentIDs, comments[i].ID)
+	}
+	result.Reviewed = len(result.CommentIDs)
+	return result, nil
+}
+
+// checkReviewable 检查评论能否审核为指定状态，rereview 为 true 时允许修改已审核评论的审核结论
+// 先发后审的评论（已通过但未记录审核时间）视同待审核，可直接通过（即确认）或拒绝
+func checkReviewable(comment *schema.Comment, status int, rereview bool) error {
+	if comment.IsDeleted() {
+		return errors.BadRequest("评论已删除，不能审核")
+	}
+	if comment.Status == schema.CommentStatusUnverified {
+		return errors.BadRequest("访客尚未验证邮箱，不能审核")
+	}
+	unreviewed := comment.Status == schema.CommentStatusPending || comment.Status == schema.CommentStatusApproved && comment.ReviewedAt == nil
+	if comment.Status == status && comment.ReviewedAt != nil {
+		return errors.BadRequest("评论已是该审核状态")
+	}
+	if !unreviewed && !rereview {
+		return errors.BadRequest("评论已审核，不能重复审核")
+	}
+	return nil
+}
+
+// applyReview 更新评论的审核状态，并调整回复的隐藏状态、文章评论数与提及，需在事务中调用
+// 文章评论数只统计已通过、未删除且未被隐藏的评论：评论被拒绝时隐藏其下的回复，改为通过时恢复
+func (s *CommentService) applyReview(ctx context.Context, comment *schema.Comment, reviewerID *uint, req *schema.ReviewCommentRequest) error {
+	now := time.Now()
+	prevStatus := comment.Status
+	if err := s.CommentRepository.UpdateCommentStatus(ctx, prevStatus, reviewerID, req, &now); err != nil {
+		return err
+	}
+
+	visible := func(status int) bool {
+		return status == schema.CommentStatusApproved && !comment.IsDeleted() && comment.HiddenBy == nil
+	}
+	delta := 0
+	if visible(req.Status) {
+		delta++
+	}
+	if visible(prevStatus) {
+		delta--
+	}
+
+	// 评论本身未被隐藏时，拒绝与否决定其下的回复是否隐藏
+	wasRejected := prevStatus == schema.CommentStatusRejected
+	isRejected := req.Status == schema.CommentStatusRejected
+	if comment.HiddenBy == nil && wasRejected != isRejected {
+		var hiddenBy *uint
+		if isRejected {
+			hiddenBy = &comment.ID
+		}
+		replies, err := s.CommentRepository.UpdateSubtreeHidden(ctx, comment.ID, hiddenBy)
+		if err != nil {
+			return err
+		}
+		delta += replies
+	}
+
+	comment.Status = req.Status
+	comment.ReviewedAt = &now
+	comment.ReviewerID = reviewerID
+	comment.ReviewRemark = req.ReviewRemark
+
+	if delta != 0 {
+		if err := s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, delta); err != nil {
+			return err
+		}
+	}
+
+	// 被拒绝的评论取消置顶，不再保留提及
+	if isRejected {
+		if comment.PinnedAt != nil {
+			if err := s.CommentRepository.SetPinned(ctx, comment.ID, nil); err != nil {
+				return err
+			}
+			comment.PinnedAt = nil
+		}
+		return s.MentionService.DeleteSourceMentions(ctx, mentionSchema.MentionSourceComment, comment.ID)
+	}
+	return nil
+}
+
+// afterReview 审核后发送通知，并以管理员的审核结论训练垃圾评论分类器
+// 文章作者与协管员（byModerator）的管理只代表其对本人文章的处理意愿，不参与训练全站共用的分类器
+func (s *CommentService) afterReview(ctx context.Context, reviewerID uint, comment *schema.Comment, prevStatus int, req *schema.ReviewCommentRequest, byModerator bool) {
+	// 修改审核结论时会移出原结论的统计
+	if !byModerator {
+		label := schema.SpamLabelHam
+		if req.Status == schema.CommentStatusRejected {
+			label = schema.SpamLabelSpam
+		}
+		s.trainSpam(ctx, comment, label)
+	}
+
+	// 评论变为通过后通知被回复的用户与评论中被提及的用户
+	if req.Status == schema.CommentStatusApproved && prevStatus != schema.CommentStatusApproved {
+		s.syncMentions(ctx, comment)
+		s.notifyPublished(ctx, comment)
+	}
+	// 确认先发后审的评论时审核结论未变，无需通知作者
+	if req.Status != prevStatus {
+		s.notifyReviewed(ctx, reviewerID, comment, req, byModerator)
+	}
+}
@@ -0,0 +1,117 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	articleSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// articleSetting 计算文章生效的评论设置，文章未单独设置的字段使用站点默认设置
+func (s *CommentService) articleSetting(ctx context.Context, article *articleSchema.Article) (*schema.CommentSettingResponse, error) {
+	defaults := config.C.Comment.Default
+	result := &schema.CommentSettingResponse{
+		ArticleID:     article.ID,
+		Status:        defaults.Status,
+		AutoCloseDays: defaults.AutoCloseDays,
+		Moderation:    defaults.Moderation,
+	}
+
+	setting, err := s.CommentSettingRepository.Get(ctx, article.ID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if setting != nil {
+		result.Customized = true
+		if setting.Status != "" {
+			result.Status = setting.Status
+		}
+		if setting.AutoCloseDays > 0 {
+			result.AutoCloseDays = setting.AutoCloseDays
+		}
+		if setting.Moderation != "" {
+			result.Moderation = setting.Moderation
+		}
+	}
+
+	switch result.Status {
+	case schema.CommentSettingStatusClosed:
+		result.Closed = true
+	case schema.CommentSettingStatusAuto:
+		// 从首次发布时起算，未记录发布时间的文章从创建时起算，尚未发布的文章不自动关闭
+		publishedAt := article.PublishedAt
+		if publishedAt == nil && article.Status == articleSchema.ArticleStatusPublished {
+			publishedAt = &article.CreatedAt
+		}
+		if publishedAt != nil && result.AutoCloseDays > 0 {
+			closesAt := publishedAt.AddDate(0, 0, result.AutoCloseDays)
+			result.ClosesAt = &closesAt
+			result.Closed = time.Now().After(closesAt)
+		}
+	}
+	return result, nil
+}
+
+// CommentsClosed 判断文章是否已关闭评论，供接收站外回复与引用的模块在保存前检查
+func (s *CommentService) CommentsClosed(ctx context.Context, article *articleSchema.Article) (bool, error) {
+	setting, err := s.articleSetting(ctx, article)
+	if err != nil {
+		return false, err
+	}
+	return setting.Closed, nil
+}
+
+// isTrustedCommenter 判断用户能否在仅限受信任用户评论的文章下评论：
+// 管理员、受信任角色的用户、文章作者及其协管员受信任，站外来源的评论不受信任
+func (s *CommentService) isTrustedCommenter(ctx context.Context, userID uint, article *articleSchema.Article) (bool, error) {
+	if userID == 0 {
+		return false, nil
+	}
+	if util.FromIsAdminUser(ctx) || article.AuthorID == userID {
+		return true, nil
+	}
+	if slices.Contains(config.C.Comment.TrustedRoles, util.FromUserCache(ctx).Role) {
+		return true, nil
+	}
+	return s.CommentModeratorRepository.Exists(ctx, article.AuthorID, userID)
+}
+
+// GetCommentSetting 获取文章生效的评论设置
+func (s *CommentService) GetCommentSetting(ctx context.Context, articleID uint) (*schema.CommentSettingResponse, error) {
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	return s.articleSetting(ctx, article)
+}
+
+// UpdateCommentSetting 更新文章的评论设置（仅文章作者与管理员可用），所有字段为空时恢复使用站点默认设置
+func (s *CommentService) UpdateCommentSetting(ctx context.Context, userID, articleID uint, req *schema.UpdateCommentSettingRequest) (*schema.CommentSettingResponse, error) {
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if article.AuthorID != userID && !util.FromIsAdminUser(ctx) {
+		return nil, errors.Forbidden("无权限修改此文章的评论设置")
+	}
+
+	if req.Status == "" && req.AutoCloseDays == 0 && req.Moderation == "" {
+		err = s.CommentSettingRepository.Delete(ctx, articleID)
+	} else {
+		err = s.CommentSettingRepository.Save(ctx, &schema.CommentSetting{
+			ArticleID:     articleID,
+			Status:        req.Status,
+			AutoCloseDays: req.AutoCloseDays,
+			Moderation:    req.Moderation,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.articleSetting(ctx, article)
+}
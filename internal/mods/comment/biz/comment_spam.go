@@ -0,0 +1,143 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/json"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/spam"
+)
+
+// 贝叶斯分类的特征数限制
+const (
+	spamMaxEvidence  = 15 // 参与计算的最大特征数
+	spamEvidenceShow = 5  // 评分说明中列出的特征数
+)
+
+// scoreSpam 计算评论的垃圾评论评分，各项信号的分数相加后限制在 0-100
+// 单项信号计算失败时跳过该信号并记录日志，不影响评论发表
+func (s *CommentService) scoreSpam(ctx context.Context, comment *schema.Comment) *schema.SpamResult {
+	cfg := config.C.Comment.Spam
+	now := time.Now()
+	result := &schema.SpamResult{Signals: make([]schema.SpamSignal, 0)}
+	add := func(name string, score int, format string, args ...any) {
+		result.Signals = append(result.Signals, schema.SpamSignal{Name: name, Score: score, Detail: fmt.Sprintf(format, args...)})
+		result.Score += score
+	}
+	logError := func(signal string, err error) {
+		logging.Context(ctx).Warn("计算垃圾评论信号失败", zap.Error(err), zap.String("signal", signal))
+	}
+
+	// 链接数量
+	if links := len(spam.Links(comment.Content)); links > cfg.MaxLinks {
+		add(schema.SpamSignalLinks, (links-cfg.MaxLinks)*cfg.LinkScore, "包含 %d 个链接", links)
+	}
+
+	// 重复内容
+	since := now.Add(-time.Duration(cfg.DuplicateWindow) * time.Hour)
+	if count, err := s.CommentRepository.CountSameContentSince(ctx, comment.Content, since, comment.ID); err != nil {
+		logError(schema.SpamSignalDuplicate, err)
+	} else if count > 0 {
+		add(schema.SpamSignalDuplicate, cfg.DuplicateScore, "%d 小时内有 %d 条内容相同的评论", cfg.DuplicateWindow, count)
+	}
+
+	// 账号注册时间与发布频率，站外来源的评论没有作者
+	if comment.AuthorID > 0 {
+		if user, err := s.UserRepository.GetByID(ctx, comment.AuthorID); err != nil {
+			logError(schema.SpamSignalNewAccount, err)
+		} else if age := now.Sub(user.CreatedAt); age < time.Duration(cfg.NewAccountHours)*time.Hour {
+			add(schema.SpamSignalNewAccount, cfg.NewAccountScore, "账号注册不足 %d 小时", cfg.NewAccountHours)
+		}
+
+		if count, err := s.CommentRepository.CountByAuthorSince(ctx, comment.AuthorID, now.Add(-time.Hour), comment.ID); err != nil {
+			logError(schema.SpamSignalRate, err)
+		} else if int(count) >= cfg.RateLimit {
+			add(schema.SpamSignalRate, cfg.RateScore, "一小时内已发表 %d 条评论", count)
+		}
+	}
+
+	// 屏蔽词
+	content := strings.ToLower(comment.Content)
+	for _, term := range cfg.Blocklist {
+		if term = strings.TrimSpace(term); term != "" && strings.Contains(content, strings.ToLower(term)) {
+			add(schema.SpamSignalBlocklist, cfg.BlocklistScore, "命中屏蔽词：%s", term)
+		}
+	}
+
+	// 贝叶斯分类，样本不足时不参与评分
+	if probability, evidence, ok := s.classifySpam(ctx, comment.Content); ok {
+		score := int(math.Round((probability - 0.5) * 2 * float64(cfg.BayesWeight)))
+		tokens := make([]string, 0, spamEvidenceShow)
+		for i := 0; i < len(evidence) && i < spamEvidenceShow; i++ {
+			tokens = append(tokens, evidence[i].Token)
+		}
+		add(schema.SpamSignalBayes, score, "垃圾评论概率 %.2f，主要特征：%s", probability, strings.Join(tokens, "、"))
+	}
+
+	result.Score = max(0, min(100, result.Score))
+	return result
+}
+
+// classifySpam 使用审核记录训练的贝叶斯分类器计算内容为垃圾评论的概率
+func (s *CommentService) classifySpam(ctx context.Context, content string) (float64, []spam.Evidence, bool) {
+	spamDocs, hamDocs, err := s.CommentSpamRepository.GetCorpus(ctx)
+	if err != nil {
+		logging.Context(ctx).Warn("获取垃圾评论样本数失败", zap.Error(err))
+		return 0, nil, false
+	}
+	classifier := &spam.Classifier{SpamDocs: spamDocs, HamDocs: hamDocs, MaxEvidence: spamMaxEvidence}
+	if !classifier.Ready(config.C.Comment.Spam.BayesMinDocs) {
+		return 0, nil, false
+	}
+
+	tokens := spam.Tokenize(content)
+	counts, err := s.CommentSpamRepository.GetTokenCounts(ctx, tokens)
+	if err != nil {
+		logging.Context(ctx).Warn("获取垃圾评论特征统计失败", zap.Error(err))
+		return 0, nil, false
+	}
+	probability, evidence := classifier.Classify(tokens, counts)
+	return probability, evidence, true
+}
+
+// applySpamScore 为评论评分并记录评分依据，返回评分结果
+func (s *CommentService) applySpamScore(ctx context.Context, comment *schema.Comment) *schema.SpamResult {
+	result := s.scoreSpam(ctx, comment)
+	comment.SpamScore = &result.Score
+	comment.SpamSignals = json.MarshalToString(result.Signals)
+	return result
+}
+
+// trainSpam 以审核员的审核结论训练分类器，结论变化时先移出原结论的统计
+// 训练失败不影响审核，仅记录日志
+func (s *CommentService) trainSpam(ctx context.Context, comment *schema.Comment, label string) {
+	if comment.SpamLabel == label {
+		return
+	}
+
+	tokens := spam.Tokenize(comment.Content)
+	err := s.Trans.Exec(ctx, func(ctx context.Context) error {
+		if comment.SpamLabel != "" {
+			if err := s.CommentSpamRepository.Train(ctx, tokens, comment.SpamLabel, -1); err != nil {
+				return err
+			}
+		}
+		if err := s.CommentSpamRepository.Train(ctx, tokens, label, 1); err != nil {
+			return err
+		}
+		return s.CommentRepository.UpdateSpamLabel(ctx, comment.ID, label)
+	})
+	if err != nil {
+		logging.Context(ctx).Error("训练垃圾评论分类器失败", zap.Error(err), zap.Uint("comment_id", comment.ID))
+		return
+	}
+	comment.SpamLabel = label
+}
@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"slices"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+const (
+	defaultTreeMaxDepth    = 5  // 评论树默认展示的最大回复深度，避免一次加载整棵评论树
+	defaultTreeMaxChildren = 10 // 评论树中每条评论默认最多展示的直接回复数
+)
+
+// GetCommentTree 获取文章的评论树：根评论分页，回复逐层查询，每条评论最多取回复数上限条直接回复后组装为嵌套结构，
+// 超出最大深度或回复数上限的回复不加载，以未展示的回复数标记，由客户端通过回复接口加载
+func (s *CommentService) GetCommentTree(ctx context.Context, articleID uint, req *schema.CommentTreeRequest) (*schema.CommentTreeResult, error) {
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+	if req.SortByCreate == "" {
+		req.SortByCreate = "desc"
+	}
+	if req.MaxDepth <= 0 {
+		req.MaxDepth = defaultTreeMaxDepth
+	}
+	if req.MaxChildren <= 0 {
+		req.MaxChildren = defaultTreeMaxChildren
+	}
+
+	// 管理员可以查看待审核评论
+	if util.FromIsAdminUser(ctx) {
+		req.IncludePending = true
+	}
+
+	roots, err := s.CommentRepository.GetArticleComments(ctx, articleID, &req.ArticleCommentsRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// 逐层获取需要展示的回复
+	children := make(map[uint][]schema.Comment)
+	var shown []schema.Comment
+	parentIDs := make([]uint, 0, len(roots.Items))
+	for _, root := range roots.Items {
+		parentIDs = append(parentIDs, root.ID)
+	}
+	allIDs := slices.Clone(parentIDs)
+	for depth := 1; depth <= req.MaxDepth && len(parentIDs) > 0; depth++ {
+		replies, err := s.CommentRepository.GetChildrenLimited(ctx, parentIDs, req.IncludePending, req.MaxChildren)
+		if err != nil {
+			return nil, err
+		}
+
+		parentIDs = make([]uint, 0, len(replies))
+		for _, reply := range replies {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+			parentIDs = append(parentIDs, reply.ID)
+		}
+		shown = append(shown, replies...)
+		allIDs = append(allIDs, parentIDs...)
+	}
+
+	// 统计各评论的直接回复数，用于计算未展示的回复数与回复的回复数量
+	stats, err := s.CommentRepository.CountChildrenByParent(ctx, allIDs, req.IncludePending)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]schema.CommentResponse, 0, len(shown))
+	for i := range shown {
+		responses = append(responses, replyResponse(&shown[i], stats[shown[i].ID].Approved))
+	}
+	s.CommentRepository.FillAuthorInfos(ctx, responses)
+	s.fillMyReactions(ctx, roots.Items)
+	s.fillMentions(ctx, roots.Items)
+	s.fillMyReactions(ctx, responses)
+	s.fillMentions(ctx, responses)
+
+	// 组装评论树
+	responseByID := make(map[uint]*schema.CommentResponse, len(responses))
+	for i := range responses {
+		responseByID[responses[i].ID] = &responses[i]
+	}
+	var build func(resp *schema.CommentResponse) schema.CommentTreeNode
+	build = func(resp *schema.CommentResponse) schema.CommentTreeNode {
+		node := schema.CommentTreeNode{
+			CommentResponse: *resp,
+			Children:        make([]schema.CommentTreeNode, 0, len(children[resp.ID])),
+			MoreReplies:     stats[resp.ID].Total - len(children[resp.ID]),
+		}
+		for _, child := range children[resp.ID] {
+			node.Children = append(node.Children, build(responseByID[child.ID]))
+		}
+		return node
+	}
+
+	result := &schema.CommentTreeResult{
+		Items:      make([]schema.CommentTreeNode, 0, len(roots.Items)),
+		Total:      roots.Total,
+		Page:       roots.Page,
+		PageSize:   roots.PageSize,
+		TotalPages: roots.TotalPages,
+	}
+	for i := range roots.Items {
+		result.Items = append(result.Items, build(&roots.Items[i]))
+	}
+	return result, nil
+}
+
+// replyResponse 构造评论树中回复的响应数据，replyCount 为已审核通过、未删除且未被隐藏的直接回复数，作者信息由调用方批量填充
+func replyResponse(comment *schema.Comment, replyCount int64) schema.CommentResponse {
+	return schema.CommentResponse{
+		ID:            comment.ID,
+		Content:       comment.Content,
+		Type:          comment.Type,
+		SourceURL:     comment.SourceURL,
+		SourceAuthor:  comment.SourceAuthor,
+		AuthorID:      comment.AuthorID,
+		ArticleID:     comment.ArticleID,
+		ParentID:      comment.ParentID,
+		RootID:        comment.RootID,
+		Level:         comment.Level,
+		Status:        comment.Status,
+		Reactions:     comment.Reactions(),
+		ReactionCount: comment.ReactionCount,
+		ReplyCount:    replyCount,
+		Edited:        comment.EditedAt != nil,
+		EditedAt:      comment.EditedAt,
+		Deleted:       comment.IsDeleted(),
+		Hidden:        comment.HiddenBy != nil,
+		CreatedAt:     comment.CreatedAt,
+	}
+}
@@ -0,0 +1,151 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+)
+
+// SaveWebmention 保存已验证的外部引用：新引用以待审核评论的形式进入审核队列；
+// 已有引用的内容变化时记录历史版本，已通过的引用重新进入审核，避免来源页面替换内容后直接公开展示；
+// 来源失效后保留为墓碑的引用再次验证通过时，与物理删除后重新发送的引用一样作为新引用进入审核
+func (s *CommentService) SaveWebmention(ctx context.Context, articleID uint, sourceURL, author, content string) (*schema.Comment, error) {
+	// 敏感词过滤
+	content, _, err := s.SensitiveFilter.Screen(ctx, "引用内容", content)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.CommentRepository.GetBySourceURL(ctx, schema.CommentTypeWebmention, articleID, sourceURL)
+	if err == nil && !comment.IsDeleted() {
+		if comment.Content == content && comment.SourceAuthor == author {
+			return comment, nil
+		}
+		if err := s.updateWebmention(ctx, comment, author, content); err != nil {
+			return nil, err
+		}
+		return comment, nil
+	} else if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// 已关闭评论的文章不再接收新的引用，已有引用仍随来源页面更新
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	closed, err := s.CommentsClosed(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+	if closed {
+		return nil, errors.BadRequest("该文章已关闭评论")
+	}
+
+	if comment != nil {
+		if err := s.restoreWebmention(ctx, comment, author, content); err != nil {
+			return nil, err
+		}
+		return comment, nil
+	}
+
+	comment = &schema.Comment{
+		Content:      content,
+		Type:         schema.CommentTypeWebmention,
+		SourceURL:    sourceURL,
+		SourceAuthor: author,
+		ArticleID:    articleID,
+		Level:        1,
+		Status:       schema.CommentStatusPending,
+	}
+	if err := s.CommentRepository.Create(ctx, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// updateWebmention 更新来源页面内容已变化的引用，编辑前的内容记录为历史版本
+func (s *CommentService) updateWebmention(ctx context.Context, comment *schema.Comment, author, content string) error {
+	revision := &schema.CommentRevision{
+		CommentID: comment.ID,
+		Content:   comment.Content,
+		Status:    comment.Status,
+	}
+
+	now := time.Now()
+	comment.Content = content
+	comment.SourceAuthor = author
+	comment.EditedAt = &now
+	comment.EditCount++
+
+	requeue := comment.Status == schema.CommentStatusApproved
+	if requeue {
+		comment.Status = schema.CommentStatusPending
+		comment.ReviewedAt = nil
+		comment.ReviewerID = nil
+		comment.ReviewRemark = "来源内容变更后需重新审核"
+	}
+
+	// 待审核引用的内容已变化，原 AI 审核建议作废，重新排队等待 AI 审核
+	if comment.Status == schema.CommentStatusPending {
+		resetAIModeration(comment)
+	}
+
+	return s.Trans.Exec(ctx, func(ctx context.Context) error {
+		if err := s.CommentRevisionRepository.Create(ctx, revision); err != nil {
+			return err
+		}
+		if err := s.CommentRepository.UpdateSource(ctx, comment); err != nil {
+			return err
+		}
+
+		// 重新进入审核的引用不再占用文章评论数，审核通过后重新计入
+		if requeue && comment.HiddenBy == nil {
+			return s.ArticleRepository.IncrementCommentCount(ctx, comment.ArticleID, -1)
+		}
+		return nil
+	})
+}
+
+// restoreWebmention 恢复墓碑状态的引用，作为待审核的新引用重新进入审核队列
+// 墓碑不计入文章评论数，恢复为待审核后同样不计入，无需调整
+func (s *CommentService) restoreWebmention(ctx context.Context, comment *schema.Comment, author, content string) error {
+	comment.Content = content
+	comment.SourceAuthor = author
+	comment.DeletedAt = nil
+	comment.Status = schema.CommentStatusPending
+	comment.ReviewedAt = nil
+	comment.ReviewerID = nil
+	comment.ReviewRemark = ""
+	resetAIModeration(comment)
+	return s.CommentRepository.Restore(ctx, comment)
+}
+
+// resetAIModeration 清除评论的 AI 审核建议，使其重新排队等待 AI 审核
+func resetAIModeration(comment *schema.Comment) {
+	comment.AIVerdict = ""
+	comment.AIModeratedAt = nil
+	comment.AIAttempts = 0
+	comment.AINextAttemptAt = nil
+}
+
+// DeleteWebmention 删除来源已失效的外部引用，仍有回复时保留为墓碑
+func (s *CommentService) DeleteWebmention(ctx context.Context, articleID uint, sourceURL string) error {
+	comment, err := s.CommentRepository.GetBySourceURL(ctx, schema.CommentTypeWebmention, articleID, sourceURL)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if comment.IsDeleted() {
+		return nil
+	}
+
+	return s.Trans.Exec(ctx, func(ctx context.Context) error {
+		return s.removeComment(ctx, comment)
+	})
+}
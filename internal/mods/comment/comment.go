@@ -18,6 +18,7 @@ import (
 type Comment struct {
 	DB             *gorm.DB
 	CommentHandler *api.CommentHandler
+	CommentService *biz.CommentService
 }
 
 // Set 注入评论模块
@@ -28,12 +29,37 @@ var Set = wire.NewSet(
 	wire.Struct(new(api.CommentHandler), "*"),
 	wire.Struct(new(biz.CommentService), "*"),
 	wire.Struct(new(dal.CommentRepository), "*"),
+
+	// 评论表态相关结构体
+	wire.Struct(new(dal.CommentReactionRepository), "*"),
+
+	// 评论历史版本相关结构体
+	wire.Struct(new(dal.CommentRevisionRepository), "*"),
+
+	// 垃圾评论分类器相关结构体
+	wire.Struct(new(dal.CommentSpamRepository), "*"),
+
+	// 评论协管员相关结构体
+	wire.Struct(new(dal.CommentModeratorRepository), "*"),
+
+	// 文章评论设置相关结构体
+	wire.Struct(new(dal.CommentSettingRepository), "*"),
+
+	// 访客评论相关结构体
+	wire.Struct(new(dal.CommentGuestTokenRepository), "*"),
 )
 
 // AutoMigrate 自动迁移数据库
 func (c *Comment) AutoMigrate(ctx context.Context) error {
 	return c.DB.AutoMigrate(
 		&schema.Comment{},
+		&schema.CommentReaction{},
+		&schema.CommentRevision{},
+		&schema.CommentSpamToken{},
+		&schema.CommentSpamCorpus{},
+		&schema.CommentModerator{},
+		&schema.CommentSetting{},
+		&schema.CommentGuestToken{},
 	)
 }
 
@@ -44,6 +70,9 @@ func (c *Comment) Init(ctx context.Context) error {
 			return err
 		}
 	}
+
+	// 用户注册后发送认领其访客评论的邮件
+	c.CommentService.AuthService.OnRegister(c.CommentService.OnUserRegistered)
 	return nil
 }
 
@@ -52,14 +81,34 @@ func (c *Comment) RegisterRouters(ctx context.Context, comment *gin.RouterGroup)
 	// 评论接口
 	{
 		comment.GET("/article/:article_id", c.CommentHandler.GetArticleComments)
+		comment.GET("/article/:article_id/tree", c.CommentHandler.GetCommentTree)
+		comment.GET("/article/:article_id/settings", c.CommentHandler.GetCommentSetting)
+		comment.PUT("/article/:article_id/settings", c.CommentHandler.UpdateCommentSetting)
 		comment.GET("/:id", c.CommentHandler.GetComment)
 		comment.POST("", c.CommentHandler.CreateComment)
+		comment.PUT("/:id", c.CommentHandler.UpdateComment)
 		comment.DELETE("/:id", c.CommentHandler.DeleteComment)
 		comment.GET("/:id/replies", c.CommentHandler.GetCommentReplies)
+		comment.POST("/:id/reactions", c.CommentHandler.ReactComment)
 		comment.GET("/user", c.CommentHandler.GetUserComments)
+		// 访客评论接口
+		comment.POST("/guest", c.CommentHandler.CreateGuestComment)
+		comment.POST("/guest/verify", c.CommentHandler.VerifyGuestComment)
+		comment.POST("/guest/claim", c.CommentHandler.ClaimGuestComments)
+		comment.POST("/guest/claim/send", c.CommentHandler.SendGuestClaim)
+		// 文章作者与协管员接口
+		comment.GET("/moderation/queue", c.CommentHandler.GetModerationQueue)
+		comment.POST("/moderation/review", c.CommentHandler.ModerateComment)
+		comment.POST("/moderation/:id/hide", c.CommentHandler.HideComment)
+		comment.PUT("/moderation/:id/pin", c.CommentHandler.PinComment)
+		comment.GET("/moderators", c.CommentHandler.GetModerators)
+		comment.POST("/moderators", c.CommentHandler.AddModerator)
+		comment.DELETE("/moderators/:user_id", c.CommentHandler.RemoveModerator)
 		// 管理员接口
 		comment.GET("/review", c.CommentHandler.GetCommentsForReview)
 		comment.POST("/review", c.CommentHandler.ReviewComment)
+		comment.POST("/review/batch", c.CommentHandler.BatchReviewComments)
+		comment.GET("/:id/revisions", c.CommentHandler.GetCommentRevisions)
 	}
 	return nil
 }
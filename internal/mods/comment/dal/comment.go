@@ -13,6 +13,7 @@ import (
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
 	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
 	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
 	"github.com/codeExpert666/goinkblog-backend/pkg/util"
 )
 
@@ -31,38 +32,110 @@ func (r *CommentRepository) Create(ctx context.Context, comment *schema.Comment)
 	return errors.WithStack(result.Error)
 }
 
-// DeleteByID 通过 ID 删除评论
-func (r *CommentRepository) DeleteByID(ctx context.Context, id uint) error {
-	result := GetCommentDB(ctx, r.DB).Where("id = ?", id).Delete(&schema.Comment{})
+// Update 更新评论内容
+func (r *CommentRepository) Update(ctx context.Context, comment *schema.Comment) error {
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", comment.ID).Select("*").Omit("created_at").Updates(comment)
 	return errors.WithStack(result.Error)
 }
 
-// DeleteByParentID 通过父评论 ID 删除评论（递归删除），并返回删除的评论（审核通过）数量
-func (r *CommentRepository) DeleteByParentID(ctx context.Context, parentID uint) (int, error) {
-	// 先获取所有子评论
-	var comments []schema.Comment
-	if err := GetCommentDB(ctx, r.DB).Where("parent_id = ?", parentID).Find(&comments).Error; err != nil {
-		return 0, errors.WithStack(err)
-	}
+// UpdateEdited 保存编辑后的评论内容、审核状态、垃圾评论评分及 AI 审核状态
+func (r *CommentRepository) UpdateEdited(ctx context.Context, comment *schema.Comment) error {
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", comment.ID).
+		Select("content", "edited_at", "edit_count", "status", "reviewed_at", "reviewer_id", "review_remark", "spam_score", "spam_signals",
+			"ai_verdict", "ai_moderated_at", "ai_attempts", "ai_next_attempt_at").
+		Updates(comment)
+	return errors.WithStack(result.Error)
+}
 
-	if len(comments) == 0 {
-		return 0, nil
+// UpdateSource 保存来源页面变化后的外部引用内容、来源作者、编辑记录、审核状态及 AI 审核状态，已删除的引用不更新
+func (r *CommentRepository) UpdateSource(ctx context.Context, comment *schema.Comment) error {
+	result := GetCommentDB(ctx, r.DB).Where("id = ? AND deleted_at IS NULL", comment.ID).
+		Select("content", "source_author", "edited_at", "edit_count", "status", "reviewed_at", "reviewer_id", "review_remark",
+			"ai_verdict", "ai_moderated_at", "ai_attempts", "ai_next_attempt_at").
+		Updates(comment)
+	return errors.WithStack(result.Error)
+}
+
+// Restore 恢复已删除（墓碑）的外部引用，以新的内容重新进入审核
+func (r *CommentRepository) Restore(ctx context.Context, comment *schema.Comment) error {
+	result := GetCommentDB(ctx, r.DB).Where("id = ? AND deleted_at IS NOT NULL", comment.ID).
+		Select("content", "source_author", "deleted_at", "status", "reviewed_at", "reviewer_id", "review_remark",
+			"ai_verdict", "ai_moderated_at", "ai_attempts", "ai_next_attempt_at").
+		Updates(comment)
+	if result.Error != nil {
+		return errors.WithStack(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.Conflict("引用状态已变化，请稍后重试")
 	}
+	return nil
+}
 
-	// 递归删除子评论
-	var count int
-	for _, comment := range comments {
-		c, err := r.DeleteByParentID(ctx, comment.ID)
-		if err != nil {
-			return 0, err
-		}
-		if comment.Status == schema.CommentStatusApproved {
-			c += 1
+// IncrementReactionCount 增加（或减少）评论指定表态的数量，同时更新表态总数
+func (r *CommentRepository) IncrementReactionCount(ctx context.Context, id uint, reactionType string, value int) error {
+	column := schema.ReactionColumn(reactionType)
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		column:           gorm.Expr(column+" + ?", value),
+		"reaction_count": gorm.Expr("reaction_count + ?", value),
+	})
+	return errors.WithStack(result.Error)
+}
+
+// GetBySourceURL 获取文章中指定类型、来自指定外部来源的评论
+func (r *CommentRepository) GetBySourceURL(ctx context.Context, commentType string, articleID uint, sourceURL string) (*schema.Comment, error) {
+	var comment schema.Comment
+	err := GetCommentDB(ctx, r.DB).
+		Where("article_id = ? AND type = ? AND source_url = ?", articleID, commentType, sourceURL).
+		First(&comment).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("评论不存在")
 		}
-		count += c
+		return nil, errors.WithStack(err)
 	}
+	return &comment, nil
+}
+
+// DeleteByID 通过 ID 删除评论
+func (r *CommentRepository) DeleteByID(ctx context.Context, id uint) error {
+	// 删除评论的表态与历史版本
+	if err := GetCommentReactionDB(ctx, r.DB).Where("comment_id = ?", id).Delete(&schema.CommentReaction{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+	if err := GetCommentRevisionDB(ctx, r.DB).Where("comment_id = ?", id).Delete(&schema.CommentRevision{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", id).Delete(&schema.Comment{})
+	return errors.WithStack(result.Error)
+}
+
+// Tombstone 将评论替换为墓碑：清空内容、表态与历史版本，保留在评论树中以维持回复的上下文
+func (r *CommentRepository) Tombstone(ctx context.Context, id uint, deletedAt time.Time) error {
+	if err := GetCommentReactionDB(ctx, r.DB).Where("comment_id = ?", id).Delete(&schema.CommentReaction{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+	if err := GetCommentRevisionDB(ctx, r.DB).Where("comment_id = ?", id).Delete(&schema.CommentRevision{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"content":        schema.CommentTombstoneContent,
+		"deleted_at":     deletedAt,
+		"like_count":     0,
+		"heart_count":    0,
+		"laugh_count":    0,
+		"hooray_count":   0,
+		"confused_count": 0,
+		"reaction_count": 0,
+	})
+	return errors.WithStack(result.Error)
+}
 
-	err := GetCommentDB(ctx, r.DB).Where("parent_id = ?", parentID).Delete(&schema.Comment{}).Error
+// CountChildren 计算评论的直接回复数量（包括所有审核状态与墓碑）
+func (r *CommentRepository) CountChildren(ctx context.Context, id uint) (int64, error) {
+	var count int64
+	err := GetCommentDB(ctx, r.DB).Where("parent_id = ?", id).Count(&count).Error
 	return count, errors.WithStack(err)
 }
 
@@ -98,7 +171,11 @@ func (r *CommentRepository) GetArticleComments(ctx context.Context, articleID ui
 		return nil, errors.WithStack(err)
 	}
 
-	// 应用排序
+	// 应用排序，置顶评论排在最前，后置顶的在前
+	db = db.Order("pinned_at IS NULL").Order("pinned_at DESC")
+	if req.SortBy == "top" {
+		db = db.Order("reaction_count DESC")
+	}
 	if req.SortByCreate == "asc" {
 		db = db.Order("created_at ASC")
 	} else {
@@ -116,15 +193,24 @@ func (r *CommentRepository) GetArticleComments(ctx context.Context, articleID ui
 	var items []schema.CommentResponse
 	for _, comment := range comments {
 		commentResp := schema.CommentResponse{
-			ID:        comment.ID,
-			Content:   comment.Content,
-			AuthorID:  comment.AuthorID,
-			ArticleID: comment.ArticleID,
-			ParentID:  comment.ParentID,
-			RootID:    comment.RootID,
-			Level:     comment.Level,
-			Status:    comment.Status,
-			CreatedAt: comment.CreatedAt,
+			ID:            comment.ID,
+			Content:       comment.Content,
+			Type:          comment.Type,
+			SourceURL:     comment.SourceURL,
+			SourceAuthor:  comment.SourceAuthor,
+			AuthorID:      comment.AuthorID,
+			ArticleID:     comment.ArticleID,
+			ParentID:      comment.ParentID,
+			RootID:        comment.RootID,
+			Level:         comment.Level,
+			Status:        comment.Status,
+			Reactions:     comment.Reactions(),
+			ReactionCount: comment.ReactionCount,
+			Edited:        comment.EditedAt != nil,
+			EditedAt:      comment.EditedAt,
+			Deleted:       comment.IsDeleted(),
+			Pinned:        comment.PinnedAt != nil,
+			CreatedAt:     comment.CreatedAt,
 		}
 
 		// 填充评论作者信息
@@ -151,7 +237,7 @@ func (r *CommentRepository) CountReplies(ctx context.Context, commentID uint) (i
 	var count int64
 	err := GetCommentDB(ctx, r.DB).
 		Where("parent_id = ? OR root_id = ?", commentID, commentID).
-		Where("status = ?", schema.CommentStatusApproved).
+		Where("status = ? AND deleted_at IS NULL AND hidden_by IS NULL", schema.CommentStatusApproved).
 		Where("id != ?", commentID). // 排除自身
 		Count(&count).Error
 
@@ -183,9 +269,9 @@ func (r *CommentRepository) GetCommentReplies(ctx context.Context, commentID uin
 		db = db.Where("parent_id = ?", commentID)
 	}
 
-	// 只获取已审核通过的评论，除非管理员指定包含未审核评论
+	// 只获取已审核通过且未被隐藏的评论，除非管理员指定包含未审核评论
 	if !req.IncludePending {
-		db = db.Where("status = ?", schema.CommentStatusApproved)
+		db = db.Where("status = ? AND hidden_by IS NULL", schema.CommentStatusApproved)
 	}
 
 	// 计算总数
@@ -212,15 +298,24 @@ func (r *CommentRepository) GetCommentReplies(ctx context.Context, commentID uin
 	var items []schema.CommentResponse
 	for _, comment := range comments {
 		item := schema.CommentResponse{
-			ID:        comment.ID,
-			Content:   comment.Content,
-			AuthorID:  comment.AuthorID,
-			ArticleID: comment.ArticleID,
-			ParentID:  comment.ParentID,
-			RootID:    comment.RootID,
-			Level:     comment.Level,
-			Status:    comment.Status,
-			CreatedAt: comment.CreatedAt,
+			ID:            comment.ID,
+			Content:       comment.Content,
+			Type:          comment.Type,
+			SourceURL:     comment.SourceURL,
+			SourceAuthor:  comment.SourceAuthor,
+			AuthorID:      comment.AuthorID,
+			ArticleID:     comment.ArticleID,
+			ParentID:      comment.ParentID,
+			RootID:        comment.RootID,
+			Level:         comment.Level,
+			Status:        comment.Status,
+			Reactions:     comment.Reactions(),
+			ReactionCount: comment.ReactionCount,
+			Edited:        comment.EditedAt != nil,
+			EditedAt:      comment.EditedAt,
+			Deleted:       comment.IsDeleted(),
+			Hidden:        comment.HiddenBy != nil,
+			CreatedAt:     comment.CreatedAt,
 		}
 
 		// 填充基本信息
@@ -243,6 +338,56 @@ func (r *CommentRepository) GetCommentReplies(ctx context.Context, commentID uin
 	return &result, nil
 }
 
+// GetChildrenLimited 获取多条评论的直接回复，每条评论最多获取按创建时间升序排列的前 limit 条
+func (r *CommentRepository) GetChildrenLimited(ctx context.Context, parentIDs []uint, includePending bool, limit int) ([]schema.Comment, error) {
+	if len(parentIDs) == 0 {
+		return nil, nil
+	}
+
+	sub := GetCommentDB(ctx, r.DB).
+		Select("*, ROW_NUMBER() OVER (PARTITION BY parent_id ORDER BY created_at ASC, id ASC) AS row_num").
+		Where("parent_id IN ?", parentIDs)
+	// 只获取已审核通过且未被隐藏的评论，除非管理员指定包含未审核评论
+	if !includePending {
+		sub = sub.Where("status = ? AND hidden_by IS NULL", schema.CommentStatusApproved)
+	}
+
+	var comments []schema.Comment
+	err := util.GetDB(ctx, r.DB).Table("(?) AS c", sub).
+		Where("row_num <= ?", limit).
+		Order("created_at ASC").Order("id ASC").
+		Find(&comments).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return comments, nil
+}
+
+// CountChildrenByParent 统计多条评论的直接回复数：Total 为按 includePending 可见的回复数，Approved 为已通过、未删除且未被隐藏的回复数
+func (r *CommentRepository) CountChildrenByParent(ctx context.Context, parentIDs []uint, includePending bool) (map[uint]schema.CommentReplyStat, error) {
+	result := make(map[uint]schema.CommentReplyStat, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return result, nil
+	}
+
+	db := GetCommentDB(ctx, r.DB).
+		Select("parent_id, COUNT(*) AS total, "+
+			"SUM(CASE WHEN status = ? AND deleted_at IS NULL AND hidden_by IS NULL THEN 1 ELSE 0 END) AS approved", schema.CommentStatusApproved).
+		Where("parent_id IN ?", parentIDs)
+	if !includePending {
+		db = db.Where("status = ? AND hidden_by IS NULL", schema.CommentStatusApproved)
+	}
+
+	var stats []schema.CommentReplyStat
+	if err := db.Group("parent_id").Scan(&stats).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, stat := range stats {
+		result[stat.ParentID] = stat
+	}
+	return result, nil
+}
+
 // GetUserComments 获取用户的评论
 func (r *CommentRepository) GetUserComments(ctx context.Context, userID uint, req *schema.UserCommentsRequest) (*schema.CommentPaginationResult, error) {
 	var result schema.CommentPaginationResult
@@ -255,8 +400,8 @@ func (r *CommentRepository) GetUserComments(ctx context.Context, userID uint, re
 		req.PageSize = 10
 	}
 
-	// 用户可以看到自己的所有评论（包括待审核的、通过的、拒绝的）
-	db := GetCommentDB(ctx, r.DB).Where("author_id = ?", userID)
+	// 用户可以看到自己的所有评论（包括待审核的、通过的、拒绝的），已删除的除外
+	db := GetCommentDB(ctx, r.DB).Where("author_id = ? AND deleted_at IS NULL", userID)
 
 	// 计算总数
 	var total int64
@@ -277,18 +422,28 @@ func (r *CommentRepository) GetUserComments(ctx context.Context, userID uint, re
 	var items []schema.CommentResponse
 	for _, comment := range comments {
 		item := schema.CommentResponse{
-			ID:           comment.ID,
-			Content:      comment.Content,
-			AuthorID:     comment.AuthorID,
-			ArticleID:    comment.ArticleID,
-			ParentID:     comment.ParentID,
-			RootID:       comment.RootID,
-			Level:        comment.Level,
-			Status:       comment.Status,
-			ReviewedAt:   comment.ReviewedAt,
-			ReviewerID:   comment.ReviewerID,
-			ReviewRemark: comment.ReviewRemark,
-			CreatedAt:    comment.CreatedAt,
+			ID:            comment.ID,
+			Content:       comment.Content,
+			Type:          comment.Type,
+			SourceURL:     comment.SourceURL,
+			SourceAuthor:  comment.SourceAuthor,
+			AuthorID:      comment.AuthorID,
+			ArticleID:     comment.ArticleID,
+			ParentID:      comment.ParentID,
+			RootID:        comment.RootID,
+			Level:         comment.Level,
+			Status:        comment.Status,
+			ReviewedAt:    comment.ReviewedAt,
+			Reactions:     comment.Reactions(),
+			ReactionCount: comment.ReactionCount,
+			Edited:        comment.EditedAt != nil,
+			EditedAt:      comment.EditedAt,
+			Deleted:       comment.IsDeleted(),
+			Pinned:        comment.PinnedAt != nil,
+			Hidden:        comment.HiddenBy != nil,
+			ReviewerID:    comment.ReviewerID,
+			ReviewRemark:  comment.ReviewRemark,
+			CreatedAt:     comment.CreatedAt,
 		}
 		r.FillArticleInfo(ctx, &item)
 		r.FillParentCommentInfo(ctx, &item)
@@ -318,8 +473,100 @@ func (r *CommentRepository) GetCommentsForReview(ctx context.Context, req *schem
 		req.PageSize = 10
 	}
 
-	db := GetCommentDB(ctx, r.DB)
+	db := applyReviewFilter(GetCommentDB(ctx, r.DB).Where("deleted_at IS NULL"), &req.CommentReviewFilter)
 
+	// 计算总数
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 应用排序
+	if req.SortBy == "review" {
+		// 按审核时间排序
+		if req.SortOrder == "asc" {
+			db = db.Order("reviewed_at ASC")
+		} else {
+			db = db.Order("reviewed_at DESC")
+		}
+	} else if req.SortBy == "spam" {
+		// 按垃圾评论评分排序，评分相同时按创建时间倒序
+		if req.SortOrder == "asc" {
+			db = db.Order("spam_score ASC").Order("created_at DESC")
+		} else {
+			db = db.Order("spam_score DESC").Order("created_at DESC")
+		}
+	} else {
+		// 默认按创建时间排序
+		if req.SortOrder == "asc" {
+			db = db.Order("created_at ASC")
+		} else {
+			db = db.Order("created_at DESC")
+		}
+	}
+
+	// 分页
+	offset := (req.Page - 1) * req.PageSize
+	var comments []schema.Comment
+	if err := db.Offset(offset).Limit(req.PageSize).Find(&comments).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// 构造响应数据
+	var items []schema.CommentResponse
+	for _, comment := range comments {
+		item := schema.CommentResponse{
+			ID:            comment.ID,
+			Content:       comment.Content,
+			Type:          comment.Type,
+			SourceURL:     comment.SourceURL,
+			SourceAuthor:  comment.SourceAuthor,
+			AuthorID:      comment.AuthorID,
+			ArticleID:     comment.ArticleID,
+			ParentID:      comment.ParentID,
+			RootID:        comment.RootID,
+			Level:         comment.Level,
+			Status:        comment.Status,
+			ReviewedAt:    comment.ReviewedAt,
+			Reactions:     comment.Reactions(),
+			ReactionCount: comment.ReactionCount,
+			Edited:        comment.EditedAt != nil,
+			EditedAt:      comment.EditedAt,
+			Deleted:       comment.IsDeleted(),
+			Pinned:        comment.PinnedAt != nil,
+			Hidden:        comment.HiddenBy != nil,
+			ReviewerID:    comment.ReviewerID,
+			ReviewRemark:  comment.ReviewRemark,
+			SpamScore:     comment.SpamScore,
+			SpamSignals:   comment.SpamSignalList(),
+			AIVerdict:     comment.AIVerdictOf(),
+			CreatedAt:     comment.CreatedAt,
+		}
+
+		// 填充关联信息
+		r.FillAuthorInfo(ctx, &item)
+		r.FillArticleInfo(ctx, &item)
+		r.FillParentCommentInfo(ctx, &item)
+		r.FillReviewerInfo(ctx, &item)
+
+		// 计算回复数量
+		replyCount, _ := r.CountReplies(ctx, comment.ID)
+		item.ReplyCount = replyCount
+
+		items = append(items, item)
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = req.Page
+	result.PageSize = req.PageSize
+	result.TotalPages = int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
+
+	return &result, nil
+}
+
+// applyReviewFilter 应用评论审核列表的筛选条件
+func applyReviewFilter(db *gorm.DB, req *schema.CommentReviewFilter) *gorm.DB {
 	// 应用基本筛选条件
 	if req.Status != nil {
 		db = db.Where("status = ?", *req.Status)
@@ -349,6 +596,10 @@ func (r *CommentRepository) GetCommentsForReview(ctx context.Context, req *schem
 		db = db.Where("content LIKE ?", "%"+req.Keyword+"%")
 	}
 
+	if req.Type != "" {
+		db = db.Where("type = ?", req.Type)
+	}
+
 	// 应用时间范围筛选
 	if req.CreateStartTime != nil {
 		db = db.Where("created_at >= ?", req.CreateStartTime)
@@ -371,78 +622,29 @@ func (r *CommentRepository) GetCommentsForReview(ctx context.Context, req *schem
 		db = db.Where("reviewer_id = ?", *req.ReviewerID)
 	}
 
-	// 计算总数
-	var total int64
-	if err := db.Count(&total).Error; err != nil {
-		return nil, errors.WithStack(err)
+	// 垃圾评论评分筛选
+	if req.MinSpamScore != nil {
+		db = db.Where("spam_score >= ?", *req.MinSpamScore)
 	}
 
-	// 应用排序
-	if req.SortBy == "review" {
-		// 按审核时间排序
-		if req.SortOrder == "asc" {
-			db = db.Order("reviewed_at ASC")
-		} else {
-			db = db.Order("reviewed_at DESC")
-		}
-	} else {
-		// 默认按创建时间排序
-		if req.SortOrder == "asc" {
-			db = db.Order("created_at ASC")
-		} else {
-			db = db.Order("created_at DESC")
-		}
+	// 尚未经审核的评论（待审核及先发后审的评论）
+	if req.Unreviewed {
+		db = db.Where("status IN ? AND reviewed_at IS NULL", []int{schema.CommentStatusPending, schema.CommentStatusApproved})
 	}
 
-	// 分页
-	offset := (req.Page - 1) * req.PageSize
-	var comments []schema.Comment
-	if err := db.Offset(offset).Limit(req.PageSize).Find(&comments).Error; err != nil {
-		return nil, errors.WithStack(err)
+	// 限定文章作者
+	if req.ArticleAuthorIDs != nil {
+		articles := db.Session(&gorm.Session{NewDB: true}).Model(&articleSchema.Article{}).
+			Select("id").Where("author_id IN ?", req.ArticleAuthorIDs)
+		db = db.Where("article_id IN (?)", articles)
 	}
 
-	// 构造响应数据
-	var items []schema.CommentResponse
-	for _, comment := range comments {
-		item := schema.CommentResponse{
-			ID:           comment.ID,
-			Content:      comment.Content,
-			AuthorID:     comment.AuthorID,
-			ArticleID:    comment.ArticleID,
-			ParentID:     comment.ParentID,
-			RootID:       comment.RootID,
-			Level:        comment.Level,
-			Status:       comment.Status,
-			ReviewedAt:   comment.ReviewedAt,
-			ReviewerID:   comment.ReviewerID,
-			ReviewRemark: comment.ReviewRemark,
-			CreatedAt:    comment.CreatedAt,
-		}
-
-		// 填充关联信息
-		r.FillAuthorInfo(ctx, &item)
-		r.FillArticleInfo(ctx, &item)
-		r.FillParentCommentInfo(ctx, &item)
-		r.FillReviewerInfo(ctx, &item)
-
-		// 计算回复数量
-		replyCount, _ := r.CountReplies(ctx, comment.ID)
-		item.ReplyCount = replyCount
-
-		items = append(items, item)
-	}
-
-	result.Items = items
-	result.Total = total
-	result.Page = req.Page
-	result.PageSize = req.PageSize
-	result.TotalPages = int((total + int64(req.PageSize) - 1) / int64(req.PageSize))
-
-	return &result, nil
+	return db
 }
 
-// UpdateCommentStatus 更新评论状态
-func (r *CommentRepository) UpdateCommentStatus(ctx context.Context, reviewerID uint, req *schema.ReviewCommentRequest, reviewedAt *time.Time) error {
+// UpdateCommentStatus 将评论从 fromStatus 更新为新的审核状态，reviewerID 为空表示系统自动审核
+// 评论已不是 fromStatus 时（如已被其他审核员或自动审核处理）返回错误
+func (r *CommentRepository) UpdateCommentStatus(ctx context.Context, fromStatus int, reviewerID *uint, req *schema.ReviewCommentRequest, reviewedAt *time.Time) error {
 	updates := map[string]interface{}{
 		"status":        req.Status,
 		"reviewer_id":   reviewerID,
@@ -450,13 +652,31 @@ func (r *CommentRepository) UpdateCommentStatus(ctx context.Context, reviewerID
 		"reviewed_at":   reviewedAt,
 	}
 
-	err := GetCommentDB(ctx, r.DB).Where("id = ?", req.CommentID).Updates(updates).Error
-
-	return errors.WithStack(err)
+	result := GetCommentDB(ctx, r.DB).
+		Where("id = ? AND status = ?", req.CommentID, fromStatus).
+		Updates(updates)
+	if result.Error != nil {
+		return errors.WithStack(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.BadRequest("评论审核状态已变化，请刷新后重试")
+	}
+	return nil
 }
 
 // FillAuthorInfo 填充评论作者信息（名称、头像）
 func (r *CommentRepository) FillAuthorInfo(ctx context.Context, commentResp *schema.CommentResponse) {
+	// 已删除的评论不展示作者
+	if commentResp.Deleted {
+		return
+	}
+
+	// 外部引用与访客评论没有站内作者，使用来源作者
+	if commentResp.AuthorID == 0 {
+		commentResp.Author = commentResp.SourceAuthor
+		return
+	}
+
 	var authorInfo struct {
 		Username string `json:"username"`
 		Avatar   string `json:"avatar"`
@@ -480,6 +700,49 @@ func (r *CommentRepository) FillAuthorInfo(ctx context.Context, commentResp *sch
 	}
 }
 
+// FillAuthorInfos 批量填充评论作者信息，所有站内作者一次查询取出
+func (r *CommentRepository) FillAuthorInfos(ctx context.Context, items []schema.CommentResponse) {
+	authorIDs := make([]uint, 0, len(items))
+	for i := range items {
+		if items[i].Deleted {
+			continue
+		}
+		if items[i].AuthorID == 0 {
+			items[i].Author = items[i].SourceAuthor
+			continue
+		}
+		authorIDs = append(authorIDs, items[i].AuthorID)
+	}
+	if len(authorIDs) == 0 {
+		return
+	}
+
+	var authors []struct {
+		ID       uint
+		Username string
+		Avatar   string
+	}
+	err := userDal.GetUserDB(ctx, r.DB).
+		Select("id", "username", "avatar").
+		Where("id IN ?", authorIDs).
+		Find(&authors).Error
+	if err != nil {
+		logging.Context(ctx).Error("批量获取评论作者信息失败", zap.Error(errors.WithStack(err)))
+		return
+	}
+
+	byID := make(map[uint]int, len(authors))
+	for i := range authors {
+		byID[authors[i].ID] = i
+	}
+	for i := range items {
+		if j, ok := byID[items[i].AuthorID]; ok && !items[i].Deleted {
+			items[i].Author = authors[j].Username
+			items[i].Avatar = authors[j].Avatar
+		}
+	}
+}
+
 // FillReviewerInfo 填充评论审核人员信息
 func (r *CommentRepository) FillReviewerInfo(ctx context.Context, commentResp *schema.CommentResponse) {
 	if commentResp.ReviewerID == nil {
@@ -537,13 +800,14 @@ func (r *CommentRepository) FillParentCommentInfo(ctx context.Context, commentRe
 	}
 
 	var parentCommentInfo struct {
-		Content  string `json:"content"`
-		AuthorID uint   `json:"author_id"`
+		Content   string     `json:"content"`
+		AuthorID  uint       `json:"author_id"`
+		DeletedAt *time.Time `json:"deleted_at"`
 	}
 
 	// 查询父评论内容和作者
 	err := GetCommentDB(ctx, r.DB).
-		Select("content", "author_id").
+		Select("content", "author_id", "deleted_at").
 		Where("id = ?", *commentResp.ParentID).
 		First(&parentCommentInfo).Error
 
@@ -551,6 +815,11 @@ func (r *CommentRepository) FillParentCommentInfo(ctx context.Context, commentRe
 	if err == nil {
 		commentResp.ParentContent = parentCommentInfo.Content
 
+		// 已删除的父评论不展示作者
+		if parentCommentInfo.DeletedAt != nil {
+			return
+		}
+
 		// 获取父评论作者名称
 		var authorInfo struct {
 			Username string `json:"username"`
@@ -577,3 +846,167 @@ func (r *CommentRepository) FillParentCommentInfo(ctx context.Context, commentRe
 			zap.Error(errors.WithStack(err)))
 	}
 }
+
+// CountByAuthorSince 统计作者在指定时间之后发表的评论数，excludeID 不为 0 时排除该评论
+func (r *CommentRepository) CountByAuthorSince(ctx context.Context, authorID uint, since time.Time, excludeID uint) (int64, error) {
+	var count int64
+	err := GetCommentDB(ctx, r.DB).
+		Where("author_id = ? AND created_at >= ? AND id <> ?", authorID, since, excludeID).
+		Count(&count).Error
+	return count, errors.WithStack(err)
+}
+
+// CountSameContentSince 统计指定时间之后内容完全相同的评论数，excludeID 不为 0 时排除该评论
+func (r *CommentRepository) CountSameContentSince(ctx context.Context, content string, since time.Time, excludeID uint) (int64, error) {
+	var count int64
+	err := GetCommentDB(ctx, r.DB).
+		Where("content = ? AND created_at >= ? AND id <> ?", content, since, excludeID).
+		Count(&count).Error
+	return count, errors.WithStack(err)
+}
+
+// UpdateSpamLabel 记录评论已用于训练分类器的审核结论
+func (r *CommentRepository) UpdateSpamLabel(ctx context.Context, id uint, label string) error {
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", id).UpdateColumn("spam_label", label)
+	return errors.WithStack(result.Error)
+}
+
+// ClaimForAIModeration 领取待 AI 审核的评论，领取后评论在 lease 时间内不会被其他实例重复领取
+func (r *CommentRepository) ClaimForAIModeration(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]schema.Comment, error) {
+	var comments []schema.Comment
+	now := time.Now()
+	err := GetCommentDB(ctx, r.DB).
+		Where("status = ? AND deleted_at IS NULL AND ai_moderated_at IS NULL AND ai_attempts < ?", schema.CommentStatusPending, maxAttempts).
+		Where("ai_next_attempt_at IS NULL OR ai_next_attempt_at <= ?", now).
+		Order("id ASC").
+		Limit(limit).
+		Find(&comments).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return outbox.Claim(func() *gorm.DB { return GetCommentDB(ctx, r.DB) }, comments, "ai_next_attempt_at", now.Add(lease),
+		func(comment *schema.Comment) (uint, *time.Time) { return comment.ID, comment.AINextAttemptAt })
+}
+
+// SaveAIVerdict 保存评论的 AI 审核建议
+func (r *CommentRepository) SaveAIVerdict(ctx context.Context, id uint, verdict string, moderatedAt time.Time) error {
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"ai_verdict":         verdict,
+		"ai_moderated_at":    moderatedAt,
+		"ai_next_attempt_at": nil,
+	})
+	return errors.WithStack(result.Error)
+}
+
+// UpdateAIAttempt 记录评论 AI 审核的失败次数与下次尝试时间
+func (r *CommentRepository) UpdateAIAttempt(ctx context.Context, id uint, attempts int, nextAttemptAt time.Time) error {
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", id).UpdateColumns(map[string]interface{}{
+		"ai_attempts":        attempts,
+		"ai_next_attempt_at": nextAttemptAt,
+	})
+	return errors.WithStack(result.Error)
+}
+
+// GetByIDs 批量获取评论，按ID升序
+func (r *CommentRepository) GetByIDs(ctx context.Context, ids []uint) ([]schema.Comment, error) {
+	var comments []schema.Comment
+	err := GetCommentDB(ctx, r.DB).Where("id IN ?", ids).Order("id ASC").Find(&comments).Error
+	return comments, errors.WithStack(err)
+}
+
+// FindForBatchReview 按审核列表的筛选条件选择未删除的评论，只选择处于 statuses 状态的评论及先发后审的评论，按ID升序最多选择 limit 条
+func (r *CommentRepository) FindForBatchReview(ctx context.Context, filter *schema.CommentReviewFilter, statuses []int, limit int) ([]schema.Comment, error) {
+	var comments []schema.Comment
+	err := applyReviewFilter(GetCommentDB(ctx, r.DB).Where("deleted_at IS NULL"), filter).
+		Where("status IN ? OR (status = ? AND reviewed_at IS NULL)", statuses, schema.CommentStatusApproved).
+		Order("id ASC").
+		Limit(limit).
+		Find(&comments).Error
+	return comments, errors.WithStack(err)
+}
+
+// UpdateSubtreeHidden 自上而下更新评论所有回复的隐藏状态，hiddenBy 为该评论的回复应被哪条评论隐藏，为空表示不隐藏
+// 已拒绝的回复继续隐藏其下的回复；隐藏状态未变化的回复，其下的回复也不会变化，不再向下处理
+// 返回因此变为可见（正数）或不可见（负数）的已通过且未删除的回复数量，用于调整文章评论数
+func (r *CommentRepository) UpdateSubtreeHidden(ctx context.Context, id uint, hiddenBy *uint) (int, error) {
+	children := func(parentIDs []uint) ([]schema.Comment, error) {
+		var comments []schema.Comment
+		err := GetCommentDB(ctx, r.DB).
+			Select("id", "parent_id", "status", "deleted_at", "hidden_by").
+			Where("parent_id IN ?", parentIDs).
+			Find(&comments).Error
+		return comments, errors.WithStack(err)
+	}
+	setHidden := func(id uint, hiddenBy *uint) error {
+		return errors.WithStack(GetCommentDB(ctx, r.DB).Where("id = ?", id).UpdateColumn("hidden_by", hiddenBy).Error)
+	}
+	return updateSubtreeHidden(id, hiddenBy, children, setHidden)
+}
+
+// updateSubtreeHidden UpdateSubtreeHidden 的遍历逻辑，children 按父评论ID批量获取回复，setHidden 保存回复的隐藏状态
+// 回复改由另一条评论隐藏（如祖先评论先后被拒绝）时可见性不变，不计入评论数变化
+func updateSubtreeHidden(id uint, hiddenBy *uint, children func(parentIDs []uint) ([]schema.Comment, error), setHidden func(id uint, hiddenBy *uint) error) (int, error) {
+	delta := 0
+	parents := map[uint]*uint{id: hiddenBy}
+	for len(parents) > 0 {
+		ids := make([]uint, 0, len(parents))
+		for parentID := range parents {
+			ids = append(ids, parentID)
+		}
+
+		replies, err := children(ids)
+		if err != nil {
+			return 0, err
+		}
+
+		next := make(map[uint]*uint)
+		for _, child := range replies {
+			target := parents[*child.ParentID]
+			if sameID(child.HiddenBy, target) {
+				continue
+			}
+			if err := setHidden(child.ID, target); err != nil {
+				return 0, err
+			}
+
+			if (child.HiddenBy == nil) != (target == nil) && child.Status == schema.CommentStatusApproved && !child.IsDeleted() {
+				if target == nil {
+					delta++
+				} else {
+					delta--
+				}
+			}
+
+			childHiddenBy := target
+			if childHiddenBy == nil && child.Status == schema.CommentStatusRejected {
+				childID := child.ID
+				childHiddenBy = &childID
+			}
+			next[child.ID] = childHiddenBy
+		}
+		parents = next
+	}
+	return delta, nil
+}
+
+// sameID 判断两个可为空的ID是否相同
+func sameID(a, b *uint) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// SetPinned 设置评论的置顶时间，pinnedAt 为空表示取消置顶
+func (r *CommentRepository) SetPinned(ctx context.Context, id uint, pinnedAt *time.Time) error {
+	result := GetCommentDB(ctx, r.DB).Where("id = ?", id).UpdateColumn("pinned_at", pinnedAt)
+	return errors.WithStack(result.Error)
+}
+
+// CountPinned 计算文章已置顶的评论数量
+func (r *CommentRepository) CountPinned(ctx context.Context, articleID uint) (int64, error) {
+	var count int64
+	err := GetCommentDB(ctx, r.DB).Where("article_id = ? AND pinned_at IS NOT NULL", articleID).Count(&count).Error
+	return count, errors.WithStack(err)
+}
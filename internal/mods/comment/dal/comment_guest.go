@@ -0,0 +1,115 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// GetCommentGuestTokenDB 获取访客令牌数据库实例
+func GetCommentGuestTokenDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.CommentGuestToken{})
+}
+
+// CommentGuestTokenRepository 访客令牌数据访问层
+type CommentGuestTokenRepository struct {
+	DB *gorm.DB
+}
+
+// Create 创建令牌
+func (r *CommentGuestTokenRepository) Create(ctx context.Context, token *schema.CommentGuestToken) error {
+	result := GetCommentGuestTokenDB(ctx, r.DB).Create(token)
+	return errors.WithStack(result.Error)
+}
+
+// GetValid 获取指定用途且未过期的令牌
+func (r *CommentGuestTokenRepository) GetValid(ctx context.Context, token, purpose string, now time.Time) (*schema.CommentGuestToken, error) {
+	var guestToken schema.CommentGuestToken
+	err := GetCommentGuestTokenDB(ctx, r.DB).
+		Where("token = ? AND purpose = ? AND expires_at > ?", token, purpose, now).
+		First(&guestToken).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("链接无效或已过期")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &guestToken, nil
+}
+
+// Delete 删除令牌
+func (r *CommentGuestTokenRepository) Delete(ctx context.Context, id uint) error {
+	result := GetCommentGuestTokenDB(ctx, r.DB).Where("id = ?", id).Delete(&schema.CommentGuestToken{})
+	return errors.WithStack(result.Error)
+}
+
+// DeleteExpired 删除已过期的令牌，返回其中验证令牌对应的评论ID
+func (r *CommentGuestTokenRepository) DeleteExpired(ctx context.Context, now time.Time) ([]uint, error) {
+	var commentIDs []uint
+	err := GetCommentGuestTokenDB(ctx, r.DB).
+		Where("purpose = ? AND expires_at <= ?", schema.GuestTokenPurposeVerify, now).
+		Pluck("comment_id", &commentIDs).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := GetCommentGuestTokenDB(ctx, r.DB).Where("expires_at <= ?", now).Delete(&schema.CommentGuestToken{})
+	if result.Error != nil {
+		return nil, errors.WithStack(result.Error)
+	}
+	return commentIDs, nil
+}
+
+// guestCommentsByEmail 指定邮箱已验证且尚未关联账号的访客评论
+func guestCommentsByEmail(db *gorm.DB, email string) *gorm.DB {
+	return db.Where("type = ? AND author_id = 0 AND guest_email = ? AND status <> ?",
+		schema.CommentTypeGuest, email, schema.CommentStatusUnverified)
+}
+
+// UpdateVerified 保存访客评论验证邮箱后的审核状态与垃圾评论评分，评论已验证时返回错误
+func (r *CommentRepository) UpdateVerified(ctx context.Context, comment *schema.Comment) error {
+	result := GetCommentDB(ctx, r.DB).
+		Where("id = ? AND status = ?", comment.ID, schema.CommentStatusUnverified).
+		Select("status", "reviewed_at", "reviewer_id", "review_remark", "spam_score", "spam_signals").
+		Updates(comment)
+	if result.Error != nil {
+		return errors.WithStack(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.BadRequest("评论邮箱已验证")
+	}
+	return nil
+}
+
+// DeleteUnverified 删除仍未验证邮箱的访客评论，未验证的评论不能被回复，可直接删除
+func (r *CommentRepository) DeleteUnverified(ctx context.Context, ids []uint) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := GetCommentDB(ctx, r.DB).
+		Where("id IN ? AND status = ?", ids, schema.CommentStatusUnverified).
+		Delete(&schema.Comment{})
+	return errors.WithStack(result.Error)
+}
+
+// CountGuestByEmail 统计指定邮箱已验证且尚未关联账号的访客评论数
+func (r *CommentRepository) CountGuestByEmail(ctx context.Context, email string) (int64, error) {
+	var count int64
+	err := guestCommentsByEmail(GetCommentDB(ctx, r.DB), email).Count(&count).Error
+	return count, errors.WithStack(err)
+}
+
+// LinkGuestComments 将指定邮箱已验证的访客评论关联到用户账号，关联后按站内评论展示
+func (r *CommentRepository) LinkGuestComments(ctx context.Context, email string, userID uint) (int64, error) {
+	result := guestCommentsByEmail(GetCommentDB(ctx, r.DB), email).UpdateColumns(map[string]interface{}{
+		"author_id":     userID,
+		"type":          schema.CommentTypeComment,
+		"source_author": "",
+	})
+	return result.RowsAffected, errors.WithStack(result.Error)
+}
@@ -0,0 +1,98 @@
+package dal
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	userSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/schema"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// GetCommentModeratorDB 获取评论协管员数据库实例
+func GetCommentModeratorDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.CommentModerator{})
+}
+
+// CommentModeratorRepository 评论协管员数据访问层
+type CommentModeratorRepository struct {
+	DB *gorm.DB
+}
+
+// Create 添加协管员，已存在时忽略
+func (r *CommentModeratorRepository) Create(ctx context.Context, moderator *schema.CommentModerator) error {
+	result := GetCommentModeratorDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(moderator)
+	return errors.WithStack(result.Error)
+}
+
+// Delete 移除协管员
+func (r *CommentModeratorRepository) Delete(ctx context.Context, authorID, moderatorID uint) error {
+	result := GetCommentModeratorDB(ctx, r.DB).
+		Where("author_id = ? AND moderator_id = ?", authorID, moderatorID).
+		Delete(&schema.CommentModerator{})
+	if result.Error != nil {
+		return errors.WithStack(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("协管员不存在")
+	}
+	return nil
+}
+
+// Exists 判断用户是否为作者的协管员
+func (r *CommentModeratorRepository) Exists(ctx context.Context, authorID, moderatorID uint) (bool, error) {
+	var count int64
+	err := GetCommentModeratorDB(ctx, r.DB).
+		Where("author_id = ? AND moderator_id = ?", authorID, moderatorID).
+		Count(&count).Error
+	return count > 0, errors.WithStack(err)
+}
+
+// ListByAuthor 获取作者的协管员及其用户信息，按指定时间排序
+func (r *CommentModeratorRepository) ListByAuthor(ctx context.Context, authorID uint) ([]schema.CommentModeratorResponse, error) {
+	var moderators []schema.CommentModerator
+	err := GetCommentModeratorDB(ctx, r.DB).Where("author_id = ?", authorID).Order("id ASC").Find(&moderators).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]schema.CommentModeratorResponse, 0, len(moderators))
+	if len(moderators) == 0 {
+		return items, nil
+	}
+
+	ids := make([]uint, 0, len(moderators))
+	for _, moderator := range moderators {
+		ids = append(ids, moderator.ModeratorID)
+	}
+	var users []userSchema.User
+	if err := userDal.GetUserDB(ctx, r.DB).Select("id", "username", "avatar").Where("id IN ?", ids).Find(&users).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	userMap := make(map[uint]userSchema.User, len(users))
+	for _, user := range users {
+		userMap[user.ID] = user
+	}
+
+	for _, moderator := range moderators {
+		user := userMap[moderator.ModeratorID]
+		items = append(items, schema.CommentModeratorResponse{
+			UserID:    moderator.ModeratorID,
+			Username:  user.Username,
+			Avatar:    user.Avatar,
+			CreatedAt: moderator.CreatedAt,
+		})
+	}
+	return items, nil
+}
+
+// ListAuthorIDsByModerator 获取指定用户担任协管员的作者ID
+func (r *CommentModeratorRepository) ListAuthorIDsByModerator(ctx context.Context, moderatorID uint) ([]uint, error) {
+	var authorIDs []uint
+	err := GetCommentModeratorDB(ctx, r.DB).Where("moderator_id = ?", moderatorID).Pluck("author_id", &authorIDs).Error
+	return authorIDs, errors.WithStack(err)
+}
@@ -0,0 +1,76 @@
+package dal
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetCommentReactionDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.CommentReaction{})
+}
+
+// CommentReactionRepository 评论表态数据访问层
+type CommentReactionRepository struct {
+	DB *gorm.DB
+}
+
+// Create 添加表态，已存在时不做任何操作，返回是否新增
+func (r *CommentReactionRepository) Create(ctx context.Context, reaction *schema.CommentReaction) (bool, error) {
+	result := GetCommentReactionDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(reaction)
+	if result.Error != nil {
+		return false, errors.WithStack(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Delete 取消表态，返回是否删除
+func (r *CommentReactionRepository) Delete(ctx context.Context, commentID, userID uint, reactionType string) (bool, error) {
+	result := GetCommentReactionDB(ctx, r.DB).
+		Where("comment_id = ? AND user_id = ? AND type = ?", commentID, userID, reactionType).
+		Delete(&schema.CommentReaction{})
+	if result.Error != nil {
+		return false, errors.WithStack(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// Exists 检查用户是否对评论做出了指定表态
+func (r *CommentReactionRepository) Exists(ctx context.Context, commentID, userID uint, reactionType string) (bool, error) {
+	var count int64
+	err := GetCommentReactionDB(ctx, r.DB).
+		Where("comment_id = ? AND user_id = ? AND type = ?", commentID, userID, reactionType).
+		Count(&count).Error
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	return count > 0, nil
+}
+
+// GetUserReactions 批量获取用户对多条评论的表态，键为评论ID
+func (r *CommentReactionRepository) GetUserReactions(ctx context.Context, userID uint, commentIDs []uint) (map[uint][]string, error) {
+	result := make(map[uint][]string)
+	if userID == 0 || len(commentIDs) == 0 {
+		return result, nil
+	}
+
+	var reactions []schema.CommentReaction
+	err := GetCommentReactionDB(ctx, r.DB).
+		Select("comment_id, type").
+		Where("user_id = ? AND comment_id IN ?", userID, commentIDs).
+		Order("id ASC").
+		Find(&reactions).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, reaction := range reactions {
+		result[reaction.CommentID] = append(result[reaction.CommentID], reaction.Type)
+	}
+	return result, nil
+}
@@ -0,0 +1,35 @@
+package dal
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetCommentRevisionDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.CommentRevision{})
+}
+
+// CommentRevisionRepository 评论历史版本数据访问层
+type CommentRevisionRepository struct {
+	DB *gorm.DB
+}
+
+// Create 记录评论历史版本
+func (r *CommentRevisionRepository) Create(ctx context.Context, revision *schema.CommentRevision) error {
+	result := GetCommentRevisionDB(ctx, r.DB).Create(revision)
+	return errors.WithStack(result.Error)
+}
+
+// ListByCommentID 获取评论的历史版本（按编辑时间倒序）
+func (r *CommentRevisionRepository) ListByCommentID(ctx context.Context, commentID uint) ([]schema.CommentRevision, error) {
+	var revisions []schema.CommentRevision
+	if err := GetCommentRevisionDB(ctx, r.DB).Where("comment_id = ?", commentID).Order("id DESC").Find(&revisions).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return revisions, nil
+}
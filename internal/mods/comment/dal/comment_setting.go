@@ -0,0 +1,50 @@
+package dal
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// GetCommentSettingDB 获取文章评论设置数据库实例
+func GetCommentSettingDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.CommentSetting{})
+}
+
+// CommentSettingRepository 文章评论设置数据访问层
+type CommentSettingRepository struct {
+	DB *gorm.DB
+}
+
+// Get 获取文章的评论设置
+func (r *CommentSettingRepository) Get(ctx context.Context, articleID uint) (*schema.CommentSetting, error) {
+	var setting schema.CommentSetting
+	err := GetCommentSettingDB(ctx, r.DB).Where("article_id = ?", articleID).First(&setting).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("文章评论设置不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &setting, nil
+}
+
+// Save 保存文章的评论设置
+func (r *CommentSettingRepository) Save(ctx context.Context, setting *schema.CommentSetting) error {
+	result := GetCommentSettingDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "article_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"status", "auto_close_days", "moderation", "updated_at"}),
+	}).Create(setting)
+	return errors.WithStack(result.Error)
+}
+
+// Delete 删除文章的评论设置，恢复使用站点默认设置
+func (r *CommentSettingRepository) Delete(ctx context.Context, articleID uint) error {
+	result := GetCommentSettingDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.CommentSetting{})
+	return errors.WithStack(result.Error)
+}
@@ -0,0 +1,133 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/spam"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// maxSpamTokenLen 特征的最大字节数，超出的特征不参与统计
+const maxSpamTokenLen = 100
+
+func GetCommentSpamTokenDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.CommentSpamToken{})
+}
+
+func GetCommentSpamCorpusDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.CommentSpamCorpus{})
+}
+
+// CommentSpamRepository 垃圾评论分类器数据访问层
+type CommentSpamRepository struct {
+	DB *gorm.DB
+}
+
+// GetCorpus 获取垃圾与正常样本数
+func (r *CommentSpamRepository) GetCorpus(ctx context.Context) (spamDocs, hamDocs int64, err error) {
+	var corpus []schema.CommentSpamCorpus
+	if err := GetCommentSpamCorpusDB(ctx, r.DB).Find(&corpus).Error; err != nil {
+		return 0, 0, errors.WithStack(err)
+	}
+	for _, c := range corpus {
+		switch c.Label {
+		case schema.SpamLabelSpam:
+			spamDocs = c.Documents
+		case schema.SpamLabelHam:
+			hamDocs = c.Documents
+		}
+	}
+	return spamDocs, hamDocs, nil
+}
+
+// GetTokenCounts 批量获取特征的样本统计
+func (r *CommentSpamRepository) GetTokenCounts(ctx context.Context, tokens []string) (map[string]spam.TokenCount, error) {
+	result := make(map[string]spam.TokenCount)
+	tokens = validTokens(tokens)
+	if len(tokens) == 0 {
+		return result, nil
+	}
+
+	var rows []schema.CommentSpamToken
+	if err := GetCommentSpamTokenDB(ctx, r.DB).Where("token IN ?", tokens).Find(&rows).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, row := range rows {
+		result[row.Token] = spam.TokenCount{Spam: row.SpamCount, Ham: row.HamCount}
+	}
+	return result, nil
+}
+
+// Train 将一条样本计入（delta 为 1）或移出（delta 为 -1）指定标签的统计
+func (r *CommentSpamRepository) Train(ctx context.Context, tokens []string, label string, delta int) error {
+	column := "ham_count"
+	if label == schema.SpamLabelSpam {
+		column = "spam_count"
+	}
+	tokens = validTokens(tokens)
+	now := time.Now()
+
+	if delta > 0 {
+		if len(tokens) > 0 {
+			rows := make([]schema.CommentSpamToken, 0, len(tokens))
+			for _, token := range tokens {
+				row := schema.CommentSpamToken{Token: token}
+				if label == schema.SpamLabelSpam {
+					row.SpamCount = 1
+				} else {
+					row.HamCount = 1
+				}
+				rows = append(rows, row)
+			}
+			result := GetCommentSpamTokenDB(ctx, r.DB).Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "token"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					column:       gorm.Expr(column + " + 1"),
+					"updated_at": now,
+				}),
+			}).Create(&rows)
+			if result.Error != nil {
+				return errors.WithStack(result.Error)
+			}
+		}
+
+		result := GetCommentSpamCorpusDB(ctx, r.DB).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "label"}},
+			DoUpdates: clause.Assignments(map[string]interface{}{
+				"documents":  gorm.Expr("documents + 1"),
+				"updated_at": now,
+			}),
+		}).Create(&schema.CommentSpamCorpus{Label: label, Documents: 1})
+		return errors.WithStack(result.Error)
+	}
+
+	if len(tokens) > 0 {
+		result := GetCommentSpamTokenDB(ctx, r.DB).
+			Where("token IN ? AND "+column+" > 0", tokens).
+			UpdateColumns(map[string]interface{}{column: gorm.Expr(column + " - 1"), "updated_at": now})
+		if result.Error != nil {
+			return errors.WithStack(result.Error)
+		}
+	}
+	result := GetCommentSpamCorpusDB(ctx, r.DB).
+		Where("label = ? AND documents > 0", label).
+		UpdateColumns(map[string]interface{}{"documents": gorm.Expr("documents - 1"), "updated_at": now})
+	return errors.WithStack(result.Error)
+}
+
+// validTokens 过滤超出长度的特征
+func validTokens(tokens []string) []string {
+	valid := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if len(token) <= maxSpamTokenLen {
+			valid = append(valid, token)
+		}
+	}
+	return valid
+}
@@ -0,0 +1,121 @@
+package dal
+
+import (
+	"testing"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+)
+
+// memoryThread 内存中的评论树，按评论服务的审核流程修改审核结论并维护文章评论数
+type memoryThread struct {
+	comments map[uint]*schema.Comment
+	count    int
+}
+
+func newMemoryThread() *memoryThread {
+	t := &memoryThread{comments: make(map[uint]*schema.Comment)}
+	// A ← B ← C ← D，均已通过
+	for id := uint(1); id <= 4; id++ {
+		comment := &schema.Comment{ID: id, Status: schema.CommentStatusApproved}
+		if id > 1 {
+			parentID := id - 1
+			comment.ParentID = &parentID
+		}
+		t.comments[id] = comment
+		t.count++
+	}
+	return t
+}
+
+func (t *memoryThread) children(parentIDs []uint) ([]schema.Comment, error) {
+	var result []schema.Comment
+	for _, comment := range t.comments {
+		for _, parentID := range parentIDs {
+			if comment.ParentID != nil && *comment.ParentID == parentID {
+				result = append(result, *comment)
+			}
+		}
+	}
+	return result, nil
+}
+
+func (t *memoryThread) setHidden(id uint, hiddenBy *uint) error {
+	t.comments[id].HiddenBy = hiddenBy
+	return nil
+}
+
+// review 与 CommentService.applyReview 相同的评论数调整
+func (t *memoryThread) review(tb testing.TB, id uint, status int) {
+	tb.Helper()
+	comment := t.comments[id]
+	visible := func(status int) bool {
+		return status == schema.CommentStatusApproved && comment.HiddenBy == nil
+	}
+	if visible(status) {
+		t.count++
+	}
+	if visible(comment.Status) {
+		t.count--
+	}
+
+	wasRejected := comment.Status == schema.CommentStatusRejected
+	isRejected := status == schema.CommentStatusRejected
+	if comment.HiddenBy == nil && wasRejected != isRejected {
+		var hiddenBy *uint
+		if isRejected {
+			hiddenBy = &comment.ID
+		}
+		delta, err := updateSubtreeHidden(id, hiddenBy, t.children, t.setHidden)
+		if err != nil {
+			tb.Fatalf("updateSubtreeHidden() error = %v", err)
+		}
+		t.count += delta
+	}
+	comment.Status = status
+}
+
+// visible 实际可见的评论数
+func (t *memoryThread) visible() int {
+	n := 0
+	for _, comment := range t.comments {
+		if comment.Status == schema.CommentStatusApproved && comment.HiddenBy == nil {
+			n++
+		}
+	}
+	return n
+}
+
+func TestUpdateSubtreeHiddenNestedRejection(t *testing.T) {
+	const a, b, c = 1, 2, 3
+	thread := newMemoryThread()
+
+	steps := []struct {
+		name   string
+		id     uint
+		status int
+		want   int
+	}{
+		{"拒绝 B，隐藏 C、D", b, schema.CommentStatusRejected, 1},
+		{"拒绝 A，C、D 改由 A 隐藏", a, schema.CommentStatusRejected, 0},
+		{"通过 A，C、D 重新由 B 隐藏", a, schema.CommentStatusApproved, 1},
+		{"再次拒绝 A", a, schema.CommentStatusRejected, 0},
+		{"再次通过 A", a, schema.CommentStatusApproved, 1},
+		{"通过 B，C、D 恢复可见", b, schema.CommentStatusApproved, 4},
+		{"拒绝 C，隐藏 D", c, schema.CommentStatusRejected, 2},
+		{"拒绝 A，D 改由 A 隐藏", a, schema.CommentStatusRejected, 0},
+		{"通过 A", a, schema.CommentStatusApproved, 2},
+	}
+	for _, step := range steps {
+		thread.review(t, step.id, step.status)
+		if thread.count != step.want || thread.visible() != step.want {
+			t.Fatalf("%s: comment count = %d, visible = %d, want %d", step.name, thread.count, thread.visible(), step.want)
+		}
+	}
+
+	if hiddenBy := thread.comments[c].HiddenBy; hiddenBy != nil {
+		t.Errorf("C hidden by %d, want visible", *hiddenBy)
+	}
+	if hiddenBy := thread.comments[4].HiddenBy; hiddenBy == nil || *hiddenBy != c {
+		t.Errorf("D hidden by %v, want C", hiddenBy)
+	}
+}
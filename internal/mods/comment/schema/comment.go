@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	mentionSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
 )
 
 // Comment 评论模型
@@ -15,18 +16,60 @@ type Comment struct {
 	ParentID     *uint      `json:"parent_id" gorm:"index;comment:父评论ID"`
 	RootID       *uint      `json:"root_id" gorm:"index;comment:根评论ID,用于快速查询评论树"`
 	Level        int        `json:"level" gorm:"type:tinyint;default:1;comment:评论层级,1为顶级评论"`
-	Status       int        `json:"status" gorm:"type:tinyint;default:0;comment:审核状态,0-待审核,1-已通过,2-已拒绝"`
+	Status       int        `json:"status" gorm:"type:tinyint;default:0;comment:审核状态,0-待审核,1-已通过,2-已拒绝,3-访客邮箱待验证"`
 	ReviewedAt   *time.Time `json:"reviewed_at" gorm:"index;comment:审核时间"`
 	ReviewerID   *uint      `json:"reviewer_id" gorm:"comment:审核员ID"`
 	ReviewRemark string     `json:"review_remark" gorm:"type:varchar(255);comment:审核备注"`
-	CreatedAt    time.Time  `json:"created_at" gorm:"index;comment:创建时间"`
+	HiddenBy     *uint      `json:"hidden_by" gorm:"index;comment:因祖先评论被拒绝而隐藏时为该祖先评论的ID,为空表示未被隐藏"`
+	PinnedAt     *time.Time `json:"pinned_at" gorm:"index;comment:置顶时间,为空表示未置顶"`
+	Type         string     `json:"type" gorm:"size:20;not null;default:comment;index;comment:评论类型,comment-站内评论,guest-访客评论,webmention-外部引用,activitypub-联邦回复"`
+	SourceURL    string     `json:"source_url" gorm:"size:500;index;comment:外部来源链接"`
+	SourceAuthor string     `json:"source_author" gorm:"size:100;comment:外部来源作者"`
+	GuestEmail   string     `json:"-" gorm:"size:100;index;comment:访客邮箱,关联账号后保留"`
+
+	// 表态数量（冗余存储，随表态的增删原子更新）
+	LikeCount     int `json:"like_count" gorm:"not null;default:0;comment:点赞数"`
+	HeartCount    int `json:"heart_count" gorm:"not null;default:0;comment:爱心表态数"`
+	LaughCount    int `json:"laugh_count" gorm:"not null;default:0;comment:大笑表态数"`
+	HoorayCount   int `json:"hooray_count" gorm:"not null;default:0;comment:庆祝表态数"`
+	ConfusedCount int `json:"confused_count" gorm:"not null;default:0;comment:疑惑表态数"`
+	ReactionCount int `json:"reaction_count" gorm:"not null;default:0;index;comment:表态总数,用于热门排序"`
+
+	EditedAt  *time.Time `json:"edited_at" gorm:"comment:最后编辑时间"`
+	EditCount int        `json:"edit_count" gorm:"not null;default:0;comment:编辑次数"`
+	DeletedAt *time.Time `json:"deleted_at" gorm:"index;comment:删除时间,不为空表示评论已删除但因仍有回复而保留为墓碑"`
+
+	// 垃圾评论评分
+	SpamScore   *int   `json:"spam_score" gorm:"index;comment:垃圾评论评分(0-100),为空表示未评分"`
+	SpamSignals string `json:"-" gorm:"type:text;comment:评分依据(JSON)"`
+	SpamLabel   string `json:"-" gorm:"size:10;comment:已用于训练分类器的审核结论,spam-垃圾,ham-正常"`
+
+	// AI 审核建议
+	AIVerdict       string     `json:"-" gorm:"type:text;comment:AI 审核建议(JSON)"`
+	AIModeratedAt   *time.Time `json:"-" gorm:"index;comment:AI 审核完成时间,为空表示尚未完成"`
+	AIAttempts      int        `json:"-" gorm:"not null;default:0;comment:AI 审核失败次数"`
+	AINextAttemptAt *time.Time `json:"-" gorm:"comment:AI 审核下次尝试时间"`
+
+	CreatedAt time.Time `json:"created_at" gorm:"index;comment:创建时间"`
 }
 
 // 评论状态常量
 const (
-	CommentStatusPending  = 0 // 待审核
-	CommentStatusApproved = 1 // 已通过
-	CommentStatusRejected = 2 // 已拒绝
+	CommentStatusPending    = 0 // 待审核
+	CommentStatusApproved   = 1 // 已通过
+	CommentStatusRejected   = 2 // 已拒绝
+	CommentStatusUnverified = 3 // 访客邮箱待验证，验证后进入审核
+)
+
+// CommentTombstoneContent 已删除但仍有回复的评论（墓碑）展示的内容
+const CommentTombstoneContent = "该评论已删除"
+
+// 评论类型常量
+const (
+	CommentTypeComment     = "comment"     // 站内评论
+	CommentTypeGuest       = "guest"       // 未登录访客的评论，以访客名称作为来源作者
+	CommentTypeWebmention  = "webmention"  // 外部站点通过 Webmention 发来的引用
+	CommentTypeActivityPub = "activitypub" // 联邦网络（ActivityPub）中的远程回复
 )
 
 // TableName 表名
@@ -34,28 +77,54 @@ func (a *Comment) TableName() string {
 	return config.C.FormatTableName("comment")
 }
 
+// IsDeleted 评论是否已删除（墓碑）
+func (a *Comment) IsDeleted() bool {
+	return a.DeletedAt != nil
+}
+
+// IsOffSite 评论是否没有站内作者，即访客评论、Webmention 引用与联邦网络中的远程回复
+// 这类评论缺少账号年龄、发言频率等评分依据，不能仅凭垃圾评论评分自动通过
+func (a *Comment) IsOffSite() bool {
+	return a.AuthorID == 0 || a.Type != CommentTypeComment
+}
+
 // CommentResponse 评论响应结构
 type CommentResponse struct {
-	ID             uint       `json:"id"`
-	Content        string     `json:"content"`
-	AuthorID       uint       `json:"author_id"`
-	Author         string     `json:"author,omitempty"` // 作者名称
-	Avatar         string     `json:"avatar,omitempty"` // 作者头像
-	ArticleID      uint       `json:"article_id"`
-	ArticleTitle   string     `json:"article_title,omitempty"` // 文章标题
-	ParentID       *uint      `json:"parent_id"`
-	RootID         *uint      `json:"root_id,omitempty"`         // 根评论ID
-	Level          int        `json:"level"`                     // 评论层级
-	ParentContent  string     `json:"parent_content,omitempty"`  // 父评论内容
-	ParentAuthor   string     `json:"parent_author,omitempty"`   // 父评论作者
-	Status         int        `json:"status"`                    // 审核状态
-	ReviewedAt     *time.Time `json:"reviewed_at,omitempty"`     // 审核时间
-	ReviewerID     *uint      `json:"reviewer_id,omitempty"`     // 审核员ID
-	ReviewerName   string     `json:"reviewer_name,omitempty"`   // 审核员名称
-	ReviewerAvatar string     `json:"reviewer_avatar,omitempty"` // 审核员头像
-	ReviewRemark   string     `json:"review_remark,omitempty"`   // 审核备注
-	ReplyCount     int64      `json:"reply_count"`               // 回复数量
-	CreatedAt      time.Time  `json:"created_at"`
+	ID             uint                          `json:"id"`
+	Content        string                        `json:"content"`
+	Type           string                        `json:"type"`                    // 评论类型
+	SourceURL      string                        `json:"source_url,omitempty"`    // 外部来源链接
+	SourceAuthor   string                        `json:"source_author,omitempty"` // 外部来源作者
+	AuthorID       uint                          `json:"author_id"`
+	Author         string                        `json:"author,omitempty"` // 作者名称
+	Avatar         string                        `json:"avatar,omitempty"` // 作者头像
+	ArticleID      uint                          `json:"article_id"`
+	ArticleTitle   string                        `json:"article_title,omitempty"` // 文章标题
+	ParentID       *uint                         `json:"parent_id"`
+	RootID         *uint                         `json:"root_id,omitempty"`         // 根评论ID
+	Level          int                           `json:"level"`                     // 评论层级
+	ParentContent  string                        `json:"parent_content,omitempty"`  // 父评论内容
+	ParentAuthor   string                        `json:"parent_author,omitempty"`   // 父评论作者
+	Status         int                           `json:"status"`                    // 审核状态
+	ReviewedAt     *time.Time                    `json:"reviewed_at,omitempty"`     // 审核时间
+	ReviewerID     *uint                         `json:"reviewer_id,omitempty"`     // 审核员ID
+	ReviewerName   string                        `json:"reviewer_name,omitempty"`   // 审核员名称
+	ReviewerAvatar string                        `json:"reviewer_avatar,omitempty"` // 审核员头像
+	ReviewRemark   string                        `json:"review_remark,omitempty"`   // 审核备注
+	ReplyCount     int64                         `json:"reply_count"`               // 回复数量
+	Reactions      map[string]int                `json:"reactions"`                 // 各类表态的数量
+	ReactionCount  int                           `json:"reaction_count"`            // 表态总数
+	MyReactions    []string                      `json:"my_reactions,omitempty"`    // 当前用户的表态
+	Edited         bool                          `json:"edited"`                    // 是否编辑过
+	EditedAt       *time.Time                    `json:"edited_at,omitempty"`       // 最后编辑时间
+	Deleted        bool                          `json:"deleted"`                   // 是否已删除（墓碑）
+	Hidden         bool                          `json:"hidden,omitempty"`          // 是否因祖先评论被拒绝而隐藏
+	Pinned         bool                          `json:"pinned"`                    // 是否置顶
+	Mentions       []mentionSchema.MentionedUser `json:"mentions,omitempty"`        // 评论中被提及的用户
+	SpamScore      *int                          `json:"spam_score,omitempty"`      // 垃圾评论评分（仅审核列表返回）
+	SpamSignals    []SpamSignal                  `json:"spam_signals,omitempty"`    // 评分依据（仅审核列表返回）
+	AIVerdict      *AIVerdict                    `json:"ai_verdict,omitempty"`      // AI 审核建议（仅审核列表返回）
+	CreatedAt      time.Time                     `json:"created_at"`
 }
 
 // CreateCommentRequest 创建评论请求
@@ -63,6 +132,16 @@ type CreateCommentRequest struct {
 	Content   string `json:"content" binding:"required"`
 	ArticleID uint   `json:"article_id" binding:"required"`
 	ParentID  *uint  `json:"parent_id"`
+
+	// 以下字段仅供内部创建外部来源的评论使用，不接受客户端传入
+	Type         string `json:"-"` // 评论类型，为空时为站内评论
+	SourceURL    string `json:"-"` // 外部来源链接
+	SourceAuthor string `json:"-"` // 外部来源作者
+}
+
+// UpdateCommentRequest 编辑评论请求
+type UpdateCommentRequest struct {
+	Content string `json:"content" binding:"required"`
 }
 
 // ReviewCommentRequest 评论审核请求
@@ -70,18 +149,48 @@ type ReviewCommentRequest struct {
 	CommentID    uint   `json:"comment_id" binding:"required"`       // 评论ID
 	Status       int    `json:"status" binding:"required,oneof=1 2"` // 审核状态：1-通过，2-拒绝
 	ReviewRemark string `json:"review_remark"`                       // 审核备注
+	Rereview     bool   `json:"rereview"`                            // 是否修改已审核评论的审核结论，否则只能审核待审核评论
+}
+
+// BatchReviewCommentRequest 批量审核评论请求，按评论ID或按筛选条件选择评论，二者只能指定其一
+type BatchReviewCommentRequest struct {
+	CommentIDs   []uint               `json:"comment_ids" binding:"omitempty,dive,min=1"` // 评论ID列表
+	Filter       *CommentReviewFilter `json:"filter"`                                     // 筛选条件，按评论ID升序最多选择批量审核上限条评论
+	Status       int                  `json:"status" binding:"required,oneof=1 2"`        // 审核状态：1-通过，2-拒绝
+	ReviewRemark string               `json:"review_remark"`                              // 审核备注
+	Rereview     bool                 `json:"rereview"`                                   // 是否同时修改已审核评论的审核结论，否则只审核待审核评论
+}
+
+// BatchReviewCommentResponse 批量审核评论响应
+type BatchReviewCommentResponse struct {
+	CommentIDs []uint `json:"comment_ids"` // 审核状态发生变化的评论ID
+	Reviewed   int    `json:"reviewed"`    // 审核状态发生变化的评论数量
 }
 
 // CommentReviewListRequest 评论审核列表查询请求
 type CommentReviewListRequest struct {
+	CommentReviewFilter
+
+	// 排序选项
+	SortBy    string `json:"sort_by" form:"sort_by" binding:"omitempty,oneof=create review spam"` // 排序字段：create-创建时间，review-审核时间，spam-垃圾评论评分
+	SortOrder string `json:"sort_order" form:"sort_order" binding:"omitempty,oneof=desc asc"`     // 排序方式：desc-降序，asc-升序
+
+	// 分页选项
+	Page     int `json:"page" form:"page" binding:"required,min=1"`                   // 页码
+	PageSize int `json:"page_size" form:"page_size" binding:"required,min=1,max=100"` // 页大小
+}
+
+// CommentReviewFilter 评论审核列表的筛选条件，也用于批量审核时选择评论
+type CommentReviewFilter struct {
 	// 基本筛选
-	ArticleID *uint  `json:"article_id" form:"article_id"`                         // 文章ID
-	AuthorID  *uint  `json:"author_id" form:"author_id"`                           // 评论作者ID
-	ParentID  *uint  `json:"parent_id" form:"parent_id"`                           // 父评论ID
-	RootID    *uint  `json:"root_id" form:"root_id"`                               // 根评论ID
-	Level     *int   `json:"level" form:"level"`                                   // 评论层级
-	Keyword   string `json:"keyword" form:"keyword"`                               // 关键词搜索
-	Status    *int   `json:"status" form:"status" binding:"omitempty,oneof=0 1 2"` // 评论状态：0-待审核，1-已通过，2-已拒绝，不传则查询所有状态
+	ArticleID *uint  `json:"article_id" form:"article_id"`                                                    // 文章ID
+	AuthorID  *uint  `json:"author_id" form:"author_id"`                                                      // 评论作者ID
+	ParentID  *uint  `json:"parent_id" form:"parent_id"`                                                      // 父评论ID
+	RootID    *uint  `json:"root_id" form:"root_id"`                                                          // 根评论ID
+	Level     *int   `json:"level" form:"level"`                                                              // 评论层级
+	Keyword   string `json:"keyword" form:"keyword"`                                                          // 关键词搜索
+	Type      string `json:"type" form:"type" binding:"omitempty,oneof=comment guest webmention activitypub"` // 评论类型：comment-站内评论，guest-访客评论，webmention-外部引用，activitypub-联邦回复，不传则查询所有类型
+	Status    *int   `json:"status" form:"status" binding:"omitempty,oneof=0 1 2 3"`                          // 评论状态：0-待审核，1-已通过，2-已拒绝，3-访客邮箱待验证，不传则查询所有状态
 
 	// 时间范围筛选
 	CreateStartTime *time.Time `json:"create_start_time" form:"create_start_time"` // 创建开始时间
@@ -92,13 +201,14 @@ type CommentReviewListRequest struct {
 	// 审核人员筛选
 	ReviewerID *uint `json:"reviewer_id" form:"reviewer_id"` // 审核人员ID
 
-	// 排序选项
-	SortBy    string `json:"sort_by" form:"sort_by" binding:"omitempty,oneof=create review"`  // 排序字段：create-创建时间，review-审核时间
-	SortOrder string `json:"sort_order" form:"sort_order" binding:"omitempty,oneof=desc asc"` // 排序方式：desc-降序，asc-升序
+	// 垃圾评论评分筛选
+	MinSpamScore *int `json:"min_spam_score" form:"min_spam_score" binding:"omitempty,min=0,max=100"` // 最低垃圾评论评分
 
-	// 分页选项
-	Page     int `json:"page" form:"page" binding:"required,min=1"`                   // 页码
-	PageSize int `json:"page_size" form:"page_size" binding:"required,min=1,max=100"` // 页大小
+	// 审核进度筛选
+	Unreviewed bool `json:"unreviewed" form:"unreviewed"` // 只查询尚未经审核的评论（待审核及先发后审的评论）
+
+	// 以下字段仅供内部限定审核范围使用，不接受客户端传入
+	ArticleAuthorIDs []uint `json:"-" form:"-"` // 只选择这些作者的文章下的评论
 }
 
 // CommentPaginationResult 评论分页结果
@@ -120,6 +230,7 @@ type PaginationRequest struct {
 type ArticleCommentsRequest struct {
 	PaginationRequest        // 嵌入分页请求基础结构
 	IncludePending    bool   `json:"-" form:"-"`                                                              // 是否包含待审核评论（仅管理员可用）
+	SortBy            string `json:"sort_by" form:"sort_by" binding:"omitempty,oneof=create top"`             // 排序字段：create-创建时间，top-表态总数（相同时按创建时间排序）
 	SortByCreate      string `json:"sort_by_create" form:"sort_by_create" binding:"omitempty,oneof=asc desc"` // 排序方式
 }
 
@@ -134,7 +245,32 @@ type CommentRepliesRequest struct {
 
 // CommentTreeRequest 评论树查询请求
 type CommentTreeRequest struct {
-	MaxDepth int `json:"max_depth" form:"max_depth" binding:"omitempty,min=0"` // 最大递归深度，0表示不限制
+	ArticleCommentsRequest     // 嵌入根评论的分页与排序选项
+	MaxDepth               int `json:"max_depth" form:"max_depth" binding:"omitempty,min=0,max=20"`        // 最大递归深度，未指定时为 5，最大为 20
+	MaxChildren            int `json:"max_children" form:"max_children" binding:"omitempty,min=1,max=100"` // 每条评论最多展示的直接回复数，默认为 10
+}
+
+// CommentTreeNode 评论树节点
+type CommentTreeNode struct {
+	CommentResponse
+	Children    []CommentTreeNode `json:"children"`     // 已展示的直接回复，按创建时间升序排列
+	MoreReplies int               `json:"more_replies"` // 因超出回复数上限或最大深度而未展示的直接回复数，大于 0 时可通过回复接口加载更多
+}
+
+// CommentReplyStat 评论的直接回复统计
+type CommentReplyStat struct {
+	ParentID uint  // 评论ID
+	Total    int   // 可见的直接回复数（管理员包含未审核的回复）
+	Approved int64 // 已通过、未删除且未被隐藏的直接回复数
+}
+
+// CommentTreeResult 评论树分页结果，按根评论分页
+type CommentTreeResult struct {
+	Items      []CommentTreeNode `json:"items"`
+	Total      int64             `json:"total"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"page_size"`
+	TotalPages int               `json:"total_pages"`
 }
 
 // UserCommentsRequest 用户评论查询请求
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 访客令牌用途常量
+const (
+	GuestTokenPurposeVerify = "verify" // 验证访客评论的邮箱
+	GuestTokenPurposeClaim  = "claim"  // 将访客评论认领到同邮箱注册的账号
+)
+
+// CommentGuestToken 访客评论的邮件链接令牌，只保存令牌的摘要，使用后即删除
+type CommentGuestToken struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	Token     string    `json:"-" gorm:"size:64;not null;uniqueIndex;comment:令牌的 SHA-256 摘要"`
+	Purpose   string    `json:"purpose" gorm:"size:10;not null;comment:用途,verify-验证邮箱,claim-认领评论"`
+	CommentID uint      `json:"comment_id" gorm:"not null;default:0;index;comment:待验证的评论ID"`
+	UserID    uint      `json:"user_id" gorm:"not null;default:0;comment:认领评论的用户ID"`
+	Email     string    `json:"email" gorm:"size:100;not null;comment:收件邮箱"`
+	ExpiresAt time.Time `json:"expires_at" gorm:"index;comment:过期时间"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *CommentGuestToken) TableName() string {
+	return config.C.FormatTableName("comment_guest_token")
+}
+
+// CreateGuestCommentRequest 访客评论请求
+type CreateGuestCommentRequest struct {
+	CreateCommentRequest
+	Name      string `json:"name" binding:"required,max=50"`         // 访客名称
+	Email     string `json:"email" binding:"required,email,max=100"` // 访客邮箱，用于接收验证链接，不公开展示
+	Captcha   string `json:"captcha" binding:"required"`             // 验证码
+	CaptchaID string `json:"captcha_id" binding:"required"`          // 验证码ID
+	Locale    string `json:"locale" binding:"omitempty,max=20"`      // 验证邮件语言，为空时使用默认语言
+}
+
+// GuestTokenRequest 使用邮件链接中的令牌验证邮箱或认领评论的请求
+type GuestTokenRequest struct {
+	Token string `json:"token" binding:"required"` // 邮件链接中的令牌
+}
+
+// SendGuestClaimRequest 发送访客评论认领邮件请求
+type SendGuestClaimRequest struct {
+	Locale string `json:"locale" form:"locale" binding:"omitempty,max=20"` // 邮件语言，为空时使用默认语言
+}
+
+// ClaimGuestCommentsResponse 认领访客评论响应
+type ClaimGuestCommentsResponse struct {
+	Claimed int64 `json:"claimed"` // 关联到当前账号的评论数量
+}
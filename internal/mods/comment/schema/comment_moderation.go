@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/pkg/json"
+)
+
+// AI 审核类别常量
+const (
+	AICategoryNormal    = "normal"    // 正常评论
+	AICategorySpam      = "spam"      // 广告或垃圾内容
+	AICategoryOffensive = "offensive" // 辱骂、歧视或人身攻击
+	AICategorySexual    = "sexual"    // 色情低俗内容
+	AICategoryIllegal   = "illegal"   // 违法违规内容
+	AICategoryOther     = "other"     // 无法确定，需要人工判断
+)
+
+// AICategories 所有 AI 审核类别
+var AICategories = []string{
+	AICategoryNormal,
+	AICategorySpam,
+	AICategoryOffensive,
+	AICategorySexual,
+	AICategoryIllegal,
+	AICategoryOther,
+}
+
+// AI 审核建议常量
+const (
+	AISuggestionApprove = "approve" // 建议通过
+	AISuggestionReject  = "reject"  // 建议拒绝
+	AISuggestionReview  = "review"  // 建议人工审核
+)
+
+// AIVerdict AI 审核建议
+type AIVerdict struct {
+	Category   string    `json:"category"`   // 类别
+	Confidence float64   `json:"confidence"` // 置信度（0-1）
+	Reason     string    `json:"reason"`     // 理由
+	Suggestion string    `json:"suggestion"` // 建议
+	Model      string    `json:"model"`      // 给出建议的模型
+	CreatedAt  time.Time `json:"created_at"` // 给出建议的时间
+}
+
+// Suggest 根据类别给出审核建议
+func (v *AIVerdict) Suggest() string {
+	switch v.Category {
+	case AICategoryNormal:
+		return AISuggestionApprove
+	case AICategorySpam, AICategoryOffensive, AICategorySexual, AICategoryIllegal:
+		return AISuggestionReject
+	default:
+		return AISuggestionReview
+	}
+}
+
+// AIVerdictOf 解析评论的 AI 审核建议
+func (a *Comment) AIVerdictOf() *AIVerdict {
+	if a.AIVerdict == "" {
+		return nil
+	}
+	var verdict AIVerdict
+	if err := json.Unmarshal([]byte(a.AIVerdict), &verdict); err != nil {
+		return nil
+	}
+	return &verdict
+}
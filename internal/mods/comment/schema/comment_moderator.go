@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// CommentModerator 作者指定的评论协管员，可与作者一同管理作者所有文章下的评论
+type CommentModerator struct {
+	ID          uint      `json:"id" gorm:"primaryKey"`
+	AuthorID    uint      `json:"author_id" gorm:"not null;uniqueIndex:idx_comment_moderator;comment:文章作者ID"`
+	ModeratorID uint      `json:"moderator_id" gorm:"not null;uniqueIndex:idx_comment_moderator;index;comment:协管员用户ID"`
+	CreatedAt   time.Time `json:"created_at" gorm:"comment:指定时间"`
+}
+
+// TableName 表名
+func (a *CommentModerator) TableName() string {
+	return config.C.FormatTableName("comment_moderator")
+}
+
+// AddCommentModeratorRequest 添加评论协管员请求
+type AddCommentModeratorRequest struct {
+	UserID uint `json:"user_id" binding:"required"` // 协管员用户ID
+}
+
+// CommentModeratorResponse 评论协管员响应结构
+type CommentModeratorResponse struct {
+	UserID    uint      `json:"user_id"`          // 协管员用户ID
+	Username  string    `json:"username"`         // 协管员用户名
+	Avatar    string    `json:"avatar,omitempty"` // 协管员头像
+	CreatedAt time.Time `json:"created_at"`       // 指定时间
+}
+
+// HideCommentRequest 隐藏评论请求
+type HideCommentRequest struct {
+	ReviewRemark string `json:"review_remark"` // 隐藏原因
+}
+
+// PinCommentRequest 置顶评论请求
+type PinCommentRequest struct {
+	Pinned bool `json:"pinned"` // true-置顶，false-取消置顶
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 评论表态类型常量
+const (
+	ReactionLike     = "like"     // 👍 点赞
+	ReactionHeart    = "heart"    // ❤️ 爱心
+	ReactionLaugh    = "laugh"    // 😄 大笑
+	ReactionHooray   = "hooray"   // 🎉 庆祝
+	ReactionConfused = "confused" // 😕 疑惑
+)
+
+// ReactionTypes 支持的表态类型，顺序即展示顺序
+var ReactionTypes = []string{ReactionLike, ReactionHeart, ReactionLaugh, ReactionHooray, ReactionConfused}
+
+// CommentReaction 用户对评论的表态，每个用户对同一评论的每种表态最多一条
+type CommentReaction struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	CommentID uint      `json:"comment_id" gorm:"not null;uniqueIndex:idx_comment_user_type;comment:评论ID"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_comment_user_type;index;comment:用户ID"`
+	Type      string    `json:"type" gorm:"size:20;not null;uniqueIndex:idx_comment_user_type;comment:表态类型"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *CommentReaction) TableName() string {
+	return config.C.FormatTableName("comment_reaction")
+}
+
+// ReactionColumn 表态类型在评论表中对应的计数字段
+func ReactionColumn(reactionType string) string {
+	return reactionType + "_count"
+}
+
+// Reactions 评论各类表态的数量
+func (a *Comment) Reactions() map[string]int {
+	return map[string]int{
+		ReactionLike:     a.LikeCount,
+		ReactionHeart:    a.HeartCount,
+		ReactionLaugh:    a.LaughCount,
+		ReactionHooray:   a.HoorayCount,
+		ReactionConfused: a.ConfusedCount,
+	}
+}
+
+// ReactCommentRequest 评论表态请求
+type ReactCommentRequest struct {
+	Type string `json:"type" binding:"required,oneof=like heart laugh hooray confused"` // 表态类型
+}
+
+// CommentReactionResponse 评论表态结果
+type CommentReactionResponse struct {
+	Reacted       bool           `json:"reacted"`        // 操作后当前用户是否持有该表态
+	Reactions     map[string]int `json:"reactions"`      // 各类表态的数量
+	ReactionCount int            `json:"reaction_count"` // 表态总数
+	MyReactions   []string       `json:"my_reactions"`   // 当前用户的表态
+}
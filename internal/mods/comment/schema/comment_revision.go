@@ -0,0 +1,33 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// CommentRevision 评论的历史版本，每次编辑前记录编辑前的内容
+type CommentRevision struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	CommentID uint      `json:"comment_id" gorm:"not null;index;comment:评论ID"`
+	Content   string    `json:"content" gorm:"type:text;not null;comment:编辑前的评论内容"`
+	Status    int       `json:"status" gorm:"type:tinyint;comment:编辑前的审核状态"`
+	EditorID  uint      `json:"editor_id" gorm:"not null;comment:编辑人ID,外部引用随来源页面更新时为0"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:编辑时间"`
+}
+
+// TableName 表名
+func (a *CommentRevision) TableName() string {
+	return config.C.FormatTableName("comment_revision")
+}
+
+// CommentRevisionResponse 评论历史版本响应结构
+type CommentRevisionResponse struct {
+	ID        uint      `json:"id"`
+	CommentID uint      `json:"comment_id"`
+	Content   string    `json:"content"`          // 编辑前的评论内容
+	Status    int       `json:"status"`           // 编辑前的审核状态
+	EditorID  uint      `json:"editor_id"`        // 编辑人ID
+	Editor    string    `json:"editor,omitempty"` // 编辑人名称
+	CreatedAt time.Time `json:"created_at"`       // 编辑时间
+}
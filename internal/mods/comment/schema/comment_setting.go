@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 评论开关常量
+const (
+	CommentSettingStatusOpen   = "open"   // 开放评论
+	CommentSettingStatusClosed = "closed" // 关闭评论
+	CommentSettingStatusAuto   = "auto"   // 文章发布指定天数后自动关闭评论
+)
+
+// 评论审核模式常量
+const (
+	CommentModerationPre     = "pre"     // 先审后发：非管理员的评论按垃圾评论评分自动处理或进入待审核
+	CommentModerationPost    = "post"    // 先发后审：站内用户的评论立即公开，之后由审核员复核；访客及站外评论仍先审后发
+	CommentModerationTrusted = "trusted" // 仅限受信任用户评论，其评论立即公开；联邦回复进入待审核
+)
+
+// CommentSetting 文章的评论设置，字段为空时使用站点默认设置
+type CommentSetting struct {
+	ArticleID     uint      `json:"article_id" gorm:"primaryKey;autoIncrement:false;comment:文章ID"`
+	Status        string    `json:"status" gorm:"size:10;comment:评论开关,open-开放,closed-关闭,auto-自动关闭,为空时使用默认设置"`
+	AutoCloseDays int       `json:"auto_close_days" gorm:"not null;default:0;comment:自动关闭评论的天数,为 0 时使用默认设置"`
+	Moderation    string    `json:"moderation" gorm:"size:10;comment:审核模式,pre-先审后发,post-先发后审,trusted-仅限受信任用户,为空时使用默认设置"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *CommentSetting) TableName() string {
+	return config.C.FormatTableName("comment_setting")
+}
+
+// UpdateCommentSettingRequest 更新文章评论设置请求，字段为空表示使用站点默认设置
+type UpdateCommentSettingRequest struct {
+	Status        string `json:"status" binding:"omitempty,oneof=open closed auto"`     // 评论开关：open-开放，closed-关闭，auto-发布指定天数后自动关闭
+	AutoCloseDays int    `json:"auto_close_days" binding:"omitempty,min=1,max=3650"`    // 自动关闭评论的天数，从文章首次发布时起算
+	Moderation    string `json:"moderation" binding:"omitempty,oneof=pre post trusted"` // 审核模式：pre-先审后发，post-先发后审，trusted-仅限受信任用户评论
+}
+
+// CommentSettingResponse 文章生效的评论设置
+type CommentSettingResponse struct {
+	ArticleID     uint       `json:"article_id"`          // 文章ID
+	Status        string     `json:"status"`              // 评论开关
+	AutoCloseDays int        `json:"auto_close_days"`     // 自动关闭评论的天数
+	Moderation    string     `json:"moderation"`          // 审核模式
+	Customized    bool       `json:"customized"`          // 是否为文章单独设置，否则为站点默认设置
+	Closed        bool       `json:"closed"`              // 当前是否已关闭评论
+	ClosesAt      *time.Time `json:"closes_at,omitempty"` // 自动关闭评论的时间
+}
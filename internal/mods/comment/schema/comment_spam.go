@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/pkg/json"
+)
+
+// 垃圾评论评分信号常量
+const (
+	SpamSignalLinks      = "links"       // 链接过多
+	SpamSignalDuplicate  = "duplicate"   // 重复内容
+	SpamSignalNewAccount = "new_account" // 新注册账号
+	SpamSignalRate       = "rate"        // 发布过快
+	SpamSignalBlocklist  = "blocklist"   // 命中屏蔽词
+	SpamSignalBayes      = "bayes"       // 贝叶斯分类
+)
+
+// 分类器训练标签常量
+const (
+	SpamLabelSpam = "spam" // 垃圾评论
+	SpamLabelHam  = "ham"  // 正常评论
+)
+
+// SpamSignal 垃圾评论评分的一项依据
+type SpamSignal struct {
+	Name   string `json:"name"`   // 信号名称
+	Score  int    `json:"score"`  // 信号分数，负数表示倾向正常
+	Detail string `json:"detail"` // 说明
+}
+
+// SpamResult 垃圾评论评分结果
+type SpamResult struct {
+	Score   int          `json:"score"`   // 总分（0-100）
+	Signals []SpamSignal `json:"signals"` // 评分依据
+}
+
+// SpamSignalList 解析评论的评分依据
+func (a *Comment) SpamSignalList() []SpamSignal {
+	if a.SpamSignals == "" {
+		return nil
+	}
+	var signals []SpamSignal
+	if err := json.Unmarshal([]byte(a.SpamSignals), &signals); err != nil {
+		return nil
+	}
+	return signals
+}
+
+// CommentSpamToken 贝叶斯分类器的特征统计
+type CommentSpamToken struct {
+	Token     string    `json:"token" gorm:"primaryKey;size:100;comment:特征"`
+	SpamCount int64     `json:"spam_count" gorm:"not null;default:0;comment:出现该特征的垃圾样本数"`
+	HamCount  int64     `json:"ham_count" gorm:"not null;default:0;comment:出现该特征的正常样本数"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *CommentSpamToken) TableName() string {
+	return config.C.FormatTableName("comment_spam_token")
+}
+
+// CommentSpamCorpus 贝叶斯分类器的样本数统计
+type CommentSpamCorpus struct {
+	Label     string    `json:"label" gorm:"primaryKey;size:10;comment:训练标签"`
+	Documents int64     `json:"documents" gorm:"not null;default:0;comment:样本数"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *CommentSpamCorpus) TableName() string {
+	return config.C.FormatTableName("comment_spam_corpus")
+}
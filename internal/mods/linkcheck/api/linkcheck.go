@@ -0,0 +1,125 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// LinkCheckHandler 链接检查API处理器
+type LinkCheckHandler struct {
+	LinkCheckService *biz.LinkCheckService
+}
+
+// @Tags LinkCheckAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户文章中的失效链接
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "每页容量" minimum(1) maximum(100) default(10)
+// @Param status query string false "链接状态，为空时返回失效与无法访问的链接" Enums(broken, unreachable)
+// @Param article_id query uint false "文章ID"
+// @Param host query string false "链接主机"
+// @Success 200 {object} util.ResponseResult{data=schema.BrokenLinkPaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/linkcheck/mine [get]
+func (h *LinkCheckHandler) GetMyBrokenLinks(c *gin.Context) {
+	var params schema.BrokenLinkQueryParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.LinkCheckService.GetMyBrokenLinks(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags LinkCheckAPI
+// @Security ApiKeyAuth
+// @Summary 获取全站的失效链接（仅管理员可用）
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "每页容量" minimum(1) maximum(100) default(10)
+// @Param status query string false "链接状态，为空时返回失效与无法访问的链接" Enums(broken, unreachable)
+// @Param author_id query uint false "作者ID"
+// @Param article_id query uint false "文章ID"
+// @Param host query string false "链接主机"
+// @Success 200 {object} util.ResponseResult{data=schema.BrokenLinkPaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/linkcheck/links [get]
+func (h *LinkCheckHandler) GetBrokenLinks(c *gin.Context) {
+	var params schema.BrokenLinkQueryParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.LinkCheckService.GetBrokenLinks(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags LinkCheckAPI
+// @Security ApiKeyAuth
+// @Summary 获取全站链接检查概况（仅管理员可用）
+// @Success 200 {object} util.ResponseResult{data=schema.LinkCheckSummary}
+// @Failure 401 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/linkcheck/summary [get]
+func (h *LinkCheckHandler) GetSummary(c *gin.Context) {
+	ctx := c.Request.Context()
+	data, err := h.LinkCheckService.GetSummary(ctx)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags LinkCheckAPI
+// @Security ApiKeyAuth
+// @Summary 立即复查链接（仅文章作者与管理员可用）
+// @Param id path uint true "链接ID" minimum(1)
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/linkcheck/links/{id}/recheck [post]
+func (h *LinkCheckHandler) Recheck(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的链接ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.LinkCheckService.Recheck(ctx, uint(id)); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
@@ -0,0 +1,278 @@
+package biz
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/linkcheck"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+	"github.com/codeExpert666/goinkblog-backend/pkg/webmention"
+)
+
+const (
+	// scanBatchSize 扫描文章时每批读取的文章数
+	scanBatchSize = 100
+	// summaryTopN 概况中主机与作者排行的数量
+	summaryTopN = 10
+	// maxLinkLength 可记录的链接最大长度
+	maxLinkLength = 500
+)
+
+// LinkCheckService 链接检查业务逻辑层
+// 后台任务定期从已发布文章中提取外部链接，并按到期时间检查其可用性
+type LinkCheckService struct {
+	checker             *linkcheck.Checker `wire:"-"`
+	worker              outbox.Worker      `wire:"-"` // 定期扫描文章并检查到期链接，有链接需要复查时立即唤醒
+	scannedAt           time.Time          `wire:"-"` // 上次扫描文章的时间，仅由后台任务访问
+	LinkCheckRepository *dal.LinkCheckRepository
+	UserRepository      *userDal.UserRepository
+}
+
+// Start 启动后台任务
+func (s *LinkCheckService) Start(ctx context.Context) {
+	cfg := config.C.LinkCheck
+	s.checker = linkcheck.NewChecker(linkcheck.Options{
+		Timeout:           time.Duration(cfg.Timeout) * time.Second,
+		Concurrency:       cfg.Concurrency,
+		HostDelay:         time.Duration(cfg.HostDelay) * time.Millisecond,
+		UserAgent:         config.C.General.AppName + "/" + config.C.General.Version,
+		AllowPrivateHosts: cfg.AllowPrivateHosts,
+	})
+	if !cfg.Enabled {
+		return
+	}
+
+	s.worker.Start(ctx, time.Duration(cfg.Interval)*time.Second, s.run)
+	s.worker.Kick()
+}
+
+// run 扫描文章并检查到期的链接
+func (s *LinkCheckService) run(ctx context.Context, _ bool) {
+	s.scanArticles(ctx)
+	s.checkDue(ctx)
+}
+
+// lease 任务领取后的保护时间，超过该时间未完成的任务可被重新领取
+func lease() time.Duration {
+	cfg := config.C.LinkCheck
+	perHost := time.Duration(cfg.Timeout)*2*time.Second + time.Duration(cfg.HostDelay)*time.Millisecond
+	return time.Duration(cfg.BatchSize) * perHost
+}
+
+// scanArticles 提取自上次扫描以来更新过的已发布文章中的外部链接，并清理已删除或下线文章的链接
+func (s *LinkCheckService) scanArticles(ctx context.Context) {
+	if err := s.LinkCheckRepository.DeleteOrphans(ctx); err != nil {
+		logging.Context(ctx).Error("清理失效文章的链接失败", zap.Error(err))
+	}
+
+	// 预留一定余量，避免与正在提交的文章更新错过
+	startedAt := time.Now().Add(-time.Minute)
+	var afterID uint
+	for {
+		articles, err := s.LinkCheckRepository.GetPublishedArticlesSince(ctx, s.scannedAt, afterID, scanBatchSize)
+		if err != nil {
+			logging.Context(ctx).Error("获取待扫描文章失败", zap.Error(err))
+			return
+		}
+
+		for _, article := range articles {
+			links := s.extractLinks(article.ID, article.AuthorID, article.Content)
+			if err := s.LinkCheckRepository.SyncArticleLinks(ctx, article.ID, article.AuthorID, links); err != nil {
+				logging.Context(ctx).Error("同步文章外部链接失败", zap.Error(err), zap.Uint("article_id", article.ID))
+				return
+			}
+		}
+
+		if len(articles) < scanBatchSize {
+			break
+		}
+		afterID = articles[len(articles)-1].ID
+	}
+	s.scannedAt = startedAt
+}
+
+// extractLinks 提取正文中指向外部站点的链接
+func (s *LinkCheckService) extractLinks(articleID, authorID uint, content string) []schema.ArticleLink {
+	var siteHost string
+	if site, err := url.Parse(config.C.Blog.Share.SiteURL); err == nil {
+		siteHost = site.Host
+	}
+
+	now := time.Now()
+	var links []schema.ArticleLink
+	for _, link := range webmention.ExtractLinks(content) {
+		u, err := url.Parse(link)
+		if err != nil || len(link) > maxLinkLength || len(u.Host) > 255 {
+			continue
+		}
+		if siteHost != "" && strings.EqualFold(u.Host, siteHost) {
+			continue
+		}
+		links = append(links, schema.ArticleLink{
+			ArticleID:   articleID,
+			AuthorID:    authorID,
+			URL:         link,
+			Host:        strings.ToLower(u.Host),
+			Status:      schema.LinkStatusPending,
+			NextCheckAt: now,
+		})
+	}
+	return links
+}
+
+// checkDue 检查到期的链接，同一链接出现在多篇文章中时只请求一次
+func (s *LinkCheckService) checkDue(ctx context.Context) {
+	cfg := config.C.LinkCheck
+	items, err := s.LinkCheckRepository.ClaimDue(ctx, cfg.BatchSize, lease())
+	if err != nil {
+		logging.Context(ctx).Error("领取链接检查任务失败", zap.Error(err))
+		return
+	}
+	if len(items) == 0 {
+		return
+	}
+
+	var urls []string
+	seen := make(map[string]struct{})
+	for _, item := range items {
+		if _, ok := seen[item.URL]; !ok {
+			seen[item.URL] = struct{}{}
+			urls = append(urls, item.URL)
+		}
+	}
+
+	results := make(map[string]linkcheck.Result, len(urls))
+	for _, result := range s.checker.CheckAll(ctx, urls) {
+		results[result.URL] = result
+	}
+
+	for i := range items {
+		item := &items[i]
+		s.applyResult(item, results[item.URL])
+		if err := s.LinkCheckRepository.Update(ctx, item); err != nil {
+			logging.Context(ctx).Error("更新链接检查结果失败", zap.Error(err), zap.Uint("link_id", item.ID))
+		}
+	}
+
+	// 本轮已满，可能还有到期的链接
+	if len(items) == cfg.BatchSize {
+		s.worker.Kick()
+	}
+}
+
+// applyResult 记录检查结果并安排下次检查
+func (s *LinkCheckService) applyResult(item *schema.ArticleLink, result linkcheck.Result) {
+	cfg := config.C.LinkCheck
+	checkedAt := result.CheckedAt
+	item.Status = result.Status
+	item.StatusCode = result.StatusCode
+	item.LastError = ""
+	if result.Err != nil {
+		item.LastError = util.Truncate(result.Err.Error(), 500)
+	}
+	item.LastCheckedAt = &checkedAt
+
+	if result.Status == linkcheck.StatusOK {
+		item.Failures = 0
+		item.NextCheckAt = checkedAt.Add(time.Duration(cfg.RecheckInterval) * time.Hour)
+	} else {
+		item.Failures++
+		item.NextCheckAt = checkedAt.Add(time.Duration(cfg.RetryInterval) * time.Hour)
+	}
+}
+
+// GetMyBrokenLinks 获取当前用户文章中的失效链接
+func (s *LinkCheckService) GetMyBrokenLinks(ctx context.Context, params *schema.BrokenLinkQueryParams) (*schema.BrokenLinkPaginationResult, error) {
+	params.AuthorID = util.FromUserID(ctx)
+	return s.LinkCheckRepository.GetBrokenList(ctx, params)
+}
+
+// GetBrokenLinks 获取全站的失效链接（管理员）
+func (s *LinkCheckService) GetBrokenLinks(ctx context.Context, params *schema.BrokenLinkQueryParams) (*schema.BrokenLinkPaginationResult, error) {
+	return s.LinkCheckRepository.GetBrokenList(ctx, params)
+}
+
+// GetSummary 获取全站链接检查概况（管理员）
+func (s *LinkCheckService) GetSummary(ctx context.Context) (*schema.LinkCheckSummary, error) {
+	summary := &schema.LinkCheckSummary{}
+
+	counts, err := s.LinkCheckRepository.CountByStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, count := range counts {
+		summary.TotalLinks += count.Count
+		switch count.Status {
+		case schema.LinkStatusOK:
+			summary.OK = count.Count
+		case schema.LinkStatusBroken:
+			summary.Broken = count.Count
+		case schema.LinkStatusUnreachable:
+			summary.Unreachable = count.Count
+		case schema.LinkStatusPending:
+			summary.Pending = count.Count
+		}
+	}
+
+	if summary.AffectedArticles, err = s.LinkCheckRepository.CountAffectedArticles(ctx); err != nil {
+		return nil, err
+	}
+	if summary.LastCheckedAt, err = s.LinkCheckRepository.GetLastCheckedAt(ctx); err != nil {
+		return nil, err
+	}
+	if summary.TopHosts, err = s.LinkCheckRepository.GetTopBrokenHosts(ctx, summaryTopN); err != nil {
+		return nil, err
+	}
+	if summary.TopAuthors, err = s.LinkCheckRepository.GetTopBrokenAuthors(ctx, summaryTopN); err != nil {
+		return nil, err
+	}
+
+	// 补充作者信息
+	for i := range summary.TopAuthors {
+		author := &summary.TopAuthors[i]
+		if user, err := s.UserRepository.GetByID(ctx, author.AuthorID); err != nil {
+			logging.Context(ctx).Error("获取作者信息失败", zap.Uint("author_id", author.AuthorID), zap.Error(err))
+		} else {
+			author.Username = user.Username
+		}
+	}
+
+	return summary, nil
+}
+
+// Recheck 将链接安排为立即复查，仅文章作者与管理员可用
+func (s *LinkCheckService) Recheck(ctx context.Context, id uint) error {
+	link, err := s.LinkCheckRepository.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if link.AuthorID != util.FromUserID(ctx) && !util.FromIsAdminUser(ctx) {
+		return errors.Forbidden("无权限复查此链接")
+	}
+	if !config.C.LinkCheck.Enabled {
+		return errors.BadRequest("链接检查未开启")
+	}
+
+	if err := s.LinkCheckRepository.ScheduleRecheck(ctx, id); err != nil {
+		return err
+	}
+	s.worker.Kick()
+	return nil
+}
+
+// Release 停止后台任务
+func (s *LinkCheckService) Release(ctx context.Context) error {
+	s.worker.Stop()
+	return nil
+}
@@ -0,0 +1,243 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	blogDal "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	blogSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetArticleLinkDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.ArticleLink{})
+}
+
+// LinkCheckRepository 链接检查数据访问层
+type LinkCheckRepository struct {
+	DB *gorm.DB
+}
+
+// GetPublishedArticlesSince 分批获取指定时间之后更新过的已发布文章，afterID 为上一批最后一篇文章的ID
+func (r *LinkCheckRepository) GetPublishedArticlesSince(ctx context.Context, since time.Time, afterID uint, limit int) ([]blogSchema.Article, error) {
+	var articles []blogSchema.Article
+	err := blogDal.GetArticleDB(ctx, r.DB).
+		Select("id, author_id, content, updated_at").
+		Where("status = ? AND updated_at > ? AND id > ?", "published", since, afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&articles).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return articles, nil
+}
+
+// SyncArticleLinks 同步文章的外部链接：新增的链接等待检查，已从正文中移除的链接被删除，已有链接保留检查结果
+func (r *LinkCheckRepository) SyncArticleLinks(ctx context.Context, articleID, authorID uint, links []schema.ArticleLink) error {
+	urls := make([]string, 0, len(links))
+	for _, link := range links {
+		urls = append(urls, link.URL)
+	}
+
+	db := GetArticleLinkDB(ctx, r.DB).Where("article_id = ?", articleID)
+	if len(urls) > 0 {
+		db = db.Where("url NOT IN ?", urls)
+	}
+	if err := db.Delete(&schema.ArticleLink{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(links) == 0 {
+		return nil
+	}
+	result := GetArticleLinkDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "article_id"}, {Name: "url"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{"author_id": authorID}),
+	}).Create(&links)
+	return errors.WithStack(result.Error)
+}
+
+// DeleteOrphans 删除已删除或未发布文章的链接
+func (r *LinkCheckRepository) DeleteOrphans(ctx context.Context) error {
+	published := blogDal.GetArticleDB(ctx, r.DB).Select("id").Where("status = ?", "published")
+	result := GetArticleLinkDB(ctx, r.DB).Where("article_id NOT IN (?)", published).Delete(&schema.ArticleLink{})
+	return errors.WithStack(result.Error)
+}
+
+// ClaimDue 领取到期的检查任务，领取后任务在 lease 时间内不会被其他实例重复领取
+func (r *LinkCheckRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]schema.ArticleLink, error) {
+	var items []schema.ArticleLink
+	now := time.Now()
+	err := GetArticleLinkDB(ctx, r.DB).
+		Where("next_check_at <= ?", now).
+		Order("next_check_at ASC").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return outbox.Claim(func() *gorm.DB { return GetArticleLinkDB(ctx, r.DB) }, items, "next_check_at", now.Add(lease),
+		func(item *schema.ArticleLink) (uint, *time.Time) { return item.ID, &item.NextCheckAt })
+}
+
+// Update 更新链接的检查结果
+func (r *LinkCheckRepository) Update(ctx context.Context, link *schema.ArticleLink) error {
+	result := GetArticleLinkDB(ctx, r.DB).Where("id = ?", link.ID).Select("*").Omit("created_at").Updates(link)
+	return errors.WithStack(result.Error)
+}
+
+// GetByID 通过ID获取链接
+func (r *LinkCheckRepository) GetByID(ctx context.Context, id uint) (*schema.ArticleLink, error) {
+	var link schema.ArticleLink
+	err := GetArticleLinkDB(ctx, r.DB).Where("id = ?", id).First(&link).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("链接不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &link, nil
+}
+
+// ScheduleRecheck 将链接安排为立即复查
+func (r *LinkCheckRepository) ScheduleRecheck(ctx context.Context, id uint) error {
+	result := GetArticleLinkDB(ctx, r.DB).Where("id = ?", id).UpdateColumn("next_check_at", time.Now())
+	return errors.WithStack(result.Error)
+}
+
+// GetBrokenList 获取失效或无法访问的链接列表（带分页）
+func (r *LinkCheckRepository) GetBrokenList(ctx context.Context, params *schema.BrokenLinkQueryParams) (*schema.BrokenLinkPaginationResult, error) {
+	var result schema.BrokenLinkPaginationResult
+
+	// 默认值
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+
+	articleTable := new(blogSchema.Article).TableName()
+	db := util.GetDB(ctx, r.DB).Table(new(schema.ArticleLink).TableName() + " AS l").
+		Joins("LEFT JOIN " + articleTable + " AS a ON a.id = l.article_id")
+	if params.Status != "" {
+		db = db.Where("l.status = ?", params.Status)
+	} else {
+		db = db.Where("l.status IN ?", []string{schema.LinkStatusBroken, schema.LinkStatusUnreachable})
+	}
+	if params.AuthorID > 0 {
+		db = db.Where("l.author_id = ?", params.AuthorID)
+	}
+	if params.ArticleID > 0 {
+		db = db.Where("l.article_id = ?", params.ArticleID)
+	}
+	if params.Host != "" {
+		db = db.Where("l.host = ?", params.Host)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]schema.BrokenLinkResponse, 0)
+	if total > 0 {
+		offset := (params.Page - 1) * params.PageSize
+		err := db.Select("l.id, l.article_id, a.title AS article_title, l.author_id, l.url, l.host, l.status, " +
+			"l.status_code, l.last_error, l.failures, l.last_checked_at, l.next_check_at").
+			Order("l.status ASC, l.article_id DESC, l.id ASC").
+			Offset(offset).Limit(params.PageSize).
+			Scan(&items).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = params.Page
+	result.PageSize = params.PageSize
+	result.TotalPages = int((total + int64(params.PageSize) - 1) / int64(params.PageSize))
+
+	return &result, nil
+}
+
+// CountByStatus 统计各状态的链接数量
+func (r *LinkCheckRepository) CountByStatus(ctx context.Context) ([]schema.LinkStatusCount, error) {
+	var counts []schema.LinkStatusCount
+	err := GetArticleLinkDB(ctx, r.DB).
+		Select("status, COUNT(*) AS count").
+		Group("status").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return counts, nil
+}
+
+// CountAffectedArticles 统计包含失效或无法访问链接的文章数
+func (r *LinkCheckRepository) CountAffectedArticles(ctx context.Context) (int64, error) {
+	var count int64
+	err := GetArticleLinkDB(ctx, r.DB).
+		Where("status IN ?", []string{schema.LinkStatusBroken, schema.LinkStatusUnreachable}).
+		Distinct("article_id").
+		Count(&count).Error
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return count, nil
+}
+
+// GetLastCheckedAt 获取最近一次检查时间
+func (r *LinkCheckRepository) GetLastCheckedAt(ctx context.Context) (*time.Time, error) {
+	var link schema.ArticleLink
+	err := GetArticleLinkDB(ctx, r.DB).
+		Where("last_checked_at IS NOT NULL").
+		Order("last_checked_at DESC").
+		Limit(1).
+		Find(&link).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return link.LastCheckedAt, nil
+}
+
+// GetTopBrokenHosts 获取失效链接最多的主机
+func (r *LinkCheckRepository) GetTopBrokenHosts(ctx context.Context, limit int) ([]schema.HostBrokenCount, error) {
+	var counts []schema.HostBrokenCount
+	err := GetArticleLinkDB(ctx, r.DB).
+		Select("host, COUNT(*) AS count").
+		Where("status = ?", schema.LinkStatusBroken).
+		Group("host").
+		Order("count DESC").
+		Limit(limit).
+		Scan(&counts).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return counts, nil
+}
+
+// GetTopBrokenAuthors 获取失效链接最多的作者
+func (r *LinkCheckRepository) GetTopBrokenAuthors(ctx context.Context, limit int) ([]schema.AuthorBrokenCount, error) {
+	var counts []schema.AuthorBrokenCount
+	err := GetArticleLinkDB(ctx, r.DB).
+		Select("author_id, COUNT(*) AS count").
+		Where("status = ?", schema.LinkStatusBroken).
+		Group("author_id").
+		Order("count DESC").
+		Limit(limit).
+		Scan(&counts).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return counts, nil
+}
@@ -0,0 +1,67 @@
+package linkcheck
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/api"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/schema"
+)
+
+// LinkCheck 链接检查模块
+type LinkCheck struct {
+	DB               *gorm.DB
+	LinkCheckService *biz.LinkCheckService
+	LinkCheckHandler *api.LinkCheckHandler
+}
+
+// Set 注入链接检查模块
+var Set = wire.NewSet(
+	wire.Struct(new(LinkCheck), "*"),
+
+	// 链接检查相关结构体
+	wire.Struct(new(api.LinkCheckHandler), "*"),
+	wire.Struct(new(biz.LinkCheckService), "*"),
+	wire.Struct(new(dal.LinkCheckRepository), "*"),
+)
+
+// AutoMigrate 自动迁移数据库
+func (l *LinkCheck) AutoMigrate(ctx context.Context) error {
+	return l.DB.AutoMigrate(
+		&schema.ArticleLink{},
+	)
+}
+
+// Init 初始化链接检查模块
+func (l *LinkCheck) Init(ctx context.Context) error {
+	if config.C.Storage.DB.AutoMigrate {
+		if err := l.AutoMigrate(ctx); err != nil {
+			return err
+		}
+	}
+
+	// 启动链接检查的后台任务
+	l.LinkCheckService.Start(ctx)
+	return nil
+}
+
+// RegisterRouters 注册路由
+func (l *LinkCheck) RegisterRouters(ctx context.Context, linkcheck *gin.RouterGroup) error {
+	linkcheck.GET("/mine", l.LinkCheckHandler.GetMyBrokenLinks)
+	linkcheck.POST("/links/:id/recheck", l.LinkCheckHandler.Recheck)
+	// 管理员接口
+	linkcheck.GET("/links", l.LinkCheckHandler.GetBrokenLinks)
+	linkcheck.GET("/summary", l.LinkCheckHandler.GetSummary)
+	return nil
+}
+
+// Release 释放资源
+func (l *LinkCheck) Release(ctx context.Context) error {
+	return l.LinkCheckService.Release(ctx)
+}
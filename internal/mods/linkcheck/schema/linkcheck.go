@@ -0,0 +1,104 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 链接状态常量
+const (
+	LinkStatusPending     = "pending"     // 等待检查
+	LinkStatusOK          = "ok"          // 链接可用
+	LinkStatusBroken      = "broken"      // 链接已失效
+	LinkStatusUnreachable = "unreachable" // 暂时无法访问
+)
+
+// ArticleLink 文章中的外部链接及其检查结果，每篇文章的每个外部链接对应一条记录
+type ArticleLink struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	ArticleID     uint       `json:"article_id" gorm:"not null;uniqueIndex:idx_article_url;comment:文章ID"`
+	AuthorID      uint       `json:"author_id" gorm:"not null;index;comment:文章作者ID"`
+	URL           string     `json:"url" gorm:"size:500;not null;uniqueIndex:idx_article_url;comment:外部链接"`
+	Host          string     `json:"host" gorm:"size:255;not null;index;comment:链接主机"`
+	Status        string     `json:"status" gorm:"size:20;not null;index;comment:链接状态"`
+	StatusCode    int        `json:"status_code" gorm:"not null;default:0;comment:最近一次检查的响应状态码"`
+	LastError     string     `json:"last_error" gorm:"size:500;comment:最近一次检查的失败原因"`
+	Failures      int        `json:"failures" gorm:"not null;default:0;comment:连续失败次数"`
+	LastCheckedAt *time.Time `json:"last_checked_at" gorm:"comment:最近一次检查时间"`
+	NextCheckAt   time.Time  `json:"next_check_at" gorm:"index;comment:下次检查时间"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *ArticleLink) TableName() string {
+	return config.C.FormatTableName("article_link")
+}
+
+// BrokenLinkQueryParams 失效链接查询请求
+type BrokenLinkQueryParams struct {
+	Page      int    `form:"page" binding:"omitempty,min=1"`
+	PageSize  int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Status    string `form:"status" binding:"omitempty,oneof=broken unreachable"` // 为空时返回失效与无法访问的链接
+	AuthorID  uint   `form:"author_id"`                                           // 仅管理员可用，按作者过滤
+	ArticleID uint   `form:"article_id"`                                          // 按文章过滤
+	Host      string `form:"host"`                                                // 按主机过滤
+}
+
+// BrokenLinkResponse 失效链接响应结构
+type BrokenLinkResponse struct {
+	ID            uint       `json:"id"`
+	ArticleID     uint       `json:"article_id"`
+	ArticleTitle  string     `json:"article_title"`
+	AuthorID      uint       `json:"author_id"`
+	URL           string     `json:"url"`
+	Host          string     `json:"host"`
+	Status        string     `json:"status"`
+	StatusCode    int        `json:"status_code"`
+	LastError     string     `json:"last_error"`
+	Failures      int        `json:"failures"`
+	LastCheckedAt *time.Time `json:"last_checked_at"`
+	NextCheckAt   time.Time  `json:"next_check_at"`
+}
+
+// BrokenLinkPaginationResult 失效链接分页结果
+type BrokenLinkPaginationResult struct {
+	Items      []BrokenLinkResponse `json:"items"`
+	Total      int64                `json:"total"`
+	Page       int                  `json:"page"`
+	PageSize   int                  `json:"page_size"`
+	TotalPages int                  `json:"total_pages"`
+}
+
+// LinkStatusCount 各状态的链接数量
+type LinkStatusCount struct {
+	Status string `json:"status"`
+	Count  int64  `json:"count"`
+}
+
+// HostBrokenCount 主机的失效链接数量
+type HostBrokenCount struct {
+	Host  string `json:"host"`
+	Count int64  `json:"count"`
+}
+
+// AuthorBrokenCount 作者的失效链接数量
+type AuthorBrokenCount struct {
+	AuthorID uint   `json:"author_id"`
+	Username string `json:"username"`
+	Count    int64  `json:"count"`
+}
+
+// LinkCheckSummary 全站链接检查概况
+type LinkCheckSummary struct {
+	TotalLinks       int64               `json:"total_links"`       // 链接总数
+	OK               int64               `json:"ok"`                // 可用链接数
+	Broken           int64               `json:"broken"`            // 失效链接数
+	Unreachable      int64               `json:"unreachable"`       // 无法访问的链接数
+	Pending          int64               `json:"pending"`           // 等待检查的链接数
+	AffectedArticles int64               `json:"affected_articles"` // 包含失效或无法访问链接的文章数
+	LastCheckedAt    *time.Time          `json:"last_checked_at"`   // 最近一次检查时间
+	TopHosts         []HostBrokenCount   `json:"top_hosts"`         // 失效链接最多的主机
+	TopAuthors       []AuthorBrokenCount `json:"top_authors"`       // 失效链接最多的作者
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// MailHandler 邮件API处理器
+type MailHandler struct {
+	MailService *biz.MailService
+}
+
+// @Tags MailAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户的通知摘要邮件设置
+// @Success 200 {object} util.ResponseResult{data=schema.DigestSettingResponse}
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mail/digest [get]
+func (h *MailHandler) GetDigestSetting(c *gin.Context) {
+	ctx := c.Request.Context()
+	data, err := h.MailService.GetDigestSetting(ctx)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags MailAPI
+// @Security ApiKeyAuth
+// @Summary 更新当前用户的通知摘要邮件设置（每日、每周或不发送）
+// @Param body body schema.UpdateDigestRequest true "摘要邮件设置"
+// @Success 200 {object} util.ResponseResult{data=schema.DigestSettingResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mail/digest [put]
+func (h *MailHandler) UpdateDigestSetting(c *gin.Context) {
+	var req schema.UpdateDigestRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.MailService.UpdateDigestSetting(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags MailAPI
+// @Security ApiKeyAuth
+// @Summary 获取发件箱中的邮件（仅管理员）
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "每页容量" minimum(1) maximum(100) default(10)
+// @Param status query string false "发送状态" Enums(pending, sent, failed)
+// @Success 200 {object} util.ResponseResult{data=schema.OutboxPaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mail/outbox [get]
+func (h *MailHandler) GetOutbox(c *gin.Context) {
+	var params schema.OutboxQueryParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.MailService.GetOutbox(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags MailAPI
+// @Security ApiKeyAuth
+// @Summary 发送测试邮件，用于检查 SMTP 配置（仅管理员）
+// @Param body body schema.SendTestMailRequest true "收件人"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mail/test [post]
+func (h *MailHandler) SendTestMail(c *gin.Context) {
+	var req schema.SendTestMailRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.MailService.SendTestMail(ctx, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
@@ -0,0 +1,321 @@
+package biz
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	blogSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/schema"
+	notificationDal "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/dal"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/mailer"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// MailService 邮件业务逻辑层
+// 邮件在入队时渲染并写入发件箱，由后台任务异步发送，失败时按指数退避重试；
+// 后台任务同时负责按用户设置生成每日或每周的通知摘要邮件
+type MailService struct {
+	transport              mailer.Transport `wire:"-"`
+	renderer               *mailer.Renderer `wire:"-"`
+	worker                 outbox.Worker    `wire:"-"` // 定期处理到期邮件与摘要，有新邮件时立即唤醒
+	MailRepository         *dal.MailRepository
+	UserRepository         *userDal.UserRepository
+	NotificationRepository *notificationDal.NotificationRepository
+}
+
+// Start 启动后台任务
+func (s *MailService) Start(ctx context.Context) {
+	cfg := config.C.Mailer
+	s.transport = mailer.NewSMTPTransport(mailer.Options{
+		Host:     cfg.Host,
+		Port:     cfg.Port,
+		Username: cfg.Username,
+		Password: cfg.Password,
+		From:     cfg.From,
+		FromName: cfg.FromName,
+		TLS:      cfg.TLS,
+		Timeout:  time.Duration(cfg.Timeout) * time.Second,
+	})
+	s.renderer = mailer.NewRenderer(os.DirFS(filepath.Join(config.C.General.WorkDir, cfg.TemplateDir)), cfg.DefaultLocale)
+	if !cfg.Enabled {
+		return
+	}
+
+	s.worker.Start(ctx, time.Duration(cfg.Interval)*time.Second, s.run)
+}
+
+// run 处理到期的邮件，定时触发时同时生成到期的摘要
+func (s *MailService) run(ctx context.Context, tick bool) {
+	if tick {
+		s.processDigests(ctx)
+	}
+	s.processOutbox(ctx)
+}
+
+// retryDelay 第 attempts 次失败后的重试等待时间
+func retryDelay(attempts int) time.Duration {
+	return outbox.Backoff(time.Duration(config.C.Mailer.RetryBackoff)*time.Second, attempts)
+}
+
+// lease 邮件领取后的保护时间，超过该时间未完成的邮件可被重新领取
+func lease() time.Duration {
+	return outbox.Lease(time.Duration(config.C.Mailer.Timeout) * time.Second)
+}
+
+// Enqueue 渲染模板并将邮件写入发件箱，由后台任务异步发送，不阻塞调用方
+// 未开启邮件发送时忽略
+func (s *MailService) Enqueue(ctx context.Context, to, template, locale string, data any) error {
+	if !config.C.Mailer.Enabled {
+		return nil
+	}
+
+	msg, err := s.renderer.Render(template, locale, data)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if locale == "" {
+		locale = config.C.Mailer.DefaultLocale
+	}
+
+	err = s.MailRepository.Enqueue(ctx, &schema.MailOutbox{
+		To:            to,
+		Template:      template,
+		Locale:        locale,
+		Subject:       util.Truncate(msg.Subject, 255),
+		TextBody:      msg.Text,
+		HTMLBody:      msg.HTML,
+		Status:        schema.MailStatusPending,
+		NextAttemptAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.worker.Kick()
+	return nil
+}
+
+// processOutbox 发送到期的邮件
+func (s *MailService) processOutbox(ctx context.Context) {
+	cfg := config.C.Mailer
+	items, err := s.MailRepository.ClaimDue(ctx, cfg.BatchSize, lease())
+	if err != nil {
+		logging.Context(ctx).Error("领取待发送邮件失败", zap.Error(err))
+		return
+	}
+
+	for i := range items {
+		item := &items[i]
+		err := s.transport.Send(ctx, &mailer.Message{
+			To:      []string{item.To},
+			Subject: item.Subject,
+			Text:    item.TextBody,
+			HTML:    item.HTMLBody,
+		})
+		if err != nil {
+			item.Attempts++
+			item.LastError = util.Truncate(err.Error(), 500)
+			if item.Attempts >= cfg.MaxAttempts {
+				item.Status = schema.MailStatusFailed
+			} else {
+				item.NextAttemptAt = time.Now().Add(retryDelay(item.Attempts))
+			}
+			logging.Context(ctx).Warn("发送邮件失败", zap.Error(err),
+				zap.Uint("id", item.ID), zap.String("template", item.Template), zap.Int("attempts", item.Attempts))
+		} else {
+			now := time.Now()
+			item.Status = schema.MailStatusSent
+			item.LastError = ""
+			item.SentAt = &now
+		}
+
+		if err := s.MailRepository.Update(ctx, item); err != nil {
+			logging.Context(ctx).Error("更新邮件发送状态失败", zap.Error(err), zap.Uint("id", item.ID))
+		}
+	}
+}
+
+// digestItem 摘要邮件中的一条通知
+type digestItem struct {
+	Message string
+	Time    time.Time
+	URL     string // 相关文章地址，未配置站点地址时为空
+}
+
+// digestData 摘要邮件模板数据
+type digestData struct {
+	AppName   string
+	Username  string
+	Frequency string
+	Items     []digestItem
+	Total     int64 // 周期内的未读通知总数
+	More      int64 // 未在邮件中列出的通知数
+	SiteURL   string
+}
+
+// digestPeriodStart 当前摘要周期的开始时间，即最近一次到达的发送时刻
+func digestPeriodStart(frequency string, now time.Time) time.Time {
+	cfg := config.C.Mailer.Digest
+	start := time.Date(now.Year(), now.Month(), now.Day(), cfg.Hour, 0, 0, 0, now.Location())
+	days := 1
+	if frequency == schema.DigestFrequencyWeekly {
+		days = 7
+		start = start.AddDate(0, 0, -((int(now.Weekday()) - cfg.WeeklyDay + 7) % 7))
+	}
+	if start.After(now) {
+		start = start.AddDate(0, 0, -days)
+	}
+	return start
+}
+
+// processDigests 为本周期内尚未发送摘要的用户生成摘要邮件
+func (s *MailService) processDigests(ctx context.Context) {
+	if !config.C.Mailer.Digest.Enabled {
+		return
+	}
+
+	now := time.Now()
+	for _, frequency := range []string{schema.DigestFrequencyDaily, schema.DigestFrequencyWeekly} {
+		start := digestPeriodStart(frequency, now)
+		subscriptions, err := s.MailRepository.GetDueSubscriptions(ctx, frequency, start, config.C.Mailer.BatchSize)
+		if err != nil {
+			logging.Context(ctx).Error("获取待发送的摘要设置失败", zap.Error(err), zap.String("frequency", frequency))
+			continue
+		}
+
+		for i := range subscriptions {
+			subscription := &subscriptions[i]
+			if err := s.sendDigest(ctx, subscription, start, now); err != nil {
+				logging.Context(ctx).Error("生成摘要邮件失败", zap.Error(err), zap.Uint("user_id", subscription.UserID))
+			}
+		}
+	}
+}
+
+// sendDigest 汇总用户自上次摘要以来的未读通知，没有新通知时不发送
+func (s *MailService) sendDigest(ctx context.Context, subscription *schema.DigestSubscription, start, now time.Time) error {
+	since := start.AddDate(0, 0, -1)
+	if subscription.Frequency == schema.DigestFrequencyWeekly {
+		since = start.AddDate(0, 0, -7)
+	}
+	if subscription.LastSentAt != nil {
+		since = *subscription.LastSentAt
+	}
+
+	claimed, err := s.MailRepository.ClaimSubscription(ctx, subscription, now)
+	if err != nil || !claimed {
+		return err
+	}
+
+	notifications, total, err := s.NotificationRepository.ListUnreadSince(ctx, subscription.UserID, since, config.C.Mailer.Digest.MaxItems)
+	if err != nil || total == 0 {
+		return err
+	}
+	user, err := s.UserRepository.GetByID(ctx, subscription.UserID)
+	if err != nil {
+		return err
+	}
+
+	site := config.C.SiteURL()
+	data := digestData{
+		AppName:   config.C.General.AppName,
+		Username:  user.Username,
+		Frequency: subscription.Frequency,
+		Items:     make([]digestItem, 0, len(notifications)),
+		Total:     total,
+		More:      total - int64(len(notifications)),
+		SiteURL:   site,
+	}
+	for _, n := range notifications {
+		item := digestItem{Message: n.Message(), Time: n.UpdatedAt}
+		if site != "" && n.ArticleID > 0 {
+			item.URL = blogSchema.ArticleURL(site, n.ArticleID)
+		}
+		data.Items = append(data.Items, item)
+	}
+	return s.Enqueue(ctx, user.Email, schema.TemplateDigest, subscription.Locale, data)
+}
+
+// GetDigestSetting 获取当前用户的摘要邮件设置
+func (s *MailService) GetDigestSetting(ctx context.Context) (*schema.DigestSettingResponse, error) {
+	subscription, err := s.MailRepository.GetSubscription(ctx, util.FromUserID(ctx))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &schema.DigestSettingResponse{
+				Frequency: schema.DigestFrequencyNone,
+				Locale:    config.C.Mailer.DefaultLocale,
+			}, nil
+		}
+		return nil, err
+	}
+
+	return &schema.DigestSettingResponse{
+		Frequency:  subscription.Frequency,
+		Locale:     subscription.Locale,
+		LastSentAt: subscription.LastSentAt,
+	}, nil
+}
+
+// UpdateDigestSetting 更新当前用户的摘要邮件设置，首封摘要在下一个发送时刻发送
+func (s *MailService) UpdateDigestSetting(ctx context.Context, req *schema.UpdateDigestRequest) (*schema.DigestSettingResponse, error) {
+	locale := req.Locale
+	if locale == "" {
+		locale = config.C.Mailer.DefaultLocale
+	}
+
+	userID := util.FromUserID(ctx)
+	existing, err := s.MailRepository.GetSubscription(ctx, userID)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	// 频率变化时从当前时间重新开始计算周期，避免汇总开启前的旧通知
+	lastSentAt := time.Now()
+	if existing != nil && existing.Frequency == req.Frequency && existing.LastSentAt != nil {
+		lastSentAt = *existing.LastSentAt
+	}
+	err = s.MailRepository.SaveSubscription(ctx, &schema.DigestSubscription{
+		UserID:     userID,
+		Frequency:  req.Frequency,
+		Locale:     locale,
+		LastSentAt: &lastSentAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetDigestSetting(ctx)
+}
+
+// GetOutbox 获取发件箱中的邮件
+func (s *MailService) GetOutbox(ctx context.Context, params *schema.OutboxQueryParams) (*schema.OutboxPaginationResult, error) {
+	return s.MailRepository.GetOutbox(ctx, params)
+}
+
+// SendTestMail 发送测试邮件，用于检查 SMTP 配置
+func (s *MailService) SendTestMail(ctx context.Context, req *schema.SendTestMailRequest) error {
+	if !config.C.Mailer.Enabled {
+		return errors.BadRequest("未开启邮件发送")
+	}
+
+	data := map[string]any{
+		"AppName": config.C.General.AppName,
+		"SentAt":  time.Now(),
+	}
+	return s.Enqueue(ctx, req.To, schema.TemplateTest, req.Locale, data)
+}
+
+// Release 停止后台任务
+func (s *MailService) Release(ctx context.Context) error {
+	s.worker.Stop()
+	return nil
+}
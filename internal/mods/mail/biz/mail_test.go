@@ -0,0 +1,53 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/schema"
+)
+
+func TestDigestPeriodStart(t *testing.T) {
+	digest := &config.C.Mailer.Digest
+	hour, weeklyDay := digest.Hour, digest.WeeklyDay
+	defer func() { digest.Hour, digest.WeeklyDay = hour, weeklyDay }()
+	// 每天 8 点发送每日摘要，每周一 8 点发送每周摘要
+	digest.Hour, digest.WeeklyDay = 8, int(time.Monday)
+
+	loc := time.FixedZone("UTC+8", 8*3600)
+	at := func(day, hour, minute int) time.Time {
+		// 2025-05-05 为周一
+		return time.Date(2025, 5, day, hour, minute, 0, 0, loc)
+	}
+
+	tests := []struct {
+		name      string
+		frequency string
+		now       time.Time
+		want      time.Time
+	}{
+		{"每日：发送时刻之后", schema.DigestFrequencyDaily, at(7, 9, 0), at(7, 8, 0)},
+		{"每日：恰好为发送时刻", schema.DigestFrequencyDaily, at(7, 8, 0), at(7, 8, 0)},
+		{"每日：发送时刻之前取前一天", schema.DigestFrequencyDaily, at(7, 7, 59), at(6, 8, 0)},
+		{"每日：跨月", schema.DigestFrequencyDaily, at(1, 0, 30), time.Date(2025, 4, 30, 8, 0, 0, 0, loc)},
+		{"每周：发送日发送时刻之后", schema.DigestFrequencyWeekly, at(5, 10, 0), at(5, 8, 0)},
+		{"每周：发送日发送时刻之前取上周", schema.DigestFrequencyWeekly, at(5, 7, 0), time.Date(2025, 4, 28, 8, 0, 0, 0, loc)},
+		{"每周：周中", schema.DigestFrequencyWeekly, at(8, 12, 0), at(5, 8, 0)},
+		{"每周：周日", schema.DigestFrequencyWeekly, at(11, 23, 0), at(5, 8, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := digestPeriodStart(tt.frequency, tt.now); !got.Equal(tt.want) {
+				t.Errorf("digestPeriodStart(%s, %v) = %v, want %v", tt.frequency, tt.now, got, tt.want)
+			}
+		})
+	}
+
+	// 每周摘要在周日发送时，周六属于上周日开始的周期
+	digest.WeeklyDay = int(time.Sunday)
+	if got, want := digestPeriodStart(schema.DigestFrequencyWeekly, at(10, 12, 0)), at(4, 8, 0); !got.Equal(want) {
+		t.Errorf("digestPeriodStart(weekly, Saturday) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,156 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// GetMailOutboxDB 获取发件箱数据库实例
+func GetMailOutboxDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.MailOutbox{})
+}
+
+// GetDigestSubscriptionDB 获取摘要邮件设置数据库实例
+func GetDigestSubscriptionDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.DigestSubscription{})
+}
+
+// MailRepository 邮件数据访问层
+type MailRepository struct {
+	DB *gorm.DB
+}
+
+// Enqueue 将邮件写入发件箱
+func (r *MailRepository) Enqueue(ctx context.Context, mail *schema.MailOutbox) error {
+	result := GetMailOutboxDB(ctx, r.DB).Create(mail)
+	return errors.WithStack(result.Error)
+}
+
+// ClaimDue 领取到期的邮件，领取后邮件在 lease 时间内不会被其他实例重复领取
+func (r *MailRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]schema.MailOutbox, error) {
+	var items []schema.MailOutbox
+	now := time.Now()
+	err := GetMailOutboxDB(ctx, r.DB).
+		Where("status = ? AND next_attempt_at <= ?", schema.MailStatusPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return outbox.Claim(func() *gorm.DB { return GetMailOutboxDB(ctx, r.DB) }, items, "next_attempt_at", now.Add(lease),
+		func(item *schema.MailOutbox) (uint, *time.Time) { return item.ID, &item.NextAttemptAt })
+}
+
+// Update 更新邮件的发送状态
+func (r *MailRepository) Update(ctx context.Context, mail *schema.MailOutbox) error {
+	result := GetMailOutboxDB(ctx, r.DB).Where("id = ?", mail.ID).Updates(map[string]interface{}{
+		"status":          mail.Status,
+		"attempts":        mail.Attempts,
+		"next_attempt_at": mail.NextAttemptAt,
+		"last_error":      mail.LastError,
+		"sent_at":         mail.SentAt,
+	})
+	return errors.WithStack(result.Error)
+}
+
+// GetOutbox 分页获取发件箱中的邮件，按创建时间倒序
+func (r *MailRepository) GetOutbox(ctx context.Context, params *schema.OutboxQueryParams) (*schema.OutboxPaginationResult, error) {
+	var result schema.OutboxPaginationResult
+
+	// 默认值
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+
+	db := GetMailOutboxDB(ctx, r.DB)
+	if params.Status != "" {
+		db = db.Where("status = ?", params.Status)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]schema.MailOutbox, 0)
+	if total > 0 {
+		offset := (params.Page - 1) * params.PageSize
+		err := db.Omit("text_body", "html_body").Order("id DESC").Offset(offset).Limit(params.PageSize).Find(&items).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = params.Page
+	result.PageSize = params.PageSize
+	result.TotalPages = int((total + int64(params.PageSize) - 1) / int64(params.PageSize))
+	return &result, nil
+}
+
+// GetSubscription 获取用户的摘要邮件设置
+func (r *MailRepository) GetSubscription(ctx context.Context, userID uint) (*schema.DigestSubscription, error) {
+	var subscription schema.DigestSubscription
+	err := GetDigestSubscriptionDB(ctx, r.DB).Where("user_id = ?", userID).First(&subscription).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("摘要邮件设置不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &subscription, nil
+}
+
+// SaveSubscription 保存用户的摘要邮件设置
+func (r *MailRepository) SaveSubscription(ctx context.Context, subscription *schema.DigestSubscription) error {
+	result := GetDigestSubscriptionDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"frequency", "locale", "last_sent_at", "updated_at"}),
+	}).Create(subscription)
+	return errors.WithStack(result.Error)
+}
+
+// GetDueSubscriptions 获取指定频率下本周期内尚未发送摘要的设置
+func (r *MailRepository) GetDueSubscriptions(ctx context.Context, frequency string, periodStart time.Time, limit int) ([]schema.DigestSubscription, error) {
+	var subscriptions []schema.DigestSubscription
+	err := GetDigestSubscriptionDB(ctx, r.DB).
+		Where("frequency = ? AND (last_sent_at IS NULL OR last_sent_at < ?)", frequency, periodStart).
+		Order("id ASC").
+		Limit(limit).
+		Find(&subscriptions).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return subscriptions, nil
+}
+
+// ClaimSubscription 将摘要设置标记为本周期已发送，返回是否标记成功
+// 以原发送时间为条件，避免多个实例重复发送同一用户的摘要
+func (r *MailRepository) ClaimSubscription(ctx context.Context, subscription *schema.DigestSubscription, sentAt time.Time) (bool, error) {
+	db := GetDigestSubscriptionDB(ctx, r.DB).Where("id = ?", subscription.ID)
+	if subscription.LastSentAt == nil {
+		db = db.Where("last_sent_at IS NULL")
+	} else {
+		db = db.Where("last_sent_at = ?", *subscription.LastSentAt)
+	}
+
+	result := db.UpdateColumn("last_sent_at", sentAt)
+	if result.Error != nil {
+		return false, errors.WithStack(result.Error)
+	}
+	return result.RowsAffected == 1, nil
+}
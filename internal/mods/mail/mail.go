@@ -0,0 +1,67 @@
+package mail
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/api"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail/schema"
+)
+
+// Mail 邮件模块
+type Mail struct {
+	DB          *gorm.DB
+	MailService *biz.MailService
+	MailHandler *api.MailHandler
+}
+
+// Set 注入邮件模块
+var Set = wire.NewSet(
+	wire.Struct(new(Mail), "*"),
+
+	// 邮件相关结构体
+	wire.Struct(new(api.MailHandler), "*"),
+	wire.Struct(new(biz.MailService), "*"),
+	wire.Struct(new(dal.MailRepository), "*"),
+)
+
+// AutoMigrate 自动迁移数据库
+func (m *Mail) AutoMigrate(ctx context.Context) error {
+	return m.DB.AutoMigrate(
+		&schema.MailOutbox{},
+		&schema.DigestSubscription{},
+	)
+}
+
+// Init 初始化邮件模块
+func (m *Mail) Init(ctx context.Context) error {
+	if config.C.Storage.DB.AutoMigrate {
+		if err := m.AutoMigrate(ctx); err != nil {
+			return err
+		}
+	}
+
+	// 启动邮件发送与摘要的后台任务
+	m.MailService.Start(ctx)
+	return nil
+}
+
+// RegisterRouters 注册路由
+func (m *Mail) RegisterRouters(ctx context.Context, mail *gin.RouterGroup) error {
+	mail.GET("/digest", m.MailHandler.GetDigestSetting)
+	mail.PUT("/digest", m.MailHandler.UpdateDigestSetting)
+	mail.GET("/outbox", m.MailHandler.GetOutbox)
+	mail.POST("/test", m.MailHandler.SendTestMail)
+	return nil
+}
+
+// Release 释放资源
+func (m *Mail) Release(ctx context.Context) error {
+	return m.MailService.Release(ctx)
+}
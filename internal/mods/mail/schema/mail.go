@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 邮件状态常量
+const (
+	MailStatusPending = "pending" // 等待发送（包括等待重试）
+	MailStatusSent    = "sent"    // 已发送
+	MailStatusFailed  = "failed"  // 多次尝试后仍发送失败
+)
+
+// 邮件模板常量
+const (
+	TemplateDigest        = "digest"         // 通知摘要
+	TemplateTest          = "test"           // 测试邮件
+	TemplateCommentVerify = "comment_verify" // 访客评论邮箱验证
+	TemplateCommentClaim  = "comment_claim"  // 认领访客评论
+)
+
+// 摘要频率常量
+const (
+	DigestFrequencyNone   = "none"   // 不发送
+	DigestFrequencyDaily  = "daily"  // 每日
+	DigestFrequencyWeekly = "weekly" // 每周
+)
+
+// MailOutbox 发件箱，邮件在入队时渲染，由后台任务异步发送
+type MailOutbox struct {
+	ID            uint       `json:"id" gorm:"primaryKey"`
+	To            string     `json:"to" gorm:"column:recipient;size:255;not null;comment:收件人地址"`
+	Template      string     `json:"template" gorm:"size:50;not null;comment:邮件模板"`
+	Locale        string     `json:"locale" gorm:"size:20;comment:邮件语言"`
+	Subject       string     `json:"subject" gorm:"size:255;not null;comment:邮件主题"`
+	TextBody      string     `json:"-" gorm:"type:text;comment:纯文本正文"`
+	HTMLBody      string     `json:"-" gorm:"type:mediumtext;comment:HTML 正文"`
+	Status        string     `json:"status" gorm:"size:20;not null;index:idx_status_next;comment:发送状态"`
+	Attempts      int        `json:"attempts" gorm:"not null;default:0;comment:已尝试次数"`
+	NextAttemptAt time.Time  `json:"next_attempt_at" gorm:"index:idx_status_next;comment:下次尝试时间"`
+	LastError     string     `json:"last_error" gorm:"size:500;comment:最近一次失败原因"`
+	SentAt        *time.Time `json:"sent_at" gorm:"comment:发送时间"`
+	CreatedAt     time.Time  `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt     time.Time  `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *MailOutbox) TableName() string {
+	return config.C.FormatTableName("mail_outbox")
+}
+
+// DigestSubscription 用户的通知摘要邮件设置，没有记录的用户不接收摘要
+type DigestSubscription struct {
+	ID         uint       `json:"id" gorm:"primaryKey"`
+	UserID     uint       `json:"user_id" gorm:"not null;uniqueIndex;comment:用户ID"`
+	Frequency  string     `json:"frequency" gorm:"size:20;not null;index;comment:摘要频率"`
+	Locale     string     `json:"locale" gorm:"size:20;comment:邮件语言"`
+	LastSentAt *time.Time `json:"last_sent_at" gorm:"comment:最近一次发送摘要的时间"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt  time.Time  `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *DigestSubscription) TableName() string {
+	return config.C.FormatTableName("mail_digest_subscription")
+}
+
+// DigestSettingResponse 摘要邮件设置
+type DigestSettingResponse struct {
+	Frequency  string     `json:"frequency"`    // 摘要频率
+	Locale     string     `json:"locale"`       // 邮件语言
+	LastSentAt *time.Time `json:"last_sent_at"` // 最近一次发送摘要的时间
+}
+
+// UpdateDigestRequest 更新摘要邮件设置请求
+type UpdateDigestRequest struct {
+	Frequency string `json:"frequency" binding:"required,oneof=none daily weekly"` // 摘要频率
+	Locale    string `json:"locale" binding:"omitempty,max=20"`                    // 邮件语言，为空时使用默认语言
+}
+
+// OutboxQueryParams 发件箱查询请求
+type OutboxQueryParams struct {
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Status   string `form:"status" binding:"omitempty,oneof=pending sent failed"`
+}
+
+// OutboxPaginationResult 发件箱分页结果
+type OutboxPaginationResult struct {
+	Items      []MailOutbox `json:"items"`
+	Total      int64        `json:"total"`
+	Page       int          `json:"page"`
+	PageSize   int          `json:"page_size"`
+	TotalPages int          `json:"total_pages"`
+}
+
+// SendTestMailRequest 发送测试邮件请求
+type SendTestMailRequest struct {
+	To     string `json:"to" binding:"required,email"`       // 收件人地址
+	Locale string `json:"locale" binding:"omitempty,max=20"` // 邮件语言，为空时使用默认语言
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// MentionHandler 提及API处理器
+type MentionHandler struct {
+	MentionService *biz.MentionService
+}
+
+// @Tags MentionAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户被提及的记录
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "每页容量" minimum(1) maximum(100) default(10)
+// @Param source_type query string false "来源类型" Enums(article, comment)
+// @Success 200 {object} util.ResponseResult{data=schema.MentionPaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mention/mine [get]
+func (h *MentionHandler) GetMyMentions(c *gin.Context) {
+	var params schema.MentionQueryParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.MentionService.GetMyMentions(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags MentionAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户的提及隐私设置
+// @Success 200 {object} util.ResponseResult{data=schema.MentionSettingResponse}
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mention/settings [get]
+func (h *MentionHandler) GetSetting(c *gin.Context) {
+	ctx := c.Request.Context()
+	data, err := h.MentionService.GetSetting(ctx)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags MentionAPI
+// @Security ApiKeyAuth
+// @Summary 更新当前用户的提及隐私策略（everyone：所有人可提及，nobody：不允许提及）
+// @Param body body schema.UpdateMentionSettingRequest true "提及隐私策略"
+// @Success 200 {object} util.ResponseResult{data=schema.MentionSettingResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mention/settings [put]
+func (h *MentionHandler) UpdateSetting(c *gin.Context) {
+	var req schema.UpdateMentionSettingRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.MentionService.UpdateSetting(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags MentionAPI
+// @Security ApiKeyAuth
+// @Summary 屏蔽指定用户对当前用户的提及
+// @Param body body schema.BlockMentionRequest true "被屏蔽的用户名"
+// @Success 200 {object} util.ResponseResult{data=schema.MentionSettingResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mention/blocks [post]
+func (h *MentionHandler) BlockUser(c *gin.Context) {
+	var req schema.BlockMentionRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.MentionService.BlockUser(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags MentionAPI
+// @Security ApiKeyAuth
+// @Summary 取消屏蔽指定用户
+// @Param user_id path uint true "被屏蔽的用户ID" minimum(1)
+// @Success 200 {object} util.ResponseResult{data=schema.MentionSettingResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/mention/blocks/{user_id} [delete]
+func (h *MentionHandler) UnblockUser(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("user_id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的用户ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.MentionService.UnblockUser(ctx, uint(id))
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
@@ -0,0 +1,247 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
+	notificationBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	notificationSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/mention"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// MentionService 提及业务逻辑层
+type MentionService struct {
+	MentionRepository   *dal.MentionRepository
+	UserRepository      *userDal.UserRepository
+	NotificationService *notificationBiz.NotificationService
+}
+
+// Source 包含提及的文章或评论
+type Source struct {
+	Type        string // 来源类型
+	ID          uint   // 文章ID或评论ID
+	ArticleID   uint   // 所属文章ID
+	MentionerID uint   // 作者ID
+	Content     string // 正文
+	Visible     bool   // 是否已公开（文章已发布或评论已审核通过），公开后才通知被提及用户
+}
+
+// SyncMentions 解析来源正文中的 @用户名 并同步提及记录，来源已公开时通知新被提及的用户
+// 提及失败不影响文章或评论的保存，仅记录日志
+func (s *MentionService) SyncMentions(ctx context.Context, source Source) {
+	if source.MentionerID == 0 {
+		return
+	}
+
+	mentions, err := s.resolve(ctx, source)
+	if err == nil {
+		err = s.MentionRepository.SyncSource(ctx, source.Type, source.ID, mentions)
+	}
+	if err != nil {
+		logging.Context(ctx).Error("同步提及记录失败", zap.Error(err),
+			zap.String("source_type", source.Type), zap.Uint("source_id", source.ID))
+		return
+	}
+
+	if source.Visible {
+		s.notify(ctx, source)
+	}
+}
+
+// resolve 解析正文中的提及，排除作者本人、不允许被提及以及屏蔽了作者的用户
+func (s *MentionService) resolve(ctx context.Context, source Source) ([]schema.Mention, error) {
+	runs := mention.Scan(source.Content)
+	if len(runs) == 0 {
+		return nil, nil
+	}
+
+	// 用户名可能包含汉字，需查询候选片段的所有前缀才能确定用户名的边界
+	seen := make(map[string]bool)
+	var candidates []string
+	for _, run := range runs {
+		for _, prefix := range mention.Prefixes(run) {
+			if key := strings.ToLower(prefix); !seen[key] {
+				seen[key] = true
+				candidates = append(candidates, prefix)
+			}
+		}
+	}
+	users, err := s.UserRepository.GetByUsernames(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make(map[string]uint, len(users))
+	names := make(map[string]string, len(users))
+	for _, user := range users {
+		ids[user.Username] = user.ID
+		names[strings.ToLower(user.Username)] = user.Username
+	}
+	usernames := mention.Resolve(runs, func(name string) (string, bool) {
+		username, ok := names[strings.ToLower(name)]
+		return username, ok
+	})
+
+	var userIDs []uint
+	for _, username := range usernames {
+		if id := ids[username]; id != source.MentionerID {
+			userIDs = append(userIDs, id)
+		}
+	}
+	if limit := config.C.Mention.MaxPerContent; limit > 0 && len(userIDs) > limit {
+		userIDs = userIDs[:limit]
+	}
+
+	// 隐私设置
+	policies, err := s.MentionRepository.GetPolicies(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+	blockers, err := s.MentionRepository.GetBlockers(ctx, source.MentionerID, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	mentions := make([]schema.Mention, 0, len(userIDs))
+	for _, id := range userIDs {
+		if policies[id] == schema.MentionPolicyNobody || blockers[id] {
+			continue
+		}
+		mentions = append(mentions, schema.Mention{
+			SourceType:  source.Type,
+			SourceID:    source.ID,
+			UserID:      id,
+			ArticleID:   source.ArticleID,
+			MentionerID: source.MentionerID,
+		})
+	}
+	return mentions, nil
+}
+
+// notify 通知来源中尚未通知的被提及用户，每个用户在同一来源中只通知一次
+func (s *MentionService) notify(ctx context.Context, source Source) {
+	mentions, err := s.MentionRepository.ListUnnotified(ctx, source.Type, source.ID)
+	if err != nil {
+		logging.Context(ctx).Error("获取待通知的提及失败", zap.Error(err),
+			zap.String("source_type", source.Type), zap.Uint("source_id", source.ID))
+		return
+	}
+	if len(mentions) == 0 {
+		return
+	}
+
+	event := notificationBiz.Event{
+		Type:       notificationSchema.NotificationTypeMention,
+		ActorID:    source.MentionerID,
+		TargetType: notificationSchema.NotificationTargetArticle,
+		TargetID:   source.ID,
+		ArticleID:  source.ArticleID,
+	}
+	if source.Type == schema.MentionSourceComment {
+		event.TargetType = notificationSchema.NotificationTargetComment
+		event.CommentID = source.ID
+		event.Content = source.Content
+	}
+
+	ids := make([]uint, 0, len(mentions))
+	for _, item := range mentions {
+		ids = append(ids, item.ID)
+		event.UserID = item.UserID
+		s.NotificationService.Notify(ctx, event)
+	}
+	if err := s.MentionRepository.MarkNotified(ctx, ids, time.Now()); err != nil {
+		logging.Context(ctx).Error("标记提及已通知失败", zap.Error(err),
+			zap.String("source_type", source.Type), zap.Uint("source_id", source.ID))
+	}
+}
+
+// DeleteSourceMentions 删除来源中的所有提及
+func (s *MentionService) DeleteSourceMentions(ctx context.Context, sourceType string, sourceID uint) error {
+	return s.MentionRepository.DeleteBySource(ctx, sourceType, sourceID)
+}
+
+// DeleteArticleMentions 删除文章正文及其评论中的所有提及
+func (s *MentionService) DeleteArticleMentions(ctx context.Context, articleID uint) error {
+	return s.MentionRepository.DeleteByArticleID(ctx, articleID)
+}
+
+// GetMentionedUsers 批量获取来源中被提及的用户，用于在响应中渲染提及链接，失败时仅记录日志
+func (s *MentionService) GetMentionedUsers(ctx context.Context, sourceType string, sourceIDs []uint) map[uint][]schema.MentionedUser {
+	result, err := s.MentionRepository.GetMentionedUsers(ctx, sourceType, sourceIDs)
+	if err != nil {
+		logging.Context(ctx).Error("获取被提及的用户失败", zap.Error(err), zap.String("source_type", sourceType))
+		return nil
+	}
+	return result
+}
+
+// GetMyMentions 获取当前用户被提及的记录
+func (s *MentionService) GetMyMentions(ctx context.Context, params *schema.MentionQueryParams) (*schema.MentionPaginationResult, error) {
+	return s.MentionRepository.GetUserMentions(ctx, util.FromUserID(ctx), params)
+}
+
+// GetSetting 获取当前用户的提及隐私设置
+func (s *MentionService) GetSetting(ctx context.Context) (*schema.MentionSettingResponse, error) {
+	userID := util.FromUserID(ctx)
+	policies, err := s.MentionRepository.GetPolicies(ctx, []uint{userID})
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := s.MentionRepository.GetBlockedUsers(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, ok := policies[userID]
+	if !ok {
+		policy = schema.MentionPolicyEveryone
+	}
+	return &schema.MentionSettingResponse{Policy: policy, Blocked: blocked}, nil
+}
+
+// UpdateSetting 更新当前用户的提及隐私策略
+func (s *MentionService) UpdateSetting(ctx context.Context, req *schema.UpdateMentionSettingRequest) (*schema.MentionSettingResponse, error) {
+	setting := &schema.MentionSetting{
+		UserID: util.FromUserID(ctx),
+		Policy: req.Policy,
+	}
+	if err := s.MentionRepository.SaveSetting(ctx, setting); err != nil {
+		return nil, err
+	}
+	return s.GetSetting(ctx)
+}
+
+// BlockUser 屏蔽指定用户对当前用户的提及
+func (s *MentionService) BlockUser(ctx context.Context, req *schema.BlockMentionRequest) (*schema.MentionSettingResponse, error) {
+	userID := util.FromUserID(ctx)
+	blocked, err := s.UserRepository.GetByUsername(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	if blocked.ID == userID {
+		return nil, errors.BadRequest("不能屏蔽自己")
+	}
+
+	if err := s.MentionRepository.CreateBlock(ctx, &schema.MentionBlock{UserID: userID, BlockedID: blocked.ID}); err != nil {
+		return nil, err
+	}
+	return s.GetSetting(ctx)
+}
+
+// UnblockUser 取消屏蔽指定用户
+func (s *MentionService) UnblockUser(ctx context.Context, blockedID uint) (*schema.MentionSettingResponse, error) {
+	if err := s.MentionRepository.DeleteBlock(ctx, util.FromUserID(ctx), blockedID); err != nil {
+		return nil, err
+	}
+	return s.GetSetting(ctx)
+}
@@ -0,0 +1,252 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	authSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/schema"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// GetMentionDB 获取提及记录数据库实例
+func GetMentionDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.Mention{})
+}
+
+// GetMentionSettingDB 获取提及设置数据库实例
+func GetMentionSettingDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.MentionSetting{})
+}
+
+// GetMentionBlockDB 获取提及屏蔽列表数据库实例
+func GetMentionBlockDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.MentionBlock{})
+}
+
+// MentionRepository 提及数据访问层
+type MentionRepository struct {
+	DB *gorm.DB
+}
+
+// SyncSource 同步来源中的提及：已从正文中移除的提及被删除，新增的提及等待通知，已有提及保留通知状态
+func (r *MentionRepository) SyncSource(ctx context.Context, sourceType string, sourceID uint, mentions []schema.Mention) error {
+	userIDs := make([]uint, 0, len(mentions))
+	for _, mention := range mentions {
+		userIDs = append(userIDs, mention.UserID)
+	}
+
+	db := GetMentionDB(ctx, r.DB).Where("source_type = ? AND source_id = ?", sourceType, sourceID)
+	if len(userIDs) > 0 {
+		db = db.Where("user_id NOT IN ?", userIDs)
+	}
+	if err := db.Delete(&schema.Mention{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(mentions) == 0 {
+		return nil
+	}
+	result := GetMentionDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(&mentions)
+	return errors.WithStack(result.Error)
+}
+
+// ListUnnotified 获取来源中尚未通知的提及
+func (r *MentionRepository) ListUnnotified(ctx context.Context, sourceType string, sourceID uint) ([]schema.Mention, error) {
+	var mentions []schema.Mention
+	err := GetMentionDB(ctx, r.DB).
+		Where("source_type = ? AND source_id = ? AND notified_at IS NULL", sourceType, sourceID).
+		Find(&mentions).Error
+	return mentions, errors.WithStack(err)
+}
+
+// MarkNotified 标记提及已通知
+func (r *MentionRepository) MarkNotified(ctx context.Context, ids []uint, notifiedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := GetMentionDB(ctx, r.DB).Where("id IN ?", ids).UpdateColumn("notified_at", notifiedAt)
+	return errors.WithStack(result.Error)
+}
+
+// DeleteBySource 删除来源中的所有提及
+func (r *MentionRepository) DeleteBySource(ctx context.Context, sourceType string, sourceID uint) error {
+	result := GetMentionDB(ctx, r.DB).Where("source_type = ? AND source_id = ?", sourceType, sourceID).Delete(&schema.Mention{})
+	return errors.WithStack(result.Error)
+}
+
+// DeleteByArticleID 删除文章正文及其评论中的所有提及
+func (r *MentionRepository) DeleteByArticleID(ctx context.Context, articleID uint) error {
+	result := GetMentionDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.Mention{})
+	return errors.WithStack(result.Error)
+}
+
+// GetMentionedUsers 批量获取来源中被提及的用户，按来源ID分组
+func (r *MentionRepository) GetMentionedUsers(ctx context.Context, sourceType string, sourceIDs []uint) (map[uint][]schema.MentionedUser, error) {
+	result := make(map[uint][]schema.MentionedUser)
+	if len(sourceIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []struct {
+		SourceID uint
+		UserID   uint
+		Username string
+	}
+	userTable := new(authSchema.User).TableName()
+	err := util.GetDB(ctx, r.DB).Table(new(schema.Mention).TableName()+" AS m").
+		Joins("JOIN "+userTable+" AS u ON u.id = m.user_id").
+		Select("m.source_id, m.user_id, u.username").
+		Where("m.source_type = ? AND m.source_id IN ?", sourceType, sourceIDs).
+		Order("m.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, row := range rows {
+		result[row.SourceID] = append(result[row.SourceID], schema.MentionedUser{
+			ID:       row.UserID,
+			Username: row.Username,
+			URL:      schema.ProfileURL(row.Username),
+		})
+	}
+	return result, nil
+}
+
+// GetUserMentions 分页获取用户被提及的记录，仅包含已通知（即来源已公开）的提及
+func (r *MentionRepository) GetUserMentions(ctx context.Context, userID uint, params *schema.MentionQueryParams) (*schema.MentionPaginationResult, error) {
+	var result schema.MentionPaginationResult
+
+	// 默认值
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+
+	userTable := new(authSchema.User).TableName()
+	db := util.GetDB(ctx, r.DB).Table(new(schema.Mention).TableName()+" AS m").
+		Joins("LEFT JOIN "+userTable+" AS u ON u.id = m.mentioner_id").
+		Where("m.user_id = ? AND m.notified_at IS NOT NULL", userID)
+	if params.SourceType != "" {
+		db = db.Where("m.source_type = ?", params.SourceType)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	items := make([]schema.MentionResponse, 0)
+	if total > 0 {
+		offset := (params.Page - 1) * params.PageSize
+		err := db.Select("m.id, m.source_type, m.source_id, m.article_id, m.mentioner_id, u.username AS mentioner, m.created_at").
+			Order("m.created_at DESC, m.id DESC").
+			Offset(offset).Limit(params.PageSize).
+			Scan(&items).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = params.Page
+	result.PageSize = params.PageSize
+	result.TotalPages = int((total + int64(params.PageSize) - 1) / int64(params.PageSize))
+	return &result, nil
+}
+
+// GetPolicies 批量获取用户的提及隐私策略，未设置的用户不在结果中
+func (r *MentionRepository) GetPolicies(ctx context.Context, userIDs []uint) (map[uint]string, error) {
+	result := make(map[uint]string)
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	var settings []schema.MentionSetting
+	if err := GetMentionSettingDB(ctx, r.DB).Where("user_id IN ?", userIDs).Find(&settings).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, setting := range settings {
+		result[setting.UserID] = setting.Policy
+	}
+	return result, nil
+}
+
+// SaveSetting 保存用户的提及隐私设置
+func (r *MentionRepository) SaveSetting(ctx context.Context, setting *schema.MentionSetting) error {
+	result := GetMentionSettingDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"policy", "updated_at"}),
+	}).Create(setting)
+	return errors.WithStack(result.Error)
+}
+
+// GetBlockers 获取 userIDs 中屏蔽了 mentionerID 的用户
+func (r *MentionRepository) GetBlockers(ctx context.Context, mentionerID uint, userIDs []uint) (map[uint]bool, error) {
+	result := make(map[uint]bool)
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	var blockers []uint
+	err := GetMentionBlockDB(ctx, r.DB).
+		Where("blocked_id = ? AND user_id IN ?", mentionerID, userIDs).
+		Pluck("user_id", &blockers).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, id := range blockers {
+		result[id] = true
+	}
+	return result, nil
+}
+
+// CreateBlock 屏蔽用户提及，已屏蔽时忽略
+func (r *MentionRepository) CreateBlock(ctx context.Context, block *schema.MentionBlock) error {
+	result := GetMentionBlockDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(block)
+	return errors.WithStack(result.Error)
+}
+
+// DeleteBlock 取消屏蔽用户提及
+func (r *MentionRepository) DeleteBlock(ctx context.Context, userID, blockedID uint) error {
+	result := GetMentionBlockDB(ctx, r.DB).Where("user_id = ? AND blocked_id = ?", userID, blockedID).Delete(&schema.MentionBlock{})
+	if result.Error != nil {
+		return errors.WithStack(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errors.NotFound("未屏蔽该用户")
+	}
+	return nil
+}
+
+// GetBlockedUsers 获取用户屏蔽的所有用户
+func (r *MentionRepository) GetBlockedUsers(ctx context.Context, userID uint) ([]schema.MentionedUser, error) {
+	var rows []struct {
+		ID       uint
+		Username string
+	}
+	userTable := new(authSchema.User).TableName()
+	err := util.GetDB(ctx, r.DB).Table(new(schema.MentionBlock).TableName()+" AS b").
+		Joins("JOIN "+userTable+" AS u ON u.id = b.blocked_id").
+		Select("u.id, u.username").
+		Where("b.user_id = ?", userID).
+		Order("b.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	users := make([]schema.MentionedUser, 0, len(rows))
+	for _, row := range rows {
+		users = append(users, schema.MentionedUser{ID: row.ID, Username: row.Username, URL: schema.ProfileURL(row.Username)})
+	}
+	return users, nil
+}
@@ -0,0 +1,65 @@
+package mention
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/api"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention/schema"
+)
+
+// Mention 提及模块
+type Mention struct {
+	DB             *gorm.DB
+	MentionHandler *api.MentionHandler
+}
+
+// Set 注入提及模块
+var Set = wire.NewSet(
+	wire.Struct(new(Mention), "*"),
+
+	// 提及相关结构体
+	wire.Struct(new(api.MentionHandler), "*"),
+	wire.Struct(new(biz.MentionService), "*"),
+	wire.Struct(new(dal.MentionRepository), "*"),
+)
+
+// AutoMigrate 自动迁移数据库
+func (m *Mention) AutoMigrate(ctx context.Context) error {
+	return m.DB.AutoMigrate(
+		&schema.Mention{},
+		&schema.MentionSetting{},
+		&schema.MentionBlock{},
+	)
+}
+
+// Init 初始化提及模块
+func (m *Mention) Init(ctx context.Context) error {
+	if config.C.Storage.DB.AutoMigrate {
+		if err := m.AutoMigrate(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterRouters 注册路由
+func (m *Mention) RegisterRouters(ctx context.Context, mention *gin.RouterGroup) error {
+	mention.GET("/mine", m.MentionHandler.GetMyMentions)
+	mention.GET("/settings", m.MentionHandler.GetSetting)
+	mention.PUT("/settings", m.MentionHandler.UpdateSetting)
+	mention.POST("/blocks", m.MentionHandler.BlockUser)
+	mention.DELETE("/blocks/:user_id", m.MentionHandler.UnblockUser)
+	return nil
+}
+
+// Release 释放资源
+func (m *Mention) Release(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 提及来源类型常量
+const (
+	MentionSourceArticle = "article" // 文章正文
+	MentionSourceComment = "comment" // 评论
+)
+
+// 提及隐私策略常量
+const (
+	MentionPolicyEveryone = "everyone" // 所有用户都可以提及（屏蔽列表中的用户除外）
+	MentionPolicyNobody   = "nobody"   // 不允许任何人提及
+)
+
+// Mention 提及记录，每条文章或评论中的每个被提及用户对应一条记录
+type Mention struct {
+	ID          uint       `json:"id" gorm:"primaryKey"`
+	SourceType  string     `json:"source_type" gorm:"size:20;not null;uniqueIndex:idx_source_user;comment:来源类型"`
+	SourceID    uint       `json:"source_id" gorm:"not null;uniqueIndex:idx_source_user;comment:来源ID（文章ID或评论ID）"`
+	UserID      uint       `json:"user_id" gorm:"not null;uniqueIndex:idx_source_user;index:idx_user_created;comment:被提及的用户ID"`
+	ArticleID   uint       `json:"article_id" gorm:"not null;index;comment:所属文章ID"`
+	MentionerID uint       `json:"mentioner_id" gorm:"not null;comment:发起提及的用户ID"`
+	NotifiedAt  *time.Time `json:"notified_at" gorm:"comment:通知被提及用户的时间，来源公开后才通知"`
+	CreatedAt   time.Time  `json:"created_at" gorm:"index:idx_user_created;comment:创建时间"`
+}
+
+// TableName 表名
+func (a *Mention) TableName() string {
+	return config.C.FormatTableName("mention")
+}
+
+// MentionSetting 用户的提及隐私设置，没有记录时视为所有人可提及
+type MentionSetting struct {
+	UserID    uint      `json:"user_id" gorm:"primaryKey;autoIncrement:false;comment:用户ID"`
+	Policy    string    `json:"policy" gorm:"size:20;not null;default:everyone;comment:允许谁提及"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *MentionSetting) TableName() string {
+	return config.C.FormatTableName("mention_setting")
+}
+
+// MentionBlock 提及屏蔽列表，被屏蔽的用户无法提及该用户
+type MentionBlock struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_user_blocked;comment:用户ID"`
+	BlockedID uint      `json:"blocked_id" gorm:"not null;uniqueIndex:idx_user_blocked;comment:被屏蔽的用户ID"`
+	CreatedAt time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *MentionBlock) TableName() string {
+	return config.C.FormatTableName("mention_block")
+}
+
+// ProfileURL 用户主页地址
+func ProfileURL(username string) string {
+	return config.C.Mention.ProfilePath + url.PathEscape(username)
+}
+
+// MentionedUser 文章或评论中被提及的用户，前端据此将正文中的 @用户名 渲染为主页链接
+type MentionedUser struct {
+	ID       uint   `json:"id"`
+	Username string `json:"username"`
+	URL      string `json:"url"`
+}
+
+// MentionQueryParams 提及列表查询请求
+type MentionQueryParams struct {
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	PageSize   int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	SourceType string `form:"source_type" binding:"omitempty,oneof=article comment"`
+}
+
+// MentionResponse 提及响应
+type MentionResponse struct {
+	ID          uint      `json:"id"`
+	SourceType  string    `json:"source_type"`
+	SourceID    uint      `json:"source_id"`
+	ArticleID   uint      `json:"article_id"`
+	MentionerID uint      `json:"mentioner_id"`
+	Mentioner   string    `json:"mentioner"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// MentionPaginationResult 提及分页结果
+type MentionPaginationResult struct {
+	Items      []MentionResponse `json:"items"`
+	Total      int64             `json:"total"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"page_size"`
+	TotalPages int               `json:"total_pages"`
+}
+
+// UpdateMentionSettingRequest 更新提及隐私设置请求
+type UpdateMentionSettingRequest struct {
+	Policy string `json:"policy" binding:"required,oneof=everyone nobody"`
+}
+
+// BlockMentionRequest 屏蔽用户提及请求
+type BlockMentionRequest struct {
+	Username string `json:"username" binding:"required"`
+}
+
+// MentionSettingResponse 提及隐私设置响应
+type MentionSettingResponse struct {
+	Policy  string          `json:"policy"`
+	Blocked []MentionedUser `json:"blocked"`
+}
@@ -6,44 +6,86 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/wire"
 
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/ai"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/auth"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention"
 )
 
 // API 路由前缀常量
 const (
-	apiPrefix = "/api/"
+	apiPrefix       = "/api/"
+	wellKnownPrefix = "/.well-known/"
 )
 
 // Mods 所有模块的集合
 type Mods struct {
-	Auth    *auth.Auth
-	Blog    *blog.Blog
-	Comment *comment.Comment
-	Stat    *stat.Stat
-	AI      *ai.AI
+	Sensitive    *sensitive.Sensitive
+	Auth         *auth.Auth
+	Notification *notification.Notification
+	Mail         *mail.Mail
+	Mention      *mention.Mention
+	Blog         *blog.Blog
+	Comment      *comment.Comment
+	Webmention   *webmention.Webmention
+	ActivityPub  *activitypub.ActivityPub
+	LinkCheck    *linkcheck.LinkCheck
+	Stat         *stat.Stat
+	AI           *ai.AI
 }
 
 // Set 定义注入器集合
 var Set = wire.NewSet(
 	wire.Struct(new(Mods), "*"),
+	sensitive.Set,
 	auth.Set,
+	notification.Set,
+	mail.Set,
+	mention.Set,
 	blog.Set,
 	comment.Set,
+	webmention.Set,
+	activitypub.Set,
+	linkcheck.Set,
 	stat.Set,
 	ai.Set,
 )
 
 // Init 初始化所有模块
 func (a *Mods) Init(ctx context.Context) error {
+	// 初始化Sensitive模块（其他模块依赖敏感词过滤器）
+	if err := a.Sensitive.Init(ctx); err != nil {
+		return err
+	}
+
 	// 初始化 Auth 模块
 	if err := a.Auth.Init(ctx); err != nil {
 		return err
 	}
 
+	// 初始化Notification模块
+	if err := a.Notification.Init(ctx); err != nil {
+		return err
+	}
+
+	// 初始化Mail模块
+	if err := a.Mail.Init(ctx); err != nil {
+		return err
+	}
+
+	// 初始化Mention模块
+	if err := a.Mention.Init(ctx); err != nil {
+		return err
+	}
+
 	// 初始化Blog模块
 	if err := a.Blog.Init(ctx); err != nil {
 		return err
@@ -54,6 +96,21 @@ func (a *Mods) Init(ctx context.Context) error {
 		return err
 	}
 
+	// 初始化Webmention模块
+	if err := a.Webmention.Init(ctx); err != nil {
+		return err
+	}
+
+	// 初始化ActivityPub模块
+	if err := a.ActivityPub.Init(ctx); err != nil {
+		return err
+	}
+
+	// 初始化LinkCheck模块
+	if err := a.LinkCheck.Init(ctx); err != nil {
+		return err
+	}
+
 	// 初始化Stat模块
 	if err := a.Stat.Init(ctx); err != nil {
 		return err
@@ -69,7 +126,7 @@ func (a *Mods) Init(ctx context.Context) error {
 
 // RouterPrefixes 路由前缀列表
 func (a *Mods) RouterPrefixes() []string {
-	return []string{apiPrefix}
+	return []string{apiPrefix, wellKnownPrefix}
 }
 
 // RegisterRouters 注册路由
@@ -83,6 +140,24 @@ func (a *Mods) RegisterRouters(ctx context.Context, e *gin.Engine) error {
 		return err
 	}
 
+	// 注册Notification模块路由
+	notificationApi := gAPI.Group("notification")
+	if err := a.Notification.RegisterRouters(ctx, notificationApi); err != nil {
+		return err
+	}
+
+	// 注册Mail模块路由
+	mailApi := gAPI.Group("mail")
+	if err := a.Mail.RegisterRouters(ctx, mailApi); err != nil {
+		return err
+	}
+
+	// 注册Mention模块路由
+	mentionApi := gAPI.Group("mention")
+	if err := a.Mention.RegisterRouters(ctx, mentionApi); err != nil {
+		return err
+	}
+
 	// 注册Blog模块路由
 	blogApi := gAPI.Group("blog")
 	if err := a.Blog.RegisterRouters(ctx, blogApi); err != nil {
@@ -95,6 +170,27 @@ func (a *Mods) RegisterRouters(ctx context.Context, e *gin.Engine) error {
 		return err
 	}
 
+	// 注册Webmention模块路由
+	webmentionApi := gAPI.Group("webmention")
+	if err := a.Webmention.RegisterRouters(ctx, webmentionApi); err != nil {
+		return err
+	}
+
+	// 注册ActivityPub模块路由
+	activitypubApi := gAPI.Group("activitypub")
+	if err := a.ActivityPub.RegisterRouters(ctx, activitypubApi); err != nil {
+		return err
+	}
+	if err := a.ActivityPub.RegisterWellKnownRouters(ctx, e.Group(wellKnownPrefix)); err != nil {
+		return err
+	}
+
+	// 注册LinkCheck模块路由
+	linkcheckApi := gAPI.Group("linkcheck")
+	if err := a.LinkCheck.RegisterRouters(ctx, linkcheckApi); err != nil {
+		return err
+	}
+
 	// 注册Stat模块路由
 	statApi := gAPI.Group("stat")
 	if err := a.Stat.RegisterRouters(ctx, statApi); err != nil {
@@ -107,6 +203,12 @@ func (a *Mods) RegisterRouters(ctx context.Context, e *gin.Engine) error {
 		return err
 	}
 
+	// 注册Sensitive模块路由
+	sensitiveApi := gAPI.Group("sensitive")
+	if err := a.Sensitive.RegisterRouters(ctx, sensitiveApi); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -117,6 +219,21 @@ func (a *Mods) Release(ctx context.Context) error {
 		return err
 	}
 
+	// 释放Notification模块资源
+	if err := a.Notification.Release(ctx); err != nil {
+		return err
+	}
+
+	// 释放Mail模块资源
+	if err := a.Mail.Release(ctx); err != nil {
+		return err
+	}
+
+	// 释放Mention模块资源
+	if err := a.Mention.Release(ctx); err != nil {
+		return err
+	}
+
 	// 释放Blog模块资源
 	if err := a.Blog.Release(ctx); err != nil {
 		return err
@@ -127,6 +244,21 @@ func (a *Mods) Release(ctx context.Context) error {
 		return err
 	}
 
+	// 释放Webmention模块资源
+	if err := a.Webmention.Release(ctx); err != nil {
+		return err
+	}
+
+	// 释放ActivityPub模块资源
+	if err := a.ActivityPub.Release(ctx); err != nil {
+		return err
+	}
+
+	// 释放LinkCheck模块资源
+	if err := a.LinkCheck.Release(ctx); err != nil {
+		return err
+	}
+
 	// 释放Stat模块资源
 	if err := a.Stat.Release(ctx); err != nil {
 		return err
@@ -137,5 +269,10 @@ func (a *Mods) Release(ctx context.Context) error {
 		return err
 	}
 
+	// 释放Sensitive模块资源
+	if err := a.Sensitive.Release(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
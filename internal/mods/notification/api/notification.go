@@ -0,0 +1,162 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// NotificationHandler 通知API处理器
+type NotificationHandler struct {
+	NotificationService *biz.NotificationService
+}
+
+// @Tags NotificationAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户的通知（同一对象上的点赞、收藏与关注会合并为一条）
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "每页容量" minimum(1) maximum(100) default(10)
+// @Param type query string false "通知类型" Enums(reply, like, favorite, mention, review, follow)
+// @Param unread_only query bool false "是否只返回未读通知" default(false)
+// @Success 200 {object} util.ResponseResult{data=schema.NotificationPaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/notification [get]
+func (h *NotificationHandler) GetNotifications(c *gin.Context) {
+	var params schema.NotificationQueryParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.NotificationService.GetNotifications(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags NotificationAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户的未读通知数
+// @Success 200 {object} util.ResponseResult{data=schema.UnreadCountResponse}
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/notification/unread-count [get]
+func (h *NotificationHandler) GetUnreadCount(c *gin.Context) {
+	ctx := c.Request.Context()
+	data, err := h.NotificationService.GetUnreadCount(ctx)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags NotificationAPI
+// @Security ApiKeyAuth
+// @Summary 将通知标记为已读
+// @Param id path uint true "通知ID" minimum(1)
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/notification/{id}/read [post]
+func (h *NotificationHandler) MarkRead(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的通知ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.NotificationService.MarkRead(ctx, uint(id)); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// @Tags NotificationAPI
+// @Security ApiKeyAuth
+// @Summary 将未读通知全部标记为已读
+// @Param body body schema.MarkAllReadRequest false "通知类型，为空时标记所有类型"
+// @Success 200 {object} util.ResponseResult{data=schema.UnreadCountResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/notification/read-all [post]
+func (h *NotificationHandler) MarkAllRead(c *gin.Context) {
+	var req schema.MarkAllReadRequest
+	if c.Request.ContentLength > 0 {
+		if err := util.ParseJSON(c, &req); err != nil {
+			util.ResError(c, err)
+			return
+		}
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.NotificationService.MarkAllRead(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags NotificationAPI
+// @Security ApiKeyAuth
+// @Summary 获取当前用户的通知偏好（通知类型 -> 是否接收）
+// @Success 200 {object} util.ResponseResult{data=map[string]bool}
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/notification/preferences [get]
+func (h *NotificationHandler) GetPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	data, err := h.NotificationService.GetPreferences(ctx)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// @Tags NotificationAPI
+// @Security ApiKeyAuth
+// @Summary 更新当前用户的通知偏好，未提交的类型保持不变
+// @Param body body schema.UpdatePreferencesRequest true "通知偏好"
+// @Success 200 {object} util.ResponseResult{data=map[string]bool}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 401 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/notification/preferences [put]
+func (h *NotificationHandler) UpdatePreferences(c *gin.Context) {
+	var req schema.UpdatePreferencesRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.NotificationService.UpdatePreferences(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
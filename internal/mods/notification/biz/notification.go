@@ -0,0 +1,196 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+
+	userDal "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	blogDal "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// contentLen 通知中评论摘要或审核备注的最大字符数
+const contentLen = 200
+
+// NotificationService 通知业务逻辑层
+type NotificationService struct {
+	NotificationRepository *dal.NotificationRepository
+	UserRepository         *userDal.UserRepository
+	ArticleRepository      *blogDal.ArticleRepository
+	Trans                  util.Trans
+}
+
+// Event 通知事件
+type Event struct {
+	Type       string // 通知类型
+	UserID     uint   // 接收通知的用户ID
+	ActorID    uint   // 触发者的用户ID，站外触发者为 0
+	ActorName  string // 触发者名称，为空时根据 ActorID 查询用户名
+	TargetType string // 接收者的对象类型
+	TargetID   uint   // 接收者的对象ID
+	CommentID  uint   // 相关评论ID
+	ArticleID  uint   // 相关文章ID，通知中记录文章标题
+	Result     string // 审核结果
+	Content    string // 评论摘要或审核备注
+}
+
+// Notify 发送通知，触发者为接收者本人或接收者关闭了该类通知时忽略
+// 通知失败不影响触发通知的业务，仅记录日志
+func (s *NotificationService) Notify(ctx context.Context, event Event) {
+	if event.UserID == 0 || event.ActorID != 0 && event.ActorID == event.UserID {
+		return
+	}
+
+	if err := s.notify(ctx, &event); err != nil {
+		logging.Context(ctx).Error("发送通知失败", zap.Error(err),
+			zap.String("type", event.Type), zap.Uint("user_id", event.UserID),
+			zap.String("target_type", event.TargetType), zap.Uint("target_id", event.TargetID))
+	}
+}
+
+func (s *NotificationService) notify(ctx context.Context, event *Event) error {
+	preferences, err := s.NotificationRepository.GetPreferences(ctx, event.UserID)
+	if err != nil {
+		return err
+	}
+	if enabled, ok := preferences[event.Type]; ok && !enabled {
+		return nil
+	}
+
+	if event.ActorName == "" && event.ActorID > 0 {
+		actor, err := s.UserRepository.GetByID(ctx, event.ActorID)
+		if err != nil {
+			return err
+		}
+		event.ActorName = actor.Username
+	}
+
+	var title string
+	if event.ArticleID > 0 {
+		article, err := s.ArticleRepository.GetByID(ctx, event.ArticleID)
+		if err != nil {
+			return err
+		}
+		title = article.Title
+	}
+
+	notification := &schema.Notification{
+		UserID:       event.UserID,
+		Type:         event.Type,
+		ActorID:      event.ActorID,
+		ActorName:    event.ActorName,
+		ActorCount:   1,
+		TargetType:   event.TargetType,
+		TargetID:     event.TargetID,
+		CommentID:    event.CommentID,
+		ArticleID:    event.ArticleID,
+		ArticleTitle: util.Truncate(title, 255),
+		Result:       event.Result,
+		Content:      util.Truncate(event.Content, contentLen),
+	}
+	if !schema.IsGroupedType(event.Type) {
+		return s.NotificationRepository.Create(ctx, notification)
+	}
+
+	// 同一对象上的同类未读通知合并为一条，同一触发者只计一次
+	notification.GroupKey = fmt.Sprintf("%s:%s:%d", event.Type, event.TargetType, event.TargetID)
+	return s.Trans.Exec(ctx, func(ctx context.Context) error {
+		group, err := s.NotificationRepository.GetUnreadGroup(ctx, event.UserID, notification.GroupKey)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+
+		if group == nil {
+			if err := s.NotificationRepository.Create(ctx, notification); err != nil {
+				return err
+			}
+			_, err := s.NotificationRepository.AddActor(ctx, &schema.NotificationActor{
+				NotificationID: notification.ID,
+				ActorID:        event.ActorID,
+				ActorName:      event.ActorName,
+			})
+			return err
+		}
+
+		added, err := s.NotificationRepository.AddActor(ctx, &schema.NotificationActor{
+			NotificationID: group.ID,
+			ActorID:        event.ActorID,
+			ActorName:      event.ActorName,
+		})
+		if err != nil || !added {
+			return err
+		}
+		return s.NotificationRepository.IncrementActor(ctx, group.ID, event.ActorID, event.ActorName)
+	})
+}
+
+// GetNotifications 获取当前用户的通知
+func (s *NotificationService) GetNotifications(ctx context.Context, params *schema.NotificationQueryParams) (*schema.NotificationPaginationResult, error) {
+	return s.NotificationRepository.GetList(ctx, util.FromUserID(ctx), params)
+}
+
+// GetUnreadCount 获取当前用户的未读通知数
+func (s *NotificationService) GetUnreadCount(ctx context.Context) (*schema.UnreadCountResponse, error) {
+	byType, err := s.NotificationRepository.CountUnread(ctx, util.FromUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &schema.UnreadCountResponse{ByType: byType}
+	for _, count := range byType {
+		result.Total += count
+	}
+	return result, nil
+}
+
+// MarkRead 将当前用户的一条通知标记为已读
+func (s *NotificationService) MarkRead(ctx context.Context, id uint) error {
+	return s.NotificationRepository.MarkRead(ctx, util.FromUserID(ctx), id)
+}
+
+// MarkAllRead 将当前用户的未读通知全部标记为已读
+func (s *NotificationService) MarkAllRead(ctx context.Context, req *schema.MarkAllReadRequest) (*schema.UnreadCountResponse, error) {
+	if _, err := s.NotificationRepository.MarkAllRead(ctx, util.FromUserID(ctx), req.Type); err != nil {
+		return nil, err
+	}
+	return s.GetUnreadCount(ctx)
+}
+
+// GetPreferences 获取当前用户的通知偏好，未设置的类型默认接收
+func (s *NotificationService) GetPreferences(ctx context.Context) (map[string]bool, error) {
+	saved, err := s.NotificationRepository.GetPreferences(ctx, util.FromUserID(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]bool, len(schema.NotificationTypes))
+	for _, typ := range schema.NotificationTypes {
+		enabled, ok := saved[typ]
+		result[typ] = !ok || enabled
+	}
+	return result, nil
+}
+
+// UpdatePreferences 更新当前用户的通知偏好，未提交的类型保持不变
+func (s *NotificationService) UpdatePreferences(ctx context.Context, req *schema.UpdatePreferencesRequest) (map[string]bool, error) {
+	userID := util.FromUserID(ctx)
+	preferences := make([]schema.NotificationPreference, 0, len(req.Preferences))
+	for typ, enabled := range req.Preferences {
+		if !slices.Contains(schema.NotificationTypes, typ) {
+			return nil, errors.BadRequest("未知的通知类型：%s", typ)
+		}
+		preferences = append(preferences, schema.NotificationPreference{UserID: userID, Type: typ, Enabled: enabled})
+	}
+
+	if err := s.NotificationRepository.SavePreferences(ctx, preferences); err != nil {
+		return nil, err
+	}
+	return s.GetPreferences(ctx)
+}
@@ -0,0 +1,235 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// GetNotificationDB 获取通知数据库实例
+func GetNotificationDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.Notification{})
+}
+
+// GetNotificationActorDB 获取通知触发者数据库实例
+func GetNotificationActorDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.NotificationActor{})
+}
+
+// GetNotificationPreferenceDB 获取通知偏好数据库实例
+func GetNotificationPreferenceDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.NotificationPreference{})
+}
+
+// NotificationRepository 通知数据访问层
+type NotificationRepository struct {
+	DB *gorm.DB
+}
+
+// Create 创建通知
+func (r *NotificationRepository) Create(ctx context.Context, notification *schema.Notification) error {
+	result := GetNotificationDB(ctx, r.DB).Create(notification)
+	return errors.WithStack(result.Error)
+}
+
+// GetUnreadGroup 获取用户指定合并键的最新一条未读通知
+func (r *NotificationRepository) GetUnreadGroup(ctx context.Context, userID uint, groupKey string) (*schema.Notification, error) {
+	var notification schema.Notification
+	err := GetNotificationDB(ctx, r.DB).
+		Where("user_id = ? AND group_key = ? AND is_read = ?", userID, groupKey, false).
+		Order("id DESC").
+		First(&notification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("通知不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &notification, nil
+}
+
+// AddActor 记录通知的触发者，返回是否为新的触发者
+func (r *NotificationRepository) AddActor(ctx context.Context, actor *schema.NotificationActor) (bool, error) {
+	result := GetNotificationActorDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(actor)
+	if result.Error != nil {
+		return false, errors.WithStack(result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
+
+// IncrementActor 合并新的触发者：人数加一，并更新最近一位触发者与触发时间
+func (r *NotificationRepository) IncrementActor(ctx context.Context, id, actorID uint, actorName string) error {
+	result := GetNotificationDB(ctx, r.DB).Where("id = ?", id).Updates(map[string]interface{}{
+		"actor_count": gorm.Expr("actor_count + 1"),
+		"actor_id":    actorID,
+		"actor_name":  actorName,
+		"updated_at":  time.Now(),
+	})
+	return errors.WithStack(result.Error)
+}
+
+// GetList 分页获取用户的通知，按最近触发时间倒序
+func (r *NotificationRepository) GetList(ctx context.Context, userID uint, params *schema.NotificationQueryParams) (*schema.NotificationPaginationResult, error) {
+	var result schema.NotificationPaginationResult
+
+	// 默认值
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+
+	db := GetNotificationDB(ctx, r.DB).Where("user_id = ?", userID)
+	if params.Type != "" {
+		db = db.Where("type = ?", params.Type)
+	}
+	if params.UnreadOnly {
+		db = db.Where("is_read = ?", false)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var notifications []schema.Notification
+	if total > 0 {
+		offset := (params.Page - 1) * params.PageSize
+		err := db.Order("updated_at DESC, id DESC").Offset(offset).Limit(params.PageSize).Find(&notifications).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	items := make([]schema.NotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		items = append(items, schema.NotificationResponse{
+			ID:           n.ID,
+			Type:         n.Type,
+			Message:      n.Message(),
+			ActorID:      n.ActorID,
+			ActorName:    n.ActorName,
+			ActorCount:   n.ActorCount,
+			TargetType:   n.TargetType,
+			TargetID:     n.TargetID,
+			CommentID:    n.CommentID,
+			ArticleID:    n.ArticleID,
+			ArticleTitle: n.ArticleTitle,
+			Result:       n.Result,
+			Content:      n.Content,
+			IsRead:       n.IsRead,
+			ReadAt:       n.ReadAt,
+			CreatedAt:    n.CreatedAt,
+			UpdatedAt:    n.UpdatedAt,
+		})
+	}
+
+	result.Items = items
+	result.Total = total
+	result.Page = params.Page
+	result.PageSize = params.PageSize
+	result.TotalPages = int((total + int64(params.PageSize) - 1) / int64(params.PageSize))
+	return &result, nil
+}
+
+// CountUnread 统计用户各类型的未读通知数
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID uint) (map[string]int64, error) {
+	var rows []struct {
+		Type  string
+		Count int64
+	}
+	err := GetNotificationDB(ctx, r.DB).
+		Select("type, COUNT(*) AS count").
+		Where("user_id = ? AND is_read = ?", userID, false).
+		Group("type").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make(map[string]int64, len(rows))
+	for _, row := range rows {
+		result[row.Type] = row.Count
+	}
+	return result, nil
+}
+
+// MarkRead 将用户的一条通知标记为已读
+func (r *NotificationRepository) MarkRead(ctx context.Context, userID, id uint) error {
+	var notification schema.Notification
+	err := GetNotificationDB(ctx, r.DB).Where("id = ? AND user_id = ?", id, userID).First(&notification).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.NotFound("通知不存在")
+		}
+		return errors.WithStack(err)
+	}
+	if notification.IsRead {
+		return nil
+	}
+
+	result := GetNotificationDB(ctx, r.DB).Where("id = ?", id).
+		Updates(map[string]interface{}{"is_read": true, "read_at": time.Now()})
+	return errors.WithStack(result.Error)
+}
+
+// MarkAllRead 将用户的未读通知全部标记为已读，typ 为空时不限类型，返回标记的数量
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID uint, typ string) (int64, error) {
+	db := GetNotificationDB(ctx, r.DB).Where("user_id = ? AND is_read = ?", userID, false)
+	if typ != "" {
+		db = db.Where("type = ?", typ)
+	}
+	result := db.Updates(map[string]interface{}{"is_read": true, "read_at": time.Now()})
+	return result.RowsAffected, errors.WithStack(result.Error)
+}
+
+// GetPreferences 获取用户已设置的通知偏好
+func (r *NotificationRepository) GetPreferences(ctx context.Context, userID uint) (map[string]bool, error) {
+	var preferences []schema.NotificationPreference
+	if err := GetNotificationPreferenceDB(ctx, r.DB).Where("user_id = ?", userID).Find(&preferences).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	result := make(map[string]bool, len(preferences))
+	for _, preference := range preferences {
+		result[preference.Type] = preference.Enabled
+	}
+	return result, nil
+}
+
+// SavePreferences 保存用户的通知偏好
+func (r *NotificationRepository) SavePreferences(ctx context.Context, preferences []schema.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+	result := GetNotificationPreferenceDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"enabled", "updated_at"}),
+	}).Create(&preferences)
+	return errors.WithStack(result.Error)
+}
+
+// ListUnreadSince 获取用户在指定时间之后触发的未读通知，按最近触发时间倒序，同时返回总数
+func (r *NotificationRepository) ListUnreadSince(ctx context.Context, userID uint, since time.Time, limit int) ([]schema.Notification, int64, error) {
+	db := GetNotificationDB(ctx, r.DB).Where("user_id = ? AND is_read = ? AND updated_at > ?", userID, false, since)
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, errors.WithStack(err)
+	}
+
+	var notifications []schema.Notification
+	if total > 0 {
+		if err := db.Order("updated_at DESC, id DESC").Limit(limit).Find(&notifications).Error; err != nil {
+			return nil, 0, errors.WithStack(err)
+		}
+	}
+	return notifications, total, nil
+}
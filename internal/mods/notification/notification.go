@@ -0,0 +1,66 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/api"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification/schema"
+)
+
+// Notification 通知模块
+type Notification struct {
+	DB                  *gorm.DB
+	NotificationHandler *api.NotificationHandler
+}
+
+// Set 注入通知模块
+var Set = wire.NewSet(
+	wire.Struct(new(Notification), "*"),
+
+	// 通知相关结构体
+	wire.Struct(new(api.NotificationHandler), "*"),
+	wire.Struct(new(biz.NotificationService), "*"),
+	wire.Struct(new(dal.NotificationRepository), "*"),
+)
+
+// AutoMigrate 自动迁移数据库
+func (n *Notification) AutoMigrate(ctx context.Context) error {
+	return n.DB.AutoMigrate(
+		&schema.Notification{},
+		&schema.NotificationActor{},
+		&schema.NotificationPreference{},
+	)
+}
+
+// Init 初始化通知模块
+func (n *Notification) Init(ctx context.Context) error {
+	if config.C.Storage.DB.AutoMigrate {
+		if err := n.AutoMigrate(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RegisterRouters 注册路由
+func (n *Notification) RegisterRouters(ctx context.Context, notification *gin.RouterGroup) error {
+	notification.GET("", n.NotificationHandler.GetNotifications)
+	notification.GET("/unread-count", n.NotificationHandler.GetUnreadCount)
+	notification.POST("/:id/read", n.NotificationHandler.MarkRead)
+	notification.POST("/read-all", n.NotificationHandler.MarkAllRead)
+	notification.GET("/preferences", n.NotificationHandler.GetPreferences)
+	notification.PUT("/preferences", n.NotificationHandler.UpdatePreferences)
+	return nil
+}
+
+// Release 释放资源
+func (n *Notification) Release(ctx context.Context) error {
+	return nil
+}
@@ -0,0 +1,207 @@
+package schema
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 通知类型常量
+const (
+	NotificationTypeReply    = "reply"    // 评论了文章或回复了评论
+	NotificationTypeLike     = "like"     // 点赞了文章
+	NotificationTypeFavorite = "favorite" // 收藏了文章
+	NotificationTypeMention  = "mention"  // 在文章或评论中提及
+	NotificationTypeReview   = "review"   // 文章或评论的审核结果
+	NotificationTypeFollow   = "follow"   // 关注（来自联邦网络）
+)
+
+// NotificationTypes 所有通知类型
+var NotificationTypes = []string{
+	NotificationTypeReply,
+	NotificationTypeLike,
+	NotificationTypeFavorite,
+	NotificationTypeMention,
+	NotificationTypeReview,
+	NotificationTypeFollow,
+}
+
+// groupedTypes 可以合并的通知类型，同一对象上的未读通知合并为一条，如 "alice 等 5 人赞了你的文章"
+var groupedTypes = map[string]bool{
+	NotificationTypeLike:     true,
+	NotificationTypeFavorite: true,
+	NotificationTypeFollow:   true,
+}
+
+// IsGroupedType 判断通知类型是否可以合并
+func IsGroupedType(typ string) bool {
+	return groupedTypes[typ]
+}
+
+// 通知对象类型常量，对象为通知接收者的文章、评论或用户本身
+const (
+	NotificationTargetArticle = "article" // 文章
+	NotificationTargetComment = "comment" // 评论
+	NotificationTargetUser    = "user"    // 用户本身，如被关注
+)
+
+// 审核结果常量
+const (
+	ReviewResultApproved         = "approved"          // 审核通过
+	ReviewResultRejected         = "rejected"          // 审核拒绝
+	ReviewResultChangesRequested = "changes_requested" // 要求修改
+	ReviewResultHidden           = "hidden"            // 被文章作者或协管员隐藏
+)
+
+// Notification 通知
+type Notification struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	UserID       uint       `json:"user_id" gorm:"not null;index:idx_user_read;comment:接收通知的用户ID"`
+	Type         string     `json:"type" gorm:"size:20;not null;comment:通知类型"`
+	GroupKey     string     `json:"group_key" gorm:"size:100;index;comment:合并键，为空表示不合并"`
+	ActorID      uint       `json:"actor_id" gorm:"comment:最近一位触发者的用户ID，为 0 表示站外或系统"`
+	ActorName    string     `json:"actor_name" gorm:"size:255;comment:最近一位触发者的名称"`
+	ActorCount   int        `json:"actor_count" gorm:"not null;default:1;comment:合并的触发者人数"`
+	TargetType   string     `json:"target_type" gorm:"size:20;comment:接收者的对象类型"`
+	TargetID     uint       `json:"target_id" gorm:"comment:接收者的对象ID（被回复的评论、被点赞的文章等）"`
+	CommentID    uint       `json:"comment_id" gorm:"comment:相关评论ID（新的回复、提及所在的评论等）"`
+	ArticleID    uint       `json:"article_id" gorm:"index;comment:相关文章ID"`
+	ArticleTitle string     `json:"article_title" gorm:"size:255;comment:相关文章标题"`
+	Result       string     `json:"result" gorm:"size:20;comment:审核结果"`
+	Content      string     `json:"content" gorm:"size:500;comment:评论摘要或审核备注"`
+	IsRead       bool       `json:"is_read" gorm:"not null;default:false;index:idx_user_read;comment:是否已读"`
+	ReadAt       *time.Time `json:"read_at" gorm:"comment:阅读时间"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt    time.Time  `json:"updated_at" gorm:"index;comment:最近一次触发的时间"`
+}
+
+// TableName 表名
+func (a *Notification) TableName() string {
+	return config.C.FormatTableName("notification")
+}
+
+// NotificationActor 合并通知的触发者，同一触发者在一条通知中只计一次
+type NotificationActor struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	NotificationID uint      `json:"notification_id" gorm:"not null;uniqueIndex:idx_notification_actor;comment:通知ID"`
+	ActorID        uint      `json:"actor_id" gorm:"not null;uniqueIndex:idx_notification_actor;comment:触发者的用户ID"`
+	ActorName      string    `json:"actor_name" gorm:"size:255;not null;uniqueIndex:idx_notification_actor;comment:触发者名称，站外触发者以此区分"`
+	CreatedAt      time.Time `json:"created_at" gorm:"comment:创建时间"`
+}
+
+// TableName 表名
+func (a *NotificationActor) TableName() string {
+	return config.C.FormatTableName("notification_actor")
+}
+
+// NotificationPreference 用户的通知偏好，没有记录的类型默认接收
+type NotificationPreference struct {
+	ID        uint      `json:"id" gorm:"primaryKey"`
+	UserID    uint      `json:"user_id" gorm:"not null;uniqueIndex:idx_user_type;comment:用户ID"`
+	Type      string    `json:"type" gorm:"size:20;not null;uniqueIndex:idx_user_type;comment:通知类型"`
+	Enabled   bool      `json:"enabled" gorm:"not null;comment:是否接收"`
+	UpdatedAt time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *NotificationPreference) TableName() string {
+	return config.C.FormatTableName("notification_preference")
+}
+
+// Message 通知的展示文案
+func (a *Notification) Message() string {
+	actor := a.ActorName
+	if a.ActorCount > 1 {
+		actor = fmt.Sprintf("%s 等 %d 人", a.ActorName, a.ActorCount)
+	}
+
+	switch a.Type {
+	case NotificationTypeReply:
+		if a.TargetType == NotificationTargetComment {
+			return fmt.Sprintf("%s 回复了你的评论", actor)
+		}
+		return fmt.Sprintf("%s 评论了你的文章《%s》", actor, a.ArticleTitle)
+	case NotificationTypeLike:
+		return fmt.Sprintf("%s 赞了你的文章《%s》", actor, a.ArticleTitle)
+	case NotificationTypeFavorite:
+		return fmt.Sprintf("%s 收藏了你的文章《%s》", actor, a.ArticleTitle)
+	case NotificationTypeMention:
+		if a.TargetType == NotificationTargetComment {
+			return fmt.Sprintf("%s 在文章《%s》的评论中提到了你", actor, a.ArticleTitle)
+		}
+		return fmt.Sprintf("%s 在文章《%s》中提到了你", actor, a.ArticleTitle)
+	case NotificationTypeReview:
+		target := "评论"
+		if a.TargetType == NotificationTargetArticle {
+			target = fmt.Sprintf("文章《%s》", a.ArticleTitle)
+		}
+		switch a.Result {
+		case ReviewResultApproved:
+			return fmt.Sprintf("你的%s已通过审核", target)
+		case ReviewResultChangesRequested:
+			return fmt.Sprintf("你的%s需要修改后重新提交", target)
+		case ReviewResultHidden:
+			return fmt.Sprintf("你在文章《%s》下的评论已被隐藏", a.ArticleTitle)
+		default:
+			return fmt.Sprintf("你的%s未通过审核", target)
+		}
+	case NotificationTypeFollow:
+		return fmt.Sprintf("%s 关注了你", actor)
+	}
+	return ""
+}
+
+// NotificationQueryParams 通知列表查询请求
+type NotificationQueryParams struct {
+	Page       int    `form:"page" binding:"omitempty,min=1"`
+	PageSize   int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Type       string `form:"type" binding:"omitempty,oneof=reply like favorite mention review follow"`
+	UnreadOnly bool   `form:"unread_only"`
+}
+
+// NotificationResponse 通知响应
+type NotificationResponse struct {
+	ID           uint       `json:"id"`
+	Type         string     `json:"type"`
+	Message      string     `json:"message"`       // 展示文案
+	ActorID      uint       `json:"actor_id"`      // 最近一位触发者的用户ID
+	ActorName    string     `json:"actor_name"`    // 最近一位触发者的名称
+	ActorCount   int        `json:"actor_count"`   // 合并的触发者人数
+	TargetType   string     `json:"target_type"`   // 对象类型
+	TargetID     uint       `json:"target_id"`     // 对象ID
+	CommentID    uint       `json:"comment_id"`    // 相关评论ID
+	ArticleID    uint       `json:"article_id"`    // 相关文章ID
+	ArticleTitle string     `json:"article_title"` // 相关文章标题
+	Result       string     `json:"result"`        // 审核结果
+	Content      string     `json:"content"`       // 评论摘要或审核备注
+	IsRead       bool       `json:"is_read"`       // 是否已读
+	ReadAt       *time.Time `json:"read_at"`       // 阅读时间
+	CreatedAt    time.Time  `json:"created_at"`    // 创建时间
+	UpdatedAt    time.Time  `json:"updated_at"`    // 最近一次触发的时间
+}
+
+// NotificationPaginationResult 通知分页结果
+type NotificationPaginationResult struct {
+	Items      []NotificationResponse `json:"items"`
+	Total      int64                  `json:"total"`
+	Page       int                    `json:"page"`
+	PageSize   int                    `json:"page_size"`
+	TotalPages int                    `json:"total_pages"`
+}
+
+// UnreadCountResponse 未读通知数量
+type UnreadCountResponse struct {
+	Total  int64            `json:"total"`   // 未读总数
+	ByType map[string]int64 `json:"by_type"` // 各类型的未读数
+}
+
+// MarkAllReadRequest 全部标记为已读请求
+type MarkAllReadRequest struct {
+	Type string `json:"type" binding:"omitempty,oneof=reply like favorite mention review follow"` // 为空时标记所有类型
+}
+
+// UpdatePreferencesRequest 更新通知偏好请求
+type UpdatePreferencesRequest struct {
+	Preferences map[string]bool `json:"preferences" binding:"required"` // 通知类型 -> 是否接收
+}
@@ -0,0 +1,181 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// SensitiveWordHandler 敏感词API处理器
+type SensitiveWordHandler struct {
+	SensitiveWordService *biz.SensitiveWordService
+}
+
+// GetWordList 获取敏感词列表
+// @Tags SensitiveAPI
+// @Security ApiKeyAuth
+// @Summary 获取敏感词列表（仅管理员可用）
+// @Param page query int false "页码" minimum(1) default(1)
+// @Param page_size query int false "每页容量" minimum(1) maximum(100) default(10)
+// @Param keyword query string false "敏感词关键字"
+// @Param category query string false "分类"
+// @Param action query string false "处理方式" Enums(block, mask, review)
+// @Success 200 {object} util.ResponseResult{data=schema.SensitiveWordPaginationResult}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/sensitive/words [get]
+func (h *SensitiveWordHandler) GetWordList(c *gin.Context) {
+	var params schema.SensitiveWordQueryParams
+	if err := util.ParseQuery(c, &params); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.SensitiveWordService.GetWordList(ctx, &params)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// CreateWord 创建敏感词
+// @Tags SensitiveAPI
+// @Security ApiKeyAuth
+// @Summary 创建敏感词（仅管理员可用）
+// @Param body body schema.CreateSensitiveWordRequest true "敏感词信息"
+// @Success 200 {object} util.ResponseResult{data=schema.SensitiveWord}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 409 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/sensitive/words [post]
+func (h *SensitiveWordHandler) CreateWord(c *gin.Context) {
+	var req schema.CreateSensitiveWordRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.SensitiveWordService.CreateWord(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// BatchCreateWords 批量导入敏感词
+// @Tags SensitiveAPI
+// @Security ApiKeyAuth
+// @Summary 批量导入敏感词（仅管理员可用，已存在的敏感词将更新分类与处理方式）
+// @Param body body schema.BatchCreateSensitiveWordRequest true "敏感词列表"
+// @Success 200 {object} util.ResponseResult{data=int}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/sensitive/words/batch [post]
+func (h *SensitiveWordHandler) BatchCreateWords(c *gin.Context) {
+	var req schema.BatchCreateSensitiveWordRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.SensitiveWordService.BatchCreateWords(ctx, &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// UpdateWord 更新敏感词
+// @Tags SensitiveAPI
+// @Security ApiKeyAuth
+// @Summary 更新敏感词（仅管理员可用）
+// @Param id path uint true "敏感词ID" minimum(1)
+// @Param body body schema.UpdateSensitiveWordRequest true "敏感词信息"
+// @Success 200 {object} util.ResponseResult{data=schema.SensitiveWord}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 409 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/sensitive/words/{id} [put]
+func (h *SensitiveWordHandler) UpdateWord(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的敏感词ID"))
+		return
+	}
+
+	var req schema.UpdateSensitiveWordRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	data, err := h.SensitiveWordService.UpdateWord(ctx, uint(id), &req)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// DeleteWord 删除敏感词
+// @Tags SensitiveAPI
+// @Security ApiKeyAuth
+// @Summary 删除敏感词（仅管理员可用）
+// @Param id path uint true "敏感词ID" minimum(1)
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/sensitive/words/{id} [delete]
+func (h *SensitiveWordHandler) DeleteWord(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的敏感词ID"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.SensitiveWordService.DeleteWord(ctx, uint(id)); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResOK(c)
+}
+
+// CheckText 检测文本
+// @Tags SensitiveAPI
+// @Security ApiKeyAuth
+// @Summary 使用当前词库检测文本（仅管理员可用）
+// @Param body body schema.CheckTextRequest true "待检测文本"
+// @Success 200 {object} util.ResponseResult{data=schema.CheckTextResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/sensitive/check [post]
+func (h *SensitiveWordHandler) CheckText(c *gin.Context) {
+	var req schema.CheckTextRequest
+	if err := util.ParseJSON(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	util.ResSuccess(c, h.SensitiveWordService.CheckText(ctx, &req))
+}
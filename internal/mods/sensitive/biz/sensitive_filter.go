@@ -0,0 +1,149 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/dal"
+	"github.com/codeExpert666/goinkblog-backend/pkg/cachex"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/sensitive"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// SensitiveFilter 敏感词过滤器，词库变更后无需重启即可生效
+type SensitiveFilter struct {
+	filter                  *atomic.Value `wire:"-"` // 当前使用的过滤器
+	synced                  atomic.Int64  `wire:"-"` // 已同步的词库变更标记
+	dirty                   atomic.Bool   `wire:"-"` // 本实例的词库发生变更，需要重建过滤器
+	worker                  outbox.Worker `wire:"-"` // 串行执行过滤器重建，定期检查其他实例的词库变更
+	Cache                   cachex.Cacher // 词库变更同步通知
+	SensitiveWordRepository *dal.SensitiveWordRepository
+}
+
+// Load 初始化敏感词过滤器
+func (f *SensitiveFilter) Load(ctx context.Context) error {
+	f.filter = new(atomic.Value)
+	if err := f.reload(ctx); err != nil {
+		return err
+	}
+
+	f.worker.Start(ctx, time.Duration(config.C.Sensitive.RefreshInterval)*time.Second, f.sync)
+	return nil
+}
+
+// reload 从数据库重新构建过滤器
+func (f *SensitiveFilter) reload(ctx context.Context) error {
+	items, err := f.SensitiveWordRepository.GetAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	words := make([]sensitive.Word, 0, len(items))
+	for _, item := range items {
+		words = append(words, sensitive.Word{Text: item.Word, Category: item.Category, Action: item.Action})
+	}
+
+	f.filter.Store(sensitive.New(words))
+	logging.Context(ctx).Debug("敏感词过滤器构建完成", zap.Int("word_count", len(words)))
+	return nil
+}
+
+// sync 在本实例词库变更或同步标记更新（用于多实例部署时的同步）时重建过滤器
+// 由后台任务串行执行，重建前读取同步标记，重建期间发生的变更会在下一次执行时处理
+func (f *SensitiveFilter) sync(ctx context.Context, _ bool) {
+	updated := f.syncMarker(ctx)
+	if !f.dirty.Swap(false) && f.synced.Load() >= updated {
+		return
+	}
+
+	if err := f.reload(ctx); err != nil {
+		f.dirty.Store(true)
+		logging.Context(ctx).Error("更新敏感词过滤器失败", zap.Error(err))
+		return
+	}
+	if f.synced.Load() < updated {
+		f.synced.Store(updated)
+	}
+}
+
+// syncMarker 从缓存中获取词库变更同步标记，获取失败时返回 0
+func (f *SensitiveFilter) syncMarker(ctx context.Context) int64 {
+	val, ok, err := f.Cache.Get(ctx, config.CacheNSForSensitive, config.CacheKeyForSyncToSensitiveFilter)
+	if err != nil {
+		logging.Context(ctx).Error("从缓存中获取敏感词过滤器的同步标记失败", zap.Error(err),
+			zap.String("cache_key", config.CacheKeyForSyncToSensitiveFilter))
+		return 0
+	} else if !ok {
+		return 0
+	}
+
+	updated, err := strconv.ParseInt(val, 10, 64)
+	if err != nil {
+		logging.Context(ctx).Error("解析敏感词过滤器的同步标记失败", zap.Error(err), zap.String("val", val))
+		return 0
+	}
+	return updated
+}
+
+// MarkDirty 通知词库发生变更，本实例立即异步重建过滤器，其他实例通过同步标记感知
+func (f *SensitiveFilter) MarkDirty(ctx context.Context) {
+	if err := f.Cache.Set(ctx, config.CacheNSForSensitive, config.CacheKeyForSyncToSensitiveFilter,
+		fmt.Sprintf("%d", time.Now().Unix())); err != nil {
+		logging.Context(ctx).Error("向缓存中存入敏感词过滤器同步标记失败", zap.Error(err))
+	}
+
+	f.dirty.Store(true)
+	f.worker.Kick()
+}
+
+// Check 检测文本中的敏感词
+func (f *SensitiveFilter) Check(ctx context.Context, text string) *sensitive.Result {
+	if f.filter == nil {
+		return &sensitive.Result{Masked: text}
+	}
+	filter, ok := f.filter.Load().(*sensitive.Filter)
+	if !ok {
+		return &sensitive.Result{Masked: text}
+	}
+	return filter.Check(text)
+}
+
+// Screen 按词库配置处理用户提交的文本，field 为字段名称（用于提示信息）
+// 命中 block 时返回错误；命中 mask 时返回掩码后的文本；命中 review 时 review 为 true，由调用方转入人工审核
+func (f *SensitiveFilter) Screen(ctx context.Context, field, text string) (masked string, review bool, err error) {
+	result := f.Check(ctx, text)
+	if len(result.Hits) == 0 {
+		return text, false, nil
+	}
+
+	logging.Context(logging.NewTag(ctx, logging.TagKeyOperate)).Info("用户提交内容命中敏感词",
+		zap.String("field", field),
+		zap.String("action", string(result.Action)),
+		zap.Strings("words", result.Words()),
+		zap.Uint("user_id", util.FromUserID(ctx)))
+
+	switch result.Action {
+	case sensitive.ActionBlock:
+		return "", false, errors.BadRequest("%s包含违禁词：%s", field, strings.Join(result.Words(), "、"))
+	case sensitive.ActionReview:
+		return text, true, nil
+	default:
+		return result.Masked, false, nil
+	}
+}
+
+// Release 释放资源
+func (f *SensitiveFilter) Release(ctx context.Context) error {
+	f.worker.Stop()
+	return nil
+}
@@ -0,0 +1,139 @@
+package biz
+
+import (
+	"context"
+	"strings"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+)
+
+// SensitiveWordService 敏感词业务逻辑层
+type SensitiveWordService struct {
+	SensitiveWordRepository *dal.SensitiveWordRepository
+	SensitiveFilter         *SensitiveFilter
+}
+
+// GetWordList 获取敏感词列表
+func (s *SensitiveWordService) GetWordList(ctx context.Context, params *schema.SensitiveWordQueryParams) (*schema.SensitiveWordPaginationResult, error) {
+	if params.Page <= 0 {
+		params.Page = 1
+	}
+	if params.PageSize <= 0 {
+		params.PageSize = 10
+	}
+	return s.SensitiveWordRepository.GetList(ctx, params)
+}
+
+// CreateWord 创建敏感词
+func (s *SensitiveWordService) CreateWord(ctx context.Context, req *schema.CreateSensitiveWordRequest) (*schema.SensitiveWord, error) {
+	text := strings.TrimSpace(req.Word)
+	if text == "" {
+		return nil, errors.BadRequest("敏感词不能为空")
+	}
+
+	exists, err := s.SensitiveWordRepository.ExistsByWord(ctx, text, 0)
+	if err != nil {
+		return nil, err
+	} else if exists {
+		return nil, errors.Conflict("敏感词已存在")
+	}
+
+	word := &schema.SensitiveWord{
+		Word:     text,
+		Category: strings.TrimSpace(req.Category),
+		Action:   req.Action,
+	}
+	if err := s.SensitiveWordRepository.Create(ctx, word); err != nil {
+		return nil, err
+	}
+
+	s.SensitiveFilter.MarkDirty(ctx)
+	return word, nil
+}
+
+// BatchCreateWords 批量导入敏感词，返回实际导入的数量
+func (s *SensitiveWordService) BatchCreateWords(ctx context.Context, req *schema.BatchCreateSensitiveWordRequest) (int, error) {
+	seen := make(map[string]struct{}, len(req.Words))
+	words := make([]*schema.SensitiveWord, 0, len(req.Words))
+	for _, w := range req.Words {
+		text := strings.TrimSpace(w)
+		if text == "" {
+			continue
+		}
+		if _, ok := seen[text]; ok {
+			continue
+		}
+		seen[text] = struct{}{}
+		words = append(words, &schema.SensitiveWord{
+			Word:     text,
+			Category: strings.TrimSpace(req.Category),
+			Action:   req.Action,
+		})
+	}
+	if len(words) == 0 {
+		return 0, errors.BadRequest("敏感词不能为空")
+	}
+
+	if err := s.SensitiveWordRepository.Upsert(ctx, words); err != nil {
+		return 0, err
+	}
+
+	s.SensitiveFilter.MarkDirty(ctx)
+	return len(words), nil
+}
+
+// UpdateWord 更新敏感词
+func (s *SensitiveWordService) UpdateWord(ctx context.Context, id uint, req *schema.UpdateSensitiveWordRequest) (*schema.SensitiveWord, error) {
+	word, err := s.SensitiveWordRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	text := strings.TrimSpace(req.Word)
+	if text == "" {
+		return nil, errors.BadRequest("敏感词不能为空")
+	}
+
+	exists, err := s.SensitiveWordRepository.ExistsByWord(ctx, text, id)
+	if err != nil {
+		return nil, err
+	} else if exists {
+		return nil, errors.Conflict("敏感词已存在")
+	}
+
+	word.Word = text
+	word.Category = strings.TrimSpace(req.Category)
+	word.Action = req.Action
+	if err := s.SensitiveWordRepository.Update(ctx, word); err != nil {
+		return nil, err
+	}
+
+	s.SensitiveFilter.MarkDirty(ctx)
+	return word, nil
+}
+
+// DeleteWord 删除敏感词
+func (s *SensitiveWordService) DeleteWord(ctx context.Context, id uint) error {
+	if _, err := s.SensitiveWordRepository.GetByID(ctx, id); err != nil {
+		return err
+	}
+
+	if err := s.SensitiveWordRepository.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	s.SensitiveFilter.MarkDirty(ctx)
+	return nil
+}
+
+// CheckText 使用当前词库检测文本（用于管理员验证词库效果）
+func (s *SensitiveWordService) CheckText(ctx context.Context, req *schema.CheckTextRequest) *schema.CheckTextResponse {
+	result := s.SensitiveFilter.Check(ctx, req.Text)
+	return &schema.CheckTextResponse{
+		Action: result.Action,
+		Hits:   result.Hits,
+		Masked: result.Masked,
+	}
+}
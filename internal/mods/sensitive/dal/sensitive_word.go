@@ -0,0 +1,116 @@
+package dal
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetSensitiveWordDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.SensitiveWord{})
+}
+
+// SensitiveWordRepository 敏感词数据访问层
+type SensitiveWordRepository struct {
+	DB *gorm.DB
+}
+
+// Create 创建敏感词
+func (r *SensitiveWordRepository) Create(ctx context.Context, word *schema.SensitiveWord) error {
+	result := GetSensitiveWordDB(ctx, r.DB).Create(word)
+	return errors.WithStack(result.Error)
+}
+
+// Upsert 批量创建敏感词，已存在的敏感词更新分类与处理方式
+func (r *SensitiveWordRepository) Upsert(ctx context.Context, words []*schema.SensitiveWord) error {
+	result := GetSensitiveWordDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "word"}},
+		DoUpdates: clause.AssignmentColumns([]string{"category", "action", "updated_at"}),
+	}).CreateInBatches(words, 200)
+	return errors.WithStack(result.Error)
+}
+
+// Update 更新敏感词
+func (r *SensitiveWordRepository) Update(ctx context.Context, word *schema.SensitiveWord) error {
+	result := GetSensitiveWordDB(ctx, r.DB).Where("id = ?", word.ID).Select("*").Omit("created_at").Updates(word)
+	return errors.WithStack(result.Error)
+}
+
+// Delete 删除敏感词
+func (r *SensitiveWordRepository) Delete(ctx context.Context, id uint) error {
+	result := GetSensitiveWordDB(ctx, r.DB).Where("id = ?", id).Delete(&schema.SensitiveWord{})
+	return errors.WithStack(result.Error)
+}
+
+// GetByID 通过ID获取敏感词
+func (r *SensitiveWordRepository) GetByID(ctx context.Context, id uint) (*schema.SensitiveWord, error) {
+	var word schema.SensitiveWord
+	err := GetSensitiveWordDB(ctx, r.DB).Where("id = ?", id).First(&word).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.NotFound("敏感词不存在")
+		}
+		return nil, errors.WithStack(err)
+	}
+	return &word, nil
+}
+
+// ExistsByWord 检查敏感词是否存在（可排除指定ID）
+func (r *SensitiveWordRepository) ExistsByWord(ctx context.Context, word string, excludeID uint) (bool, error) {
+	var count int64
+	db := GetSensitiveWordDB(ctx, r.DB).Where("word = ?", word)
+	if excludeID > 0 {
+		db = db.Where("id <> ?", excludeID)
+	}
+	if err := db.Count(&count).Error; err != nil {
+		return false, errors.WithStack(err)
+	}
+	return count > 0, nil
+}
+
+// GetAll 获取所有敏感词
+func (r *SensitiveWordRepository) GetAll(ctx context.Context) ([]schema.SensitiveWord, error) {
+	var words []schema.SensitiveWord
+	if err := GetSensitiveWordDB(ctx, r.DB).Order("id ASC").Find(&words).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return words, nil
+}
+
+// GetList 获取敏感词列表
+func (r *SensitiveWordRepository) GetList(ctx context.Context, params *schema.SensitiveWordQueryParams) (*schema.SensitiveWordPaginationResult, error) {
+	db := GetSensitiveWordDB(ctx, r.DB)
+	if params.Keyword != "" {
+		db = db.Where("word LIKE ?", "%"+params.Keyword+"%")
+	}
+	if params.Category != "" {
+		db = db.Where("category = ?", params.Category)
+	}
+	if params.Action != "" {
+		db = db.Where("action = ?", params.Action)
+	}
+
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var words []schema.SensitiveWord
+	offset := (params.Page - 1) * params.PageSize
+	if err := db.Order("id DESC").Offset(offset).Limit(params.PageSize).Find(&words).Error; err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return &schema.SensitiveWordPaginationResult{
+		Items:      words,
+		Total:      total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: int((total + int64(params.PageSize) - 1) / int64(params.PageSize)),
+	}, nil
+}
@@ -0,0 +1,74 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/pkg/sensitive"
+)
+
+// SensitiveWord 敏感词模型
+type SensitiveWord struct {
+	ID        uint             `json:"id" gorm:"index;primaryKey"`
+	Word      string           `json:"word" gorm:"size:100;not null;uniqueIndex;comment:敏感词"`
+	Category  string           `json:"category" gorm:"size:50;not null;default:'';index;comment:分类"`
+	Action    sensitive.Action `json:"action" gorm:"size:20;not null;default:'block';comment:处理方式：block、mask、review"`
+	CreatedAt time.Time        `json:"created_at" gorm:"index;comment:创建时间"`
+	UpdatedAt time.Time        `json:"updated_at" gorm:"index;comment:更新时间"`
+}
+
+// TableName 表名
+func (a *SensitiveWord) TableName() string {
+	return config.C.FormatTableName("sensitive_word")
+}
+
+// CreateSensitiveWordRequest 创建敏感词请求
+type CreateSensitiveWordRequest struct {
+	Word     string           `json:"word" binding:"required,max=100"`
+	Category string           `json:"category" binding:"max=50"`
+	Action   sensitive.Action `json:"action" binding:"required,oneof=block mask review"`
+}
+
+// BatchCreateSensitiveWordRequest 批量导入敏感词请求，已存在的敏感词将更新分类与处理方式
+type BatchCreateSensitiveWordRequest struct {
+	Words    []string         `json:"words" binding:"required,min=1,max=1000,dive,required,max=100"`
+	Category string           `json:"category" binding:"max=50"`
+	Action   sensitive.Action `json:"action" binding:"required,oneof=block mask review"`
+}
+
+// UpdateSensitiveWordRequest 更新敏感词请求
+type UpdateSensitiveWordRequest struct {
+	Word     string           `json:"word" binding:"required,max=100"`
+	Category string           `json:"category" binding:"max=50"`
+	Action   sensitive.Action `json:"action" binding:"required,oneof=block mask review"`
+}
+
+// SensitiveWordQueryParams 敏感词查询请求
+type SensitiveWordQueryParams struct {
+	Page     int    `form:"page" binding:"omitempty,min=1"`
+	PageSize int    `form:"page_size" binding:"omitempty,min=1,max=100"`
+	Keyword  string `form:"keyword" binding:"omitempty,max=100"`
+	Category string `form:"category" binding:"omitempty,max=50"`
+	Action   string `form:"action" binding:"omitempty,oneof=block mask review"`
+}
+
+// SensitiveWordPaginationResult 敏感词分页结果
+type SensitiveWordPaginationResult struct {
+	Items      []SensitiveWord `json:"items"`
+	Total      int64           `json:"total"`
+	Page       int             `json:"page"`
+	PageSize   int             `json:"page_size"`
+	TotalPages int             `json:"total_pages"`
+}
+
+// CheckTextRequest 敏感词检测请求
+type CheckTextRequest struct {
+	Text string `json:"text" binding:"required"`
+}
+
+// CheckTextResponse 敏感词检测结果
+type CheckTextResponse struct {
+	Action sensitive.Action `json:"action"` // 需要采取的处理方式，未命中时为空
+	Hits   []sensitive.Hit  `json:"hits"`   // 所有命中
+	Masked string           `json:"masked"` // 掩码后的文本
+}
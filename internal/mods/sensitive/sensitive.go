@@ -0,0 +1,72 @@
+package sensitive
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/api"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/schema"
+)
+
+// Sensitive 敏感词模块
+type Sensitive struct {
+	DB                   *gorm.DB
+	SensitiveFilter      *biz.SensitiveFilter
+	SensitiveWordHandler *api.SensitiveWordHandler
+}
+
+// Set 注入敏感词模块
+var Set = wire.NewSet(
+	wire.Struct(new(Sensitive), "*"),
+
+	// 敏感词相关结构体
+	wire.Struct(new(api.SensitiveWordHandler), "*"),
+	wire.Struct(new(biz.SensitiveWordService), "*"),
+	wire.Struct(new(biz.SensitiveFilter), "*"),
+	wire.Struct(new(dal.SensitiveWordRepository), "*"),
+)
+
+// AutoMigrate 自动迁移数据库
+func (s *Sensitive) AutoMigrate(ctx context.Context) error {
+	return s.DB.AutoMigrate(
+		&schema.SensitiveWord{},
+	)
+}
+
+// Init 初始化敏感词模块
+func (s *Sensitive) Init(ctx context.Context) error {
+	if config.C.Storage.DB.AutoMigrate {
+		if err := s.AutoMigrate(ctx); err != nil {
+			return err
+		}
+	}
+
+	// 加载敏感词过滤器
+	return s.SensitiveFilter.Load(ctx)
+}
+
+// RegisterRouters 注册路由
+func (s *Sensitive) RegisterRouters(ctx context.Context, sensitive *gin.RouterGroup) error {
+	// 敏感词管理接口（仅管理员可用）
+	words := sensitive.Group("/words")
+	{
+		words.GET("", s.SensitiveWordHandler.GetWordList)
+		words.POST("", s.SensitiveWordHandler.CreateWord)
+		words.POST("/batch", s.SensitiveWordHandler.BatchCreateWords)
+		words.PUT("/:id", s.SensitiveWordHandler.UpdateWord)
+		words.DELETE("/:id", s.SensitiveWordHandler.DeleteWord)
+	}
+	sensitive.POST("/check", s.SensitiveWordHandler.CheckText)
+	return nil
+}
+
+// Release 释放资源
+func (s *Sensitive) Release(ctx context.Context) error {
+	return s.SensitiveFilter.Release(ctx)
+}
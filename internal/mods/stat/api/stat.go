@@ -57,6 +57,59 @@ func (h *StatHandler) GetUserArticleStatistic(c *gin.Context) {
 	util.ResSuccess(c, data)
 }
 
+// GetUserArticleCompletion 获取用户文章的阅读完成度
+// @Tags StatAPI
+// @Security ApiKeyAuth
+// @Summary 获取用户文章的阅读完成度
+// @Success 200 {object} util.ResponseResult{data=[]schema.ArticleCompletionItem}
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/stat/user/articles/completion [get]
+func (h *StatHandler) GetUserArticleCompletion(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.StatService.GetUserArticleCompletion(ctx, userID)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
+// GetArticleTrend 获取单篇文章的每日统计趋势
+// @Tags StatAPI
+// @Security ApiKeyAuth
+// @Summary 获取单篇文章的每日统计趋势（仅作者与管理员可用）
+// @Param id path uint true "文章ID"
+// @Param days query int false "天数" minimum(1) maximum(365) default(30)
+// @Success 200 {object} util.ResponseResult{data=schema.ArticleTrendResponse}
+// @Failure 400 {object} util.ResponseResult
+// @Failure 403 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/stat/articles/{id}/trend [get]
+func (h *StatHandler) GetArticleTrend(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		util.ResError(c, errors.BadRequest("无效的文章ID"))
+		return
+	}
+	days, err := strconv.Atoi(c.DefaultQuery("days", "30"))
+	if err != nil || days > 365 {
+		util.ResError(c, errors.BadRequest("无效的天数参数"))
+		return
+	}
+
+	ctx := c.Request.Context()
+	userID := util.FromUserID(ctx)
+	data, err := h.StatService.GetArticleTrend(ctx, userID, uint(id), days)
+	if err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, data)
+}
+
 // GetSiteOverview 获取站点概览统计信息
 // @Tags StatAPI
 // @Security ApiKeyAuth
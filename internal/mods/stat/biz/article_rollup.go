@@ -0,0 +1,97 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+)
+
+// ArticleRollup 文章每日统计汇总器，定期将日志、交互与评论数据汇总为每篇文章每天一行
+type ArticleRollup struct {
+	ticker                     *time.Ticker `wire:"-"` // 定时汇总
+	ArticleDailyStatRepository *dal.ArticleDailyStatRepository
+}
+
+// Load 回填缺失的历史数据并启动后台汇总
+func (a *ArticleRollup) Load(ctx context.Context) error {
+	if err := a.backfill(ctx); err != nil {
+		return err
+	}
+
+	a.ticker = time.NewTicker(time.Duration(config.C.Stat.ArticleRollup.Interval) * time.Second)
+	go a.autoRollup(ctx)
+	return nil
+}
+
+// backfill 从最近一次汇总的日期（最多回溯 BackfillDays 天）开始汇总到今天
+func (a *ArticleRollup) backfill(ctx context.Context) error {
+	today := truncateToDay(time.Now())
+	start := today.AddDate(0, 0, -config.C.Stat.ArticleRollup.BackfillDays+1)
+
+	latest, err := a.ArticleDailyStatRepository.GetLatestDate(ctx)
+	if err != nil {
+		return err
+	}
+	if latest != nil {
+		if day := truncateToDay(*latest); day.After(start) {
+			start = day
+		}
+	}
+
+	for day := start; !day.After(today); day = day.AddDate(0, 0, 1) {
+		if err := a.rollup(ctx, day); err != nil {
+			return err
+		}
+	}
+
+	logging.Context(ctx).Info("文章每日统计回填完成", zap.String("start", start.Format(time.DateOnly)),
+		zap.String("end", today.Format(time.DateOnly)))
+	return nil
+}
+
+// autoRollup 定期重新汇总昨天与今天的数据（昨天的数据在跨天后仍可能有少量写入）
+func (a *ArticleRollup) autoRollup(ctx context.Context) {
+	for range a.ticker.C {
+		today := truncateToDay(time.Now())
+		for _, day := range []time.Time{today.AddDate(0, 0, -1), today} {
+			if err := a.rollup(ctx, day); err != nil {
+				logging.Context(ctx).Error("汇总文章每日统计失败", zap.Error(err),
+					zap.String("date", day.Format(time.DateOnly)))
+			}
+		}
+	}
+}
+
+// rollup 汇总指定日期的数据
+func (a *ArticleRollup) rollup(ctx context.Context, day time.Time) error {
+	stats, err := a.ArticleDailyStatRepository.Aggregate(ctx, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+
+	items := make([]*schema.ArticleDailyStat, 0, len(stats))
+	for _, stat := range stats {
+		items = append(items, stat)
+	}
+	return a.ArticleDailyStatRepository.Upsert(ctx, items)
+}
+
+// Release 释放资源
+func (a *ArticleRollup) Release(ctx context.Context) error {
+	if a.ticker != nil {
+		a.ticker.Stop()
+	}
+	return nil
+}
+
+// truncateToDay 截取到本地时间当天零点
+func truncateToDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.Local)
+}
@@ -18,9 +18,12 @@ import (
 	"github.com/shirou/gopsutil/v3/process"
 
 	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	blogDal "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/schema"
 	"github.com/codeExpert666/goinkblog-backend/pkg/cachex"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
 )
 
 // 系统启动时间（全局变量会在程序启动时初始化）
@@ -28,8 +31,10 @@ var startupTime = time.Now()
 
 // StatService 统计业务逻辑层
 type StatService struct {
-	StatRepository *dal.StatRepository
-	Cache          cachex.Cacher
+	StatRepository             *dal.StatRepository
+	ArticleDailyStatRepository *dal.ArticleDailyStatRepository
+	ArticleRepository          *blogDal.ArticleRepository
+	Cache                      cachex.Cacher
 }
 
 // GetUserArticleVisitTrend 获取用户文章访问趋势数据
@@ -45,6 +50,64 @@ func (s *StatService) GetUserArticleStatistic(ctx context.Context, userID uint)
 	return s.StatRepository.GetUserArticleStatistic(ctx, userID)
 }
 
+// GetUserArticleCompletion 获取用户文章的阅读完成度
+func (s *StatService) GetUserArticleCompletion(ctx context.Context, userID uint) ([]schema.ArticleCompletionItem, error) {
+	return s.StatRepository.GetUserArticleCompletion(ctx, userID)
+}
+
+// GetArticleTrend 获取单篇文章的每日统计趋势（仅作者与管理员可见）
+func (s *StatService) GetArticleTrend(ctx context.Context, userID, articleID uint, days int) (*schema.ArticleTrendResponse, error) {
+	if days <= 0 {
+		days = 30 // 默认查询最近30天的数据
+	}
+
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	if article.AuthorID != userID && !util.FromIsAdminUser(ctx) {
+		return nil, errors.Forbidden("无权限查看此文章的统计数据")
+	}
+
+	endDate := truncateToDay(time.Now())
+	startDate := endDate.AddDate(0, 0, -days+1)
+	stats, err := s.ArticleDailyStatRepository.GetByArticleID(ctx, articleID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	statMap := make(map[string]schema.ArticleDailyStat, len(stats))
+	for _, stat := range stats {
+		statMap[stat.Date.Format(time.DateOnly)] = stat
+	}
+
+	// 生成连续的日期数据
+	result := &schema.ArticleTrendResponse{
+		ArticleID: article.ID,
+		Title:     article.Title,
+		Items:     make([]schema.ArticleTrendItem, 0, days),
+	}
+	for date := startDate; !date.After(endDate); date = date.AddDate(0, 0, 1) {
+		dateStr := date.Format(time.DateOnly)
+		stat := statMap[dateStr]
+		result.Items = append(result.Items, schema.ArticleTrendItem{
+			Date:           dateStr,
+			Views:          stat.Views,
+			UniqueVisitors: stat.UniqueVisitors,
+			Likes:          stat.Likes,
+			Favorites:      stat.Favorites,
+			Comments:       stat.Comments,
+		})
+		result.TotalViews += stat.Views
+		result.TotalVisitors += stat.UniqueVisitors
+		result.TotalLikes += stat.Likes
+		result.TotalFavorites += stat.Favorites
+		result.TotalComments += stat.Comments
+	}
+
+	return result, nil
+}
+
 // GetOverview 获取站点概览统计信息
 func (s *StatService) GetOverview(ctx context.Context) *schema.SiteOverviewResponse {
 	return s.StatRepository.GetOverview(ctx)
@@ -0,0 +1,142 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	blogDal "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	commentDal "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/dal"
+	commentSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/schema"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetArticleDailyStatDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.ArticleDailyStat{})
+}
+
+// ArticleDailyStatRepository 文章每日统计数据访问层
+type ArticleDailyStatRepository struct {
+	DB *gorm.DB
+}
+
+// articleCount 单篇文章的计数结果
+type articleCount struct {
+	ArticleID uint
+	Count     int64
+}
+
+// articleVisit 单篇文章的访问计数结果
+type articleVisit struct {
+	ArticleID      uint
+	Views          int64
+	UniqueVisitors int64
+}
+
+// Aggregate 汇总 [start, end) 时间段内每篇文章的浏览、独立访客、点赞、收藏与评论数
+func (r *ArticleDailyStatRepository) Aggregate(ctx context.Context, start, end time.Time) (map[uint]*schema.ArticleDailyStat, error) {
+	result := make(map[uint]*schema.ArticleDailyStat)
+	get := func(articleID uint) *schema.ArticleDailyStat {
+		stat, ok := result[articleID]
+		if !ok {
+			stat = &schema.ArticleDailyStat{ArticleID: articleID, Date: start}
+			result[articleID] = stat
+		}
+		return stat
+	}
+
+	// 1. 从请求日志中统计文章详情的浏览次数与独立访客（登录用户按用户ID，匿名用户按IP区分）
+	var visits []articleVisit
+	err := GetLogDB(ctx, r.DB).Model(&schema.Logger{}).
+		Select("SUBSTRING_INDEX(data->>'$.path', '/', -1) + 0 AS article_id",
+			"COUNT(*) AS views",
+			"COUNT(DISTINCT IF(user_id > 0, CONCAT('u:', user_id), CONCAT('ip:', data->>'$.client_ip'))) AS unique_visitors").
+		Where("tag = 'request' AND created_at >= ? AND created_at < ?", start, end).
+		Where("data->>'$.method' = 'GET' AND data->>'$.status' + 0 BETWEEN 200 AND 299").
+		Where("data->>'$.path' REGEXP '^/api/blog/articles/[0-9]+$'").
+		Group("article_id").
+		Scan(&visits).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, v := range visits {
+		stat := get(v.ArticleID)
+		stat.Views = v.Views
+		stat.UniqueVisitors = v.UniqueVisitors
+	}
+
+	// 2. 统计新增点赞与收藏
+	for _, typ := range []string{"like", "favorite"} {
+		var counts []articleCount
+		err := blogDal.GetInteractionDB(ctx, r.DB).
+			Select("article_id", "COUNT(*) AS count").
+			Where("type = ? AND created_at >= ? AND created_at < ?", typ, start, end).
+			Group("article_id").
+			Scan(&counts).Error
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		for _, c := range counts {
+			if typ == "like" {
+				get(c.ArticleID).Likes = c.Count
+			} else {
+				get(c.ArticleID).Favorites = c.Count
+			}
+		}
+	}
+
+	// 3. 统计新增评论，与文章评论数口径一致，只计入已审核通过、未隐藏且未删除的评论
+	var counts []articleCount
+	err = commentDal.GetCommentDB(ctx, r.DB).
+		Select("article_id", "COUNT(*) AS count").
+		Where("created_at >= ? AND created_at < ?", start, end).
+		Where("status = ? AND hidden_by IS NULL AND deleted_at IS NULL", commentSchema.CommentStatusApproved).
+		Group("article_id").
+		Scan(&counts).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, c := range counts {
+		get(c.ArticleID).Comments = c.Count
+	}
+
+	return result, nil
+}
+
+// Upsert 写入每日统计，已存在时覆盖计数
+func (r *ArticleDailyStatRepository) Upsert(ctx context.Context, stats []*schema.ArticleDailyStat) error {
+	if len(stats) == 0 {
+		return nil
+	}
+	result := GetArticleDailyStatDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "article_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"views", "unique_visitors", "likes", "favorites", "comments", "updated_at"}),
+	}).CreateInBatches(stats, 200)
+	return errors.WithStack(result.Error)
+}
+
+// GetLatestDate 获取最近一次汇总的日期，尚未汇总时返回 nil
+func (r *ArticleDailyStatRepository) GetLatestDate(ctx context.Context) (*time.Time, error) {
+	var latest *time.Time
+	if err := GetArticleDailyStatDB(ctx, r.DB).Select("MAX(date)").Row().Scan(&latest); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return latest, nil
+}
+
+// GetByArticleID 获取文章在 [start, end] 日期范围内的每日统计
+func (r *ArticleDailyStatRepository) GetByArticleID(ctx context.Context, articleID uint, start, end time.Time) ([]schema.ArticleDailyStat, error) {
+	var stats []schema.ArticleDailyStat
+	err := GetArticleDailyStatDB(ctx, r.DB).
+		Where("article_id = ? AND date >= ? AND date <= ?", articleID, start.Format(time.DateOnly), end.Format(time.DateOnly)).
+		Order("date ASC").
+		Find(&stats).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return stats, nil
+}
@@ -171,6 +171,34 @@ func (r *StatRepository) GetUserArticleStatistic(ctx context.Context, userID uin
 	return &result
 }
 
+// GetUserArticleCompletion 获取用户文章的阅读完成度
+func (r *StatRepository) GetUserArticleCompletion(ctx context.Context, userID uint) ([]schema.ArticleCompletionItem, error) {
+	result := make([]schema.ArticleCompletionItem, 0)
+
+	articleTableName := new(blogSchema.Article).TableName()
+	progressTableName := new(blogSchema.ReadingProgress).TableName()
+	err := blogDal.GetArticleDB(ctx, r.DB).Table(fmt.Sprintf("%s AS a", articleTableName)).
+		Select("a.id AS article_id", "a.title",
+			"COUNT(p.id) AS reader_count",
+			fmt.Sprintf("COALESCE(SUM(p.progress >= %d), 0) AS finished_count", blogSchema.ReadingFinishedProgress),
+			"COALESCE(AVG(p.progress), 0) AS avg_progress").
+		Joins(fmt.Sprintf("LEFT JOIN %s AS p ON a.id = p.article_id", progressTableName)).
+		Where("a.author_id = ?", userID).
+		Group("a.id, a.title").
+		Order("a.created_at DESC").
+		Scan(&result).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for i := range result {
+		if result[i].ReaderCount > 0 {
+			result[i].CompletionRate = float64(result[i].FinishedCount) * 100 / float64(result[i].ReaderCount)
+		}
+	}
+	return result, nil
+}
+
 // GetOverview 获取站点概览统计信息
 func (r *StatRepository) GetOverview(ctx context.Context) *schema.SiteOverviewResponse {
 	var result schema.SiteOverviewResponse
@@ -547,17 +575,17 @@ func (r *StatRepository) GetCommentStatistic(ctx context.Context) *schema.Commen
 	}
 
 	// 获取通过的评论数量
-	if err := commentDal.GetCommentDB(ctx, r.DB).Where("status = ?", commentSchema.CommentStatusApproved).Count(&result.PassedComments).Error; err != nil {
+	if err := commentDal.GetCommentDB(ctx, r.DB).Where("status = ? AND deleted_at IS NULL", commentSchema.CommentStatusApproved).Count(&result.PassedComments).Error; err != nil {
 		logging.Context(ctx).Error("获取通过的评论数量失败", zap.Error(errors.WithStack(err)))
 	}
 
 	// 获取待审核的评论数量
-	if err := commentDal.GetCommentDB(ctx, r.DB).Where("status = ?", commentSchema.CommentStatusPending).Count(&result.PendingComments).Error; err != nil {
+	if err := commentDal.GetCommentDB(ctx, r.DB).Where("status = ? AND deleted_at IS NULL", commentSchema.CommentStatusPending).Count(&result.PendingComments).Error; err != nil {
 		logging.Context(ctx).Error("获取待审核的评论数量失败", zap.Error(errors.WithStack(err)))
 	}
 
 	// 获取拒绝的评论数量
-	if err := commentDal.GetCommentDB(ctx, r.DB).Where("status = ?", commentSchema.CommentStatusRejected).Count(&result.RejectedComments).Error; err != nil {
+	if err := commentDal.GetCommentDB(ctx, r.DB).Where("status = ? AND deleted_at IS NULL", commentSchema.CommentStatusRejected).Count(&result.RejectedComments).Error; err != nil {
 		logging.Context(ctx).Error("获取拒绝的评论数量失败", zap.Error(errors.WithStack(err)))
 	}
 
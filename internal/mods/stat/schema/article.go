@@ -35,3 +35,13 @@ type ArticleCreationTimeStatsItem struct {
 	Count      int64            `json:"count"`
 	Categories map[string]int64 `json:"categories"`
 }
+
+// ArticleCompletionItem 文章阅读完成度数据项
+type ArticleCompletionItem struct {
+	ArticleID      uint    `json:"article_id"`
+	Title          string  `json:"title"`
+	ReaderCount    int64   `json:"reader_count"`    // 上报过阅读进度的读者数
+	FinishedCount  int64   `json:"finished_count"`  // 读完的读者数
+	AvgProgress    float64 `json:"avg_progress"`    // 平均阅读百分比
+	CompletionRate float64 `json:"completion_rate"` // 读完率（百分比）
+}
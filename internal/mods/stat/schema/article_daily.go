@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// ArticleDailyStat 文章每日统计汇总模型
+type ArticleDailyStat struct {
+	ID             uint      `json:"id" gorm:"primaryKey"`
+	ArticleID      uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_article_date;comment:文章ID"`
+	Date           time.Time `json:"date" gorm:"type:date;not null;uniqueIndex:idx_article_date;index;comment:统计日期"`
+	Views          int64     `json:"views" gorm:"not null;default:0;comment:浏览次数"`
+	UniqueVisitors int64     `json:"unique_visitors" gorm:"not null;default:0;comment:独立访客数"`
+	Likes          int64     `json:"likes" gorm:"not null;default:0;comment:新增点赞数"`
+	Favorites      int64     `json:"favorites" gorm:"not null;default:0;comment:新增收藏数"`
+	Comments       int64     `json:"comments" gorm:"not null;default:0;comment:新增评论数,只计入汇总时已审核通过且未隐藏、未删除的评论"`
+	CreatedAt      time.Time `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt      time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *ArticleDailyStat) TableName() string {
+	return config.C.FormatTableName("article_daily_stat")
+}
+
+// ArticleTrendItem 文章每日趋势数据项
+type ArticleTrendItem struct {
+	Date           string `json:"date" example:"2025-05-01"`
+	Views          int64  `json:"views"`
+	UniqueVisitors int64  `json:"unique_visitors"`
+	Likes          int64  `json:"likes"`
+	Favorites      int64  `json:"favorites"`
+	Comments       int64  `json:"comments"`
+}
+
+// ArticleTrendResponse 文章趋势响应
+type ArticleTrendResponse struct {
+	ArticleID      uint               `json:"article_id"`
+	Title          string             `json:"title"`
+	Items          []ArticleTrendItem `json:"items"`
+	TotalViews     int64              `json:"total_views"`     // 区间内浏览次数
+	TotalVisitors  int64              `json:"total_visitors"`  // 区间内每日独立访客数之和
+	TotalLikes     int64              `json:"total_likes"`     // 区间内新增点赞数
+	TotalFavorites int64              `json:"total_favorites"` // 区间内新增收藏数
+	TotalComments  int64              `json:"total_comments"`  // 区间内新增评论数
+}
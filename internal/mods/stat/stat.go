@@ -7,15 +7,18 @@ import (
 	"github.com/google/wire"
 	"gorm.io/gorm"
 
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/api"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/biz"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat/schema"
 )
 
 // Stat 统计模块
 type Stat struct {
-	DB          *gorm.DB
-	StatHandler *api.StatHandler
+	DB            *gorm.DB
+	StatHandler   *api.StatHandler
+	ArticleRollup *biz.ArticleRollup
 }
 
 // Set 注入 Stat 模块
@@ -26,16 +29,29 @@ var Set = wire.NewSet(
 	wire.Struct(new(api.StatHandler), "*"),
 	wire.Struct(new(biz.StatService), "*"),
 	wire.Struct(new(dal.StatRepository), "*"),
+
+	// 文章每日统计相关结构体
+	wire.Struct(new(biz.ArticleRollup), "*"),
+	wire.Struct(new(dal.ArticleDailyStatRepository), "*"),
 )
 
 // AutoMigrate 自动迁移数据库
 func (s *Stat) AutoMigrate(ctx context.Context) error {
-	return nil
+	return s.DB.AutoMigrate(
+		&schema.ArticleDailyStat{},
+	)
 }
 
 // Init 初始化统计模块
 func (s *Stat) Init(ctx context.Context) error {
-	return nil
+	if config.C.Storage.DB.AutoMigrate {
+		if err := s.AutoMigrate(ctx); err != nil {
+			return err
+		}
+	}
+
+	// 回填并启动文章每日统计汇总
+	return s.ArticleRollup.Load(ctx)
 }
 
 // RegisterRouters 注册路由
@@ -50,6 +66,7 @@ func (s *Stat) RegisterRouters(ctx context.Context, stat *gin.RouterGroup) error
 		stat.GET("/categories", s.StatHandler.GetCategoryDistribution)
 		stat.GET("/logger", s.StatHandler.GetLogger)
 		stat.GET("/user/articles/visits", s.StatHandler.GetUserArticleVisitTrend)
+		stat.GET("/user/articles/completion", s.StatHandler.GetUserArticleCompletion)
 		stat.GET("/comments", s.StatHandler.GetCommentStatistic)
 		stat.GET("/system", s.StatHandler.GetSystemInfo)
 		stat.GET("/cpu", s.StatHandler.GetCPUInfo)
@@ -59,11 +76,12 @@ func (s *Stat) RegisterRouters(ctx context.Context, stat *gin.RouterGroup) error
 		stat.GET("/db", s.StatHandler.GetDBInfo)
 		stat.GET("/cache", s.StatHandler.GetCacheInfo)
 		stat.GET("/articles/creation", s.StatHandler.GetArticleCreationTimeStats)
+		stat.GET("/articles/:id/trend", s.StatHandler.GetArticleTrend)
 	}
 	return nil
 }
 
 // Release 释放模块资源
 func (s *Stat) Release(ctx context.Context) error {
-	return nil
+	return s.ArticleRollup.Release(ctx)
 }
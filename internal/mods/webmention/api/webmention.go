@@ -0,0 +1,47 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// WebmentionHandler Webmention API处理器
+type WebmentionHandler struct {
+	WebmentionService *biz.WebmentionService
+}
+
+// siteURL 站点对外访问地址，未配置时根据请求推断
+func siteURL(c *gin.Context) string {
+	return util.GetSiteURL(c, config.C.SiteURL())
+}
+
+// Receive 接收 Webmention
+// @Tags WebmentionAPI
+// @Summary 接收 Webmention（来源页面将被异步验证，验证通过后作为待审核评论展示）
+// @Accept x-www-form-urlencoded
+// @Param source formData string true "来源页面地址"
+// @Param target formData string true "目标文章地址"
+// @Success 200 {object} util.ResponseResult
+// @Failure 400 {object} util.ResponseResult
+// @Failure 404 {object} util.ResponseResult
+// @Failure 500 {object} util.ResponseResult
+// @Router /api/webmention [post]
+func (h *WebmentionHandler) Receive(c *gin.Context) {
+	var req schema.ReceiveWebmentionRequest
+	if err := util.ParseForm(c, &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := h.WebmentionService.Receive(ctx, siteURL(c), &req); err != nil {
+		util.ResError(c, err)
+		return
+	}
+
+	util.ResSuccess(c, "已接收，将异步验证来源")
+}
@@ -0,0 +1,289 @@
+package biz
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	blogDal "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	blogSchema "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/schema"
+	commentBiz "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/logging"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+	"github.com/codeExpert666/goinkblog-backend/pkg/webmention"
+)
+
+// webmentionExcerptLen 引用内容中摘要的最大字符数
+const webmentionExcerptLen = 300
+
+// WebmentionService Webmention 业务逻辑层，负责发送与接收 Webmention
+// 发送与来源验证均由后台任务异步完成，失败时按指数退避重试
+type WebmentionService struct {
+	client               *webmention.Client `wire:"-"`
+	worker               outbox.Worker      `wire:"-"` // 定期处理到期任务，有新任务时立即唤醒
+	WebmentionRepository *dal.WebmentionRepository
+	ArticleRepository    *blogDal.ArticleRepository
+	CommentService       *commentBiz.CommentService
+}
+
+// Start 启动后台任务
+func (s *WebmentionService) Start(ctx context.Context) {
+	cfg := config.C.Webmention
+	s.client = webmention.NewClient(webmention.Options{
+		Timeout:           time.Duration(cfg.Timeout) * time.Second,
+		MaxBodySize:       cfg.MaxBodySize,
+		UserAgent:         config.C.General.AppName + "/" + config.C.General.Version,
+		AllowPrivateHosts: cfg.AllowPrivateHosts,
+	})
+	if !cfg.Enabled {
+		return
+	}
+
+	s.worker.Start(ctx, time.Duration(cfg.Interval)*time.Second, s.run)
+}
+
+// run 处理到期的发送与验证任务
+func (s *WebmentionService) run(ctx context.Context, _ bool) {
+	s.processOutgoing(ctx)
+	s.processIncoming(ctx)
+}
+
+// retryDelay 第 attempts 次失败后的重试等待时间
+func retryDelay(attempts int) time.Duration {
+	return outbox.Backoff(time.Duration(config.C.Webmention.RetryBackoff)*time.Second, attempts)
+}
+
+// lease 任务领取后的保护时间，超过该时间未完成的任务可被重新领取
+func lease() time.Duration {
+	return outbox.Lease(time.Duration(config.C.Webmention.Timeout) * time.Second)
+}
+
+// EnqueueArticle 文章发布后为正文中的外部链接创建发送任务，失败不影响文章发布，仅记录日志
+func (s *WebmentionService) EnqueueArticle(ctx context.Context, article *blogSchema.Article) {
+	if !config.C.Webmention.Enabled {
+		return
+	}
+	base := config.C.SiteURL()
+	if base == "" {
+		logging.Context(ctx).Warn("未配置站点地址（blog.share.site_url），跳过发送 Webmention", zap.Uint("article_id", article.ID))
+		return
+	}
+
+	// 排除指向本站的链接
+	site, _ := url.Parse(base)
+	var targets []string
+	for _, link := range webmention.ExtractLinks(article.Content) {
+		if u, err := url.Parse(link); err == nil && site != nil && strings.EqualFold(u.Host, site.Host) {
+			continue
+		}
+		if len(link) <= 500 {
+			targets = append(targets, link)
+		}
+	}
+
+	if err := s.WebmentionRepository.EnqueueOutgoing(ctx, article.ID, targets); err != nil {
+		logging.Context(ctx).Error("创建 Webmention 发送任务失败", zap.Error(err), zap.Uint("article_id", article.ID))
+		return
+	}
+	s.worker.Kick()
+}
+
+// processOutgoing 处理到期的发送任务
+func (s *WebmentionService) processOutgoing(ctx context.Context) {
+	items, err := s.WebmentionRepository.ClaimDueOutgoing(ctx, config.C.Webmention.BatchSize, lease())
+	if err != nil {
+		logging.Context(ctx).Error("领取 Webmention 发送任务失败", zap.Error(err))
+		return
+	}
+
+	for i := range items {
+		item := &items[i]
+		source := blogSchema.ArticleURL(config.C.SiteURL(), item.ArticleID)
+		err := s.send(ctx, item, source)
+		if err != nil {
+			item.Attempts++
+			item.LastError = util.Truncate(err.Error(), 500)
+			if item.Attempts >= config.C.Webmention.MaxAttempts {
+				item.Status = schema.OutgoingStatusFailed
+			} else {
+				item.NextAttemptAt = time.Now().Add(retryDelay(item.Attempts))
+			}
+			logging.Context(ctx).Warn("发送 Webmention 失败", zap.Error(err),
+				zap.String("source", source), zap.String("target", item.Target), zap.Int("attempts", item.Attempts))
+		}
+
+		if err := s.WebmentionRepository.UpdateOutgoing(ctx, item); err != nil {
+			logging.Context(ctx).Error("更新 Webmention 发送任务失败", zap.Error(err), zap.Uint("id", item.ID))
+		}
+	}
+}
+
+// send 发现目标的端点并发送通知
+func (s *WebmentionService) send(ctx context.Context, item *schema.WebmentionOutgoing, source string) error {
+	endpoint, err := s.client.Discover(ctx, item.Target)
+	if err != nil {
+		return err
+	}
+	if endpoint == "" {
+		item.Status = schema.OutgoingStatusNoEndpoint
+		item.Endpoint = ""
+		return nil
+	}
+
+	item.Endpoint = util.Truncate(endpoint, 500)
+	if err := s.client.Send(ctx, endpoint, source, item.Target); err != nil {
+		return err
+	}
+	item.Status = schema.OutgoingStatusSent
+	item.LastError = ""
+	return nil
+}
+
+// Receive 接收 Webmention，校验目标后保存并异步验证来源
+func (s *WebmentionService) Receive(ctx context.Context, baseURL string, req *schema.ReceiveWebmentionRequest) error {
+	if !config.C.Webmention.Enabled {
+		return errors.NotFound("未开启 Webmention")
+	}
+
+	source, err := url.Parse(req.Source)
+	if err != nil || (source.Scheme != "http" && source.Scheme != "https") {
+		return errors.BadRequest("无效的来源地址")
+	}
+	target, err := url.Parse(req.Target)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") {
+		return errors.BadRequest("无效的目标地址")
+	}
+	if req.Source == req.Target {
+		return errors.BadRequest("来源地址与目标地址不能相同")
+	}
+
+	// 目标必须是本站已发布的文章
+	if site, err := url.Parse(baseURL); err != nil || !strings.EqualFold(target.Host, site.Host) {
+		return errors.BadRequest("目标地址不属于本站")
+	}
+	articleID, ok := blogSchema.ParseArticlePath(target.Path)
+	if !ok {
+		return errors.BadRequest("目标地址不是文章地址")
+	}
+	article, err := s.ArticleRepository.GetByID(ctx, articleID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return errors.BadRequest("目标文章不存在")
+		}
+		return err
+	}
+	if article.Status != blogSchema.ArticleStatusPublished {
+		return errors.BadRequest("目标文章不存在")
+	}
+
+	err = s.WebmentionRepository.SaveIncoming(ctx, &schema.WebmentionIncoming{
+		Source:        req.Source,
+		Target:        req.Target,
+		ArticleID:     articleID,
+		Status:        schema.IncomingStatusPending,
+		NextAttemptAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	s.worker.Kick()
+	return nil
+}
+
+// processIncoming 处理到期的验证任务
+func (s *WebmentionService) processIncoming(ctx context.Context) {
+	items, err := s.WebmentionRepository.ClaimDueIncoming(ctx, config.C.Webmention.BatchSize, lease())
+	if err != nil {
+		logging.Context(ctx).Error("领取 Webmention 验证任务失败", zap.Error(err))
+		return
+	}
+
+	for i := range items {
+		item := &items[i]
+		if err := s.verify(ctx, item); err != nil {
+			item.Attempts++
+			item.LastError = util.Truncate(err.Error(), 500)
+			if item.Attempts >= config.C.Webmention.MaxAttempts {
+				item.Status = schema.IncomingStatusInvalid
+			} else {
+				item.NextAttemptAt = time.Now().Add(retryDelay(item.Attempts))
+			}
+			logging.Context(ctx).Warn("验证 Webmention 失败", zap.Error(err),
+				zap.String("source", item.Source), zap.String("target", item.Target), zap.Int("attempts", item.Attempts))
+		}
+
+		if err := s.WebmentionRepository.UpdateIncoming(ctx, item); err != nil {
+			logging.Context(ctx).Error("更新 Webmention 验证任务失败", zap.Error(err), zap.Uint("id", item.ID))
+		}
+	}
+}
+
+// verify 验证来源页面，验证通过的引用作为待审核评论保存，来源失效时删除已有的引用
+// 返回错误表示需要重试
+func (s *WebmentionService) verify(ctx context.Context, item *schema.WebmentionIncoming) error {
+	info, err := s.client.Verify(ctx, item.Source, item.Target)
+	if errors.Is(err, webmention.ErrSourceGone) || errors.Is(err, webmention.ErrLinkNotFound) {
+		if err := s.CommentService.DeleteWebmention(ctx, item.ArticleID, item.Source); err != nil {
+			return err
+		}
+		item.CommentID = nil
+		item.LastError = err.Error()
+		if errors.Is(err, webmention.ErrSourceGone) {
+			item.Status = schema.IncomingStatusDeleted
+		} else {
+			item.Status = schema.IncomingStatusInvalid
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	comment, err := s.CommentService.SaveWebmention(ctx, item.ArticleID, item.Source, util.Truncate(info.Author, 100), mentionContent(info))
+	if err != nil {
+		if errors.IsBadRequest(err) {
+			// 命中违禁词等不可恢复的错误，不再重试
+			item.Status = schema.IncomingStatusInvalid
+			item.LastError = util.Truncate(err.Error(), 500)
+			return nil
+		}
+		return err
+	}
+
+	item.CommentID = &comment.ID
+	item.Status = schema.IncomingStatusVerified
+	item.LastError = ""
+	return nil
+}
+
+// mentionContent 根据来源页面信息生成引用的评论内容
+func mentionContent(info *webmention.Source) string {
+	parts := make([]string, 0, 3)
+	if info.Title != "" {
+		parts = append(parts, info.Title)
+	}
+	if info.Excerpt != "" {
+		parts = append(parts, util.Truncate(info.Excerpt, webmentionExcerptLen))
+	}
+	parts = append(parts, info.URL)
+	return strings.Join(parts, "\n\n")
+}
+
+// DeleteArticleMentions 删除文章相关的所有 Webmention 记录
+func (s *WebmentionService) DeleteArticleMentions(ctx context.Context, articleID uint) error {
+	return s.WebmentionRepository.DeleteByArticleID(ctx, articleID)
+}
+
+// Release 停止后台任务
+func (s *WebmentionService) Release(ctx context.Context) error {
+	s.worker.Stop()
+	return nil
+}
@@ -0,0 +1,130 @@
+package dal
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/schema"
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/outbox"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+func GetWebmentionOutgoingDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.WebmentionOutgoing{})
+}
+
+func GetWebmentionIncomingDB(ctx context.Context, defDB *gorm.DB) *gorm.DB {
+	return util.GetDB(ctx, defDB).Model(&schema.WebmentionIncoming{})
+}
+
+// WebmentionRepository Webmention 数据访问层
+type WebmentionRepository struct {
+	DB *gorm.DB
+}
+
+// EnqueueOutgoing 为文章的外部链接创建发送任务
+// 文章已有的发送任务（包括已从正文中移除的链接）全部重新发送，以便对方感知内容的更新或删除
+func (r *WebmentionRepository) EnqueueOutgoing(ctx context.Context, articleID uint, targets []string) error {
+	now := time.Now()
+	err := GetWebmentionOutgoingDB(ctx, r.DB).
+		Where("article_id = ?", articleID).
+		Updates(map[string]interface{}{
+			"status":          schema.OutgoingStatusPending,
+			"attempts":        0,
+			"next_attempt_at": now,
+			"last_error":      "",
+		}).Error
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if len(targets) == 0 {
+		return nil
+	}
+	items := make([]*schema.WebmentionOutgoing, 0, len(targets))
+	for _, target := range targets {
+		items = append(items, &schema.WebmentionOutgoing{
+			ArticleID:     articleID,
+			Target:        target,
+			Status:        schema.OutgoingStatusPending,
+			NextAttemptAt: now,
+		})
+	}
+	result := GetWebmentionOutgoingDB(ctx, r.DB).Clauses(clause.OnConflict{DoNothing: true}).Create(items)
+	return errors.WithStack(result.Error)
+}
+
+// ClaimDueOutgoing 领取到期的发送任务，领取后任务在 lease 时间内不会被其他实例重复领取
+func (r *WebmentionRepository) ClaimDueOutgoing(ctx context.Context, limit int, lease time.Duration) ([]schema.WebmentionOutgoing, error) {
+	var items []schema.WebmentionOutgoing
+	now := time.Now()
+	err := GetWebmentionOutgoingDB(ctx, r.DB).
+		Where("status = ? AND next_attempt_at <= ?", schema.OutgoingStatusPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return outbox.Claim(func() *gorm.DB { return GetWebmentionOutgoingDB(ctx, r.DB) }, items, "next_attempt_at", now.Add(lease),
+		func(item *schema.WebmentionOutgoing) (uint, *time.Time) { return item.ID, &item.NextAttemptAt })
+}
+
+// UpdateOutgoing 更新发送任务
+func (r *WebmentionRepository) UpdateOutgoing(ctx context.Context, item *schema.WebmentionOutgoing) error {
+	result := GetWebmentionOutgoingDB(ctx, r.DB).Where("id = ?", item.ID).Select("*").Omit("created_at").Updates(item)
+	return errors.WithStack(result.Error)
+}
+
+// SaveIncoming 保存收到的 Webmention，已存在时重新进入待验证状态
+func (r *WebmentionRepository) SaveIncoming(ctx context.Context, item *schema.WebmentionIncoming) error {
+	result := GetWebmentionIncomingDB(ctx, r.DB).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "source"}, {Name: "target"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"article_id":      item.ArticleID,
+			"status":          item.Status,
+			"attempts":        0,
+			"next_attempt_at": item.NextAttemptAt,
+			"last_error":      "",
+			"updated_at":      time.Now(),
+		}),
+	}).Create(item)
+	return errors.WithStack(result.Error)
+}
+
+// ClaimDueIncoming 领取到期的验证任务，领取后任务在 lease 时间内不会被其他实例重复领取
+func (r *WebmentionRepository) ClaimDueIncoming(ctx context.Context, limit int, lease time.Duration) ([]schema.WebmentionIncoming, error) {
+	var items []schema.WebmentionIncoming
+	now := time.Now()
+	err := GetWebmentionIncomingDB(ctx, r.DB).
+		Where("status = ? AND next_attempt_at <= ?", schema.IncomingStatusPending, now).
+		Order("next_attempt_at ASC").
+		Limit(limit).
+		Find(&items).Error
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return outbox.Claim(func() *gorm.DB { return GetWebmentionIncomingDB(ctx, r.DB) }, items, "next_attempt_at", now.Add(lease),
+		func(item *schema.WebmentionIncoming) (uint, *time.Time) { return item.ID, &item.NextAttemptAt })
+}
+
+// UpdateIncoming 更新收到的 Webmention
+func (r *WebmentionRepository) UpdateIncoming(ctx context.Context, item *schema.WebmentionIncoming) error {
+	result := GetWebmentionIncomingDB(ctx, r.DB).Where("id = ?", item.ID).Select("*").Omit("created_at").Updates(item)
+	return errors.WithStack(result.Error)
+}
+
+// DeleteByArticleID 删除文章相关的所有 Webmention 记录
+func (r *WebmentionRepository) DeleteByArticleID(ctx context.Context, articleID uint) error {
+	if err := GetWebmentionOutgoingDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.WebmentionOutgoing{}).Error; err != nil {
+		return errors.WithStack(err)
+	}
+	result := GetWebmentionIncomingDB(ctx, r.DB).Where("article_id = ?", articleID).Delete(&schema.WebmentionIncoming{})
+	return errors.WithStack(result.Error)
+}
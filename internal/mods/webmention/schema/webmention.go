@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+)
+
+// 发送任务状态常量
+const (
+	OutgoingStatusPending    = "pending"     // 等待发送（包括等待重试）
+	OutgoingStatusSent       = "sent"        // 已发送
+	OutgoingStatusNoEndpoint = "no_endpoint" // 目标不支持 Webmention
+	OutgoingStatusFailed     = "failed"      // 多次尝试后仍发送失败
+)
+
+// 接收记录状态常量
+const (
+	IncomingStatusPending  = "pending"  // 等待验证（包括等待重试）
+	IncomingStatusVerified = "verified" // 验证通过，已作为评论进入审核队列
+	IncomingStatusInvalid  = "invalid"  // 来源页面不包含指向目标的链接或多次验证失败
+	IncomingStatusDeleted  = "deleted"  // 来源页面已删除
+)
+
+// WebmentionOutgoing 待发送的 Webmention，每篇文章的每个外部链接对应一条记录
+type WebmentionOutgoing struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	ArticleID     uint      `json:"article_id" gorm:"not null;uniqueIndex:idx_article_target;comment:文章ID"`
+	Target        string    `json:"target" gorm:"size:500;not null;uniqueIndex:idx_article_target;comment:文章中的外部链接"`
+	Endpoint      string    `json:"endpoint" gorm:"size:500;comment:发现的 Webmention 端点"`
+	Status        string    `json:"status" gorm:"size:20;not null;index:idx_status_next;comment:发送状态"`
+	Attempts      int       `json:"attempts" gorm:"not null;default:0;comment:已尝试次数"`
+	NextAttemptAt time.Time `json:"next_attempt_at" gorm:"index:idx_status_next;comment:下次尝试时间"`
+	LastError     string    `json:"last_error" gorm:"size:500;comment:最近一次失败原因"`
+	CreatedAt     time.Time `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *WebmentionOutgoing) TableName() string {
+	return config.C.FormatTableName("webmention_outgoing")
+}
+
+// WebmentionIncoming 收到的 Webmention
+type WebmentionIncoming struct {
+	ID            uint      `json:"id" gorm:"primaryKey"`
+	Source        string    `json:"source" gorm:"size:255;not null;uniqueIndex:idx_source_target;comment:来源页面"`
+	Target        string    `json:"target" gorm:"size:255;not null;uniqueIndex:idx_source_target;comment:目标页面"`
+	ArticleID     uint      `json:"article_id" gorm:"not null;index;comment:目标文章ID"`
+	CommentID     *uint     `json:"comment_id" gorm:"comment:对应的评论ID"`
+	Status        string    `json:"status" gorm:"size:20;not null;index:idx_status_next;comment:验证状态"`
+	Attempts      int       `json:"attempts" gorm:"not null;default:0;comment:已尝试次数"`
+	NextAttemptAt time.Time `json:"next_attempt_at" gorm:"index:idx_status_next;comment:下次尝试时间"`
+	LastError     string    `json:"last_error" gorm:"size:500;comment:最近一次失败原因"`
+	CreatedAt     time.Time `json:"created_at" gorm:"comment:创建时间"`
+	UpdatedAt     time.Time `json:"updated_at" gorm:"comment:更新时间"`
+}
+
+// TableName 表名
+func (a *WebmentionIncoming) TableName() string {
+	return config.C.FormatTableName("webmention_incoming")
+}
+
+// ReceiveWebmentionRequest 接收 Webmention 请求（application/x-www-form-urlencoded）
+type ReceiveWebmentionRequest struct {
+	Source string `form:"source" binding:"required,url,max=255"`
+	Target string `form:"target" binding:"required,url,max=255"`
+}
@@ -0,0 +1,64 @@
+package webmention
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/wire"
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/internal/config"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/api"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/schema"
+)
+
+// Webmention Webmention 模块
+type Webmention struct {
+	DB                *gorm.DB
+	WebmentionService *biz.WebmentionService
+	WebmentionHandler *api.WebmentionHandler
+}
+
+// Set 注入 Webmention 模块
+var Set = wire.NewSet(
+	wire.Struct(new(Webmention), "*"),
+
+	// Webmention 相关结构体
+	wire.Struct(new(api.WebmentionHandler), "*"),
+	wire.Struct(new(biz.WebmentionService), "*"),
+	wire.Struct(new(dal.WebmentionRepository), "*"),
+)
+
+// AutoMigrate 自动迁移数据库
+func (w *Webmention) AutoMigrate(ctx context.Context) error {
+	return w.DB.AutoMigrate(
+		&schema.WebmentionOutgoing{},
+		&schema.WebmentionIncoming{},
+	)
+}
+
+// Init 初始化 Webmention 模块
+func (w *Webmention) Init(ctx context.Context) error {
+	if config.C.Storage.DB.AutoMigrate {
+		if err := w.AutoMigrate(ctx); err != nil {
+			return err
+		}
+	}
+
+	// 启动发送与验证的后台任务
+	w.WebmentionService.Start(ctx)
+	return nil
+}
+
+// RegisterRouters 注册路由
+func (w *Webmention) RegisterRouters(ctx context.Context, webmention *gin.RouterGroup) error {
+	webmention.POST("", w.WebmentionHandler.Receive)
+	return nil
+}
+
+// Release 释放资源
+func (w *Webmention) Release(ctx context.Context) error {
+	return w.WebmentionService.Release(ctx)
+}
@@ -15,135 +15,70 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/api/ai/models": {
+        "/.well-known/webfinger": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
-                    "AIAPI"
+                    "ActivityPubAPI"
                 ],
-                "summary": "列出模型配置，支持分页和排序（仅管理员可用）",
+                "summary": "WebFinger 账号查询",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 1,
-                        "description": "页码",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 300,
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 10,
-                        "description": "每页容量",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "提供商",
-                        "name": "provider",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "模型名称",
-                        "name": "model",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "true",
-                            "false"
-                        ],
-                        "type": "string",
-                        "description": "是否只显示激活的模型",
-                        "name": "active",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照模型权重排序",
-                        "name": "sort_by_weight",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照模型的每分钟最大请求数排序",
-                        "name": "sort_by_rpm",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照模型当前可用令牌数排序",
-                        "name": "sort_by_current_tokens",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
                         "type": "string",
-                        "description": "如何按照模型成功请求数排序",
-                        "name": "sort_by_success_count",
-                        "in": "query"
+                        "description": "资源标识，如 acct:username@example.com",
+                        "name": "resource",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/activitypub.JRD"
+                        }
                     },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照模型失败请求数排序",
-                        "name": "sort_by_failure_count",
-                        "in": "query"
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/activitypub/articles/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ActivityPubAPI"
+                ],
+                "summary": "获取已发布文章对应的对象",
+                "parameters": [
                     {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照模型请求平均延迟排序（注意：平均延迟为0表示尚不存在有效的延迟数据，该操作会剔除这类数据）",
-                        "name": "sort_by_avg_latency",
-                        "in": "query"
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.ListModelsResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/activitypub.Object"
                         }
                     },
                     "400": {
@@ -152,143 +87,131 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/activitypub/inbox": {
             "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
+                "consumes": [
+                    "application/json"
                 ],
                 "tags": [
-                    "AIAPI"
+                    "ActivityPubAPI"
                 ],
-                "summary": "创建新的模型配置",
+                "summary": "共享收件箱（需 HTTP 签名，支持 Follow、Undo、Like 与 Create）",
                 "parameters": [
                     {
-                        "description": "提供商",
-                        "name": "provider",
+                        "description": "活动",
+                        "name": "activity",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "string",
-                            "enum": [
-                                "openai",
-                                "local"
-                            ]
+                            "$ref": "#/definitions/activitypub.Activity"
                         }
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted"
                     },
-                    {
-                        "description": "API密钥，当provider=openai时必需",
-                        "name": "api_key",
-                        "in": "body",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    {
-                        "format": "url",
-                        "description": "API端点，当provider=openai时必需，且须是有效的HTTP/HTTPS URL",
-                        "name": "endpoint",
-                        "in": "body",
-                        "required": true,
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    {
-                        "description": "模型名称",
-                        "name": "model",
-                        "in": "body",
-                        "required": true,
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    {
-                        "maximum": 2,
-                        "minimum": 0,
-                        "default": 0.7,
-                        "description": "温度参数",
-                        "name": "temperature",
-                        "in": "body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "number"
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
-                    },
+                    }
+                }
+            }
+        },
+        "/api/activitypub/users/{id}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ActivityPubAPI"
+                ],
+                "summary": "获取用户对应的参与者",
+                "parameters": [
                     {
-                        "maximum": 300,
                         "minimum": 1,
-                        "default": 30,
-                        "description": "访问超时时间（秒）",
-                        "name": "timeout",
-                        "in": "body",
+                        "type": "integer",
+                        "description": "用户ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "integer"
+                            "$ref": "#/definitions/activitypub.Actor"
                         }
                     },
-                    {
-                        "default": false,
-                        "description": "是否激活",
-                        "name": "active",
-                        "in": "body",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "boolean"
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    {
-                        "maxLength": 256,
-                        "description": "描述",
-                        "name": "description",
-                        "in": "body",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "minimum": 1,
-                        "default": 10,
-                        "description": "每分钟最大请求数",
-                        "name": "rpm",
-                        "in": "body",
-                        "schema": {
-                            "type": "integer"
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
-                    },
+                    }
+                }
+            }
+        },
+        "/api/activitypub/users/{id}/followers": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ActivityPubAPI"
+                ],
+                "summary": "获取用户的关注者集合（仅包含数量）",
+                "parameters": [
                     {
                         "minimum": 1,
-                        "default": 100,
-                        "description": "权重",
-                        "name": "weight",
-                        "in": "body",
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "integer",
+                        "description": "用户ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.Model"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/activitypub.OrderedCollection"
                         }
                     },
                     "400": {
@@ -297,14 +220,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -312,59 +229,58 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ai/models/overview": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
+        "/api/activitypub/users/{id}/inbox": {
+            "post": {
+                "consumes": [
+                    "application/json"
                 ],
                 "tags": [
-                    "AIAPI"
+                    "ActivityPubAPI"
                 ],
-                "summary": "获取所有AI模型的总体统计信息，适合仪表板展示",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                "summary": "用户收件箱（需 HTTP 签名，支持 Follow、Undo、Like 与 Create）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "用户ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "活动",
+                        "name": "activity",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.ModelOverallStatsResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/activitypub.Activity"
                         }
                     }
-                }
-            }
-        },
-        "/api/ai/models/reset": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
                 ],
-                "tags": [
-                    "AIAPI"
-                ],
-                "summary": "重置所有模型的使用统计信息",
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -372,43 +288,37 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ai/models/{id}": {
+        "/api/activitypub/users/{id}/outbox": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
-                    "AIAPI"
+                    "ActivityPubAPI"
                 ],
-                "summary": "根据ID获取模型配置（仅管理员可用）",
+                "summary": "获取用户的发件箱（已发布文章的 Create 活动）",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "模型ID",
+                        "description": "用户ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "页码，不传时返回集合概要",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.Model"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/activitypub.OrderedCollection"
                         }
                     },
                     "400": {
@@ -422,16 +332,12 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/ai/models": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -440,75 +346,218 @@ const docTemplate = `{
                 "tags": [
                     "AIAPI"
                 ],
-                "summary": "更新现有的模型配置",
+                "summary": "列出模型配置，支持分页和排序（仅管理员可用）",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "模型ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "提供商",
-                        "name": "provider",
-                        "in": "body",
-                        "schema": {
-                            "type": "string",
-                            "enum": [
-                                "openai",
-                                "local"
-                            ]
-                        }
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "API密钥，当provider=openai时必需",
-                        "name": "api_key",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "maximum": 300,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
                     },
                     {
-                        "format": "url",
-                        "description": "API端点，当provider=openai时必需，且须是有效的HTTP/HTTPS URL",
-                        "name": "endpoint",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "string",
+                        "description": "提供商",
+                        "name": "provider",
+                        "in": "query"
                     },
                     {
+                        "type": "string",
                         "description": "模型名称",
                         "name": "model",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "in": "query"
                     },
                     {
-                        "maximum": 2,
-                        "minimum": 0,
-                        "description": "温度参数",
-                        "name": "temperature",
-                        "in": "body",
-                        "schema": {
-                            "type": "number"
-                        }
+                        "enum": [
+                            "true",
+                            "false"
+                        ],
+                        "type": "string",
+                        "description": "是否只显示激活的模型",
+                        "name": "active",
+                        "in": "query"
                     },
                     {
-                        "maximum": 300,
-                        "minimum": 1,
-                        "description": "访问超时时间（秒）",
-                        "name": "timeout",
-                        "in": "body",
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照模型权重排序",
+                        "name": "sort_by_weight",
+                        "in": "query"
                     },
                     {
-                        "description": "是否激活",
-                        "name": "active",
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照模型的每分钟最大请求数排序",
+                        "name": "sort_by_rpm",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照模型当前可用令牌数排序",
+                        "name": "sort_by_current_tokens",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照模型成功请求数排序",
+                        "name": "sort_by_success_count",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照模型失败请求数排序",
+                        "name": "sort_by_failure_count",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照模型请求平均延迟排序（注意：平均延迟为0表示尚不存在有效的延迟数据，该操作会剔除这类数据）",
+                        "name": "sort_by_avg_latency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ListModelsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "AIAPI"
+                ],
+                "summary": "创建新的模型配置",
+                "parameters": [
+                    {
+                        "description": "提供商",
+                        "name": "provider",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string",
+                            "enum": [
+                                "openai",
+                                "local"
+                            ]
+                        }
+                    },
+                    {
+                        "description": "API密钥，当provider=openai时必需",
+                        "name": "api_key",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "format": "url",
+                        "description": "API端点，当provider=openai时必需，且须是有效的HTTP/HTTPS URL",
+                        "name": "endpoint",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "模型名称",
+                        "name": "model",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "maximum": 2,
+                        "minimum": 0,
+                        "default": 0.7,
+                        "description": "温度参数",
+                        "name": "temperature",
+                        "in": "body",
+                        "schema": {
+                            "type": "number"
+                        }
+                    },
+                    {
+                        "maximum": 300,
+                        "minimum": 1,
+                        "default": 30,
+                        "description": "访问超时时间（秒）",
+                        "name": "timeout",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "default": false,
+                        "description": "是否激活",
+                        "name": "active",
                         "in": "body",
                         "schema": {
                             "type": "boolean"
@@ -525,6 +574,7 @@ const docTemplate = `{
                     },
                     {
                         "minimum": 1,
+                        "default": 10,
                         "description": "每分钟最大请求数",
                         "name": "rpm",
                         "in": "body",
@@ -534,6 +584,7 @@ const docTemplate = `{
                     },
                     {
                         "minimum": 1,
+                        "default": 100,
                         "description": "权重",
                         "name": "weight",
                         "in": "body",
@@ -567,8 +618,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -580,8 +631,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/ai/models/overview": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -590,16 +643,40 @@ const docTemplate = `{
                 "tags": [
                     "AIAPI"
                 ],
-                "summary": "删除现有的模型配置",
-                "parameters": [
+                "summary": "获取所有AI模型的总体统计信息，适合仪表板展示",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ModelOverallStatsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ai/models/reset": {
+            "post": {
+                "security": [
                     {
-                        "type": "integer",
-                        "description": "模型ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "ApiKeyAuth": []
                     }
                 ],
+                "tags": [
+                    "AIAPI"
+                ],
+                "summary": "重置所有模型的使用统计信息",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -607,18 +684,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -628,8 +693,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ai/models/{id}/reset": {
-            "post": {
+        "/api/ai/models/{id}": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -638,7 +703,7 @@ const docTemplate = `{
                 "tags": [
                     "AIAPI"
                 ],
-                "summary": "重置特定模型的使用统计信息",
+                "summary": "根据ID获取模型配置（仅管理员可用）",
                 "parameters": [
                     {
                         "type": "integer",
@@ -652,7 +717,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.Model"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -661,6 +738,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -668,157 +751,115 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/ai/polish": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "text/event-stream"
-                ],
                 "tags": [
                     "AIAPI"
                 ],
-                "summary": "使用AI助手润色文章",
+                "summary": "更新现有的模型配置",
                 "parameters": [
                     {
-                        "description": "文章内容",
-                        "name": "article_content",
+                        "type": "integer",
+                        "description": "模型ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "提供商",
+                        "name": "provider",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "type": "string"
+                            "type": "string",
+                            "enum": [
+                                "openai",
+                                "local"
+                            ]
                         }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "流式返回润色后的文章内容",
+                    },
+                    {
+                        "description": "API密钥，当provider=openai时必需",
+                        "name": "api_key",
+                        "in": "body",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    {
+                        "format": "url",
+                        "description": "API端点，当provider=openai时必需，且须是有效的HTTP/HTTPS URL",
+                        "name": "endpoint",
+                        "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "type": "string"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    {
+                        "description": "模型名称",
+                        "name": "model",
+                        "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    {
+                        "maximum": 2,
+                        "minimum": 0,
+                        "description": "温度参数",
+                        "name": "temperature",
+                        "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "type": "number"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/ai/summary": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "text/event-stream"
-                ],
-                "tags": [
-                    "AIAPI"
-                ],
-                "summary": "使用AI助手为文章生成摘要",
-                "parameters": [
                     {
-                        "description": "文章内容",
-                        "name": "article_content",
+                        "maximum": 300,
+                        "minimum": 1,
+                        "description": "访问超时时间（秒）",
+                        "name": "timeout",
                         "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "流式返回生成的文章摘要",
                         "schema": {
-                            "type": "string"
+                            "type": "integer"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    {
+                        "description": "是否激活",
+                        "name": "active",
+                        "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "type": "boolean"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    {
+                        "maxLength": 256,
+                        "description": "描述",
+                        "name": "description",
+                        "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    {
+                        "minimum": 1,
+                        "description": "每分钟最大请求数",
+                        "name": "rpm",
+                        "in": "body",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "type": "integer"
                         }
                     },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/ai/tag": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "AIAPI"
-                ],
-                "summary": "使用AI助手为文章生成6个标签",
-                "parameters": [
                     {
-                        "description": "文章内容",
-                        "name": "article_content",
+                        "minimum": 1,
+                        "description": "权重",
+                        "name": "weight",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "type": "string"
+                            "type": "integer"
                         }
                     }
                 ],
@@ -834,7 +875,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.AssistantResponse"
+                                            "$ref": "#/definitions/schema.Model"
                                         }
                                     }
                                 }
@@ -847,8 +888,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -858,61 +899,33 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
-                    },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
                     }
                 }
-            }
-        },
-        "/api/ai/title": {
-            "post": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "AIAPI"
                 ],
-                "summary": "使用AI助手为文章生成5个标题",
+                "summary": "删除现有的模型配置",
                 "parameters": [
                     {
-                        "description": "文章内容",
-                        "name": "article_content",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "integer",
+                        "description": "模型ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.AssistantResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
                     "400": {
@@ -921,8 +934,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "429": {
-                        "description": "Too Many Requests",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -932,39 +945,27 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
-                    },
-                    "503": {
-                        "description": "Service Unavailable",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
                     }
                 }
             }
         },
-        "/api/auth/avatar": {
+        "/api/ai/models/{id}/reset": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "AuthAPI"
+                    "AIAPI"
                 ],
-                "summary": "上传用户头像",
+                "summary": "重置特定模型的使用统计信息",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "头像图片文件",
-                        "name": "avatar",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "模型ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -972,19 +973,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.AvatarResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
                     "400": {
@@ -1002,12 +991,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/captcha/id": {
+        "/api/ai/moderation/logs": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "AuthAPI"
+                    "AIAPI"
+                ],
+                "summary": "分页获取 AI 审核评论的记录，包括审核建议与自动审核动作（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "comment_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "none",
+                            "approved",
+                            "rejected",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "执行的动作",
+                        "name": "action",
+                        "in": "query"
+                    }
                 ],
-                "summary": "获取验证码ID",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1020,49 +1051,83 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.Captcha"
+                                            "$ref": "#/definitions/schema.ModerationLogPaginationResult"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/auth/captcha/image": {
-            "get": {
+        "/api/ai/polish": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
-                    "image/png"
+                    "text/event-stream"
                 ],
                 "tags": [
-                    "AuthAPI"
+                    "AIAPI"
                 ],
-                "summary": "响应验证码图片（宽400px x 高160px）",
+                "summary": "使用AI助手润色文章",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "验证码ID",
-                        "name": "id",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "number",
-                        "description": "重新生成验证码（reload=1）",
-                        "name": "reload",
-                        "in": "query"
+                        "description": "文章内容",
+                        "name": "article_content",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "验证码图片 - 尺寸: 400x160 像素",
+                        "description": "流式返回润色后的文章内容",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -1070,38 +1135,49 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/currentUser": {
-            "get": {
+        "/api/ai/summary": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/event-stream"
+                ],
                 "tags": [
-                    "AuthAPI"
+                    "AIAPI"
+                ],
+                "summary": "使用AI助手为文章生成摘要",
+                "parameters": [
+                    {
+                        "description": "文章内容",
+                        "name": "article_content",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 ],
-                "summary": "获取当前用户信息",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "流式返回生成的文章摘要",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.UserResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -1111,47 +1187,37 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/auth/login": {
+        "/api/ai/tag": {
             "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "AuthAPI"
+                    "AIAPI"
                 ],
-                "summary": "用户以用户名、密码和验证码登录",
+                "summary": "使用AI助手为文章生成6个标签",
                 "parameters": [
                     {
-                        "description": "用户名",
-                        "name": "username",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "密码",
-                        "name": "password",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "验证码",
-                        "name": "captcha",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "验证码ID",
-                        "name": "captcha_id",
+                        "description": "文章内容",
+                        "name": "article_content",
                         "in": "body",
                         "required": true,
                         "schema": {
@@ -1171,7 +1237,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.LoginResponse"
+                                            "$ref": "#/definitions/schema.AssistantResponse"
                                         }
                                     }
                                 }
@@ -1184,101 +1250,50 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/auth/logout": {
+        "/api/ai/title": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "AuthAPI"
+                    "AIAPI"
                 ],
-                "summary": "用户退出登录",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/auth/profile": {
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "tags": [
-                    "AuthAPI"
-                ],
-                "summary": "更新用户资料",
+                "summary": "使用AI助手为文章生成5个标题",
                 "parameters": [
                     {
-                        "maxLength": 20,
-                        "minLength": 3,
-                        "description": "用户名",
-                        "name": "username",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "format": "email",
-                        "description": "邮箱",
-                        "name": "email",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "个人简介",
-                        "name": "bio",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "头像URL",
-                        "name": "avatar",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "密码",
-                        "name": "password",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "旧密码",
-                        "name": "old_password",
+                        "description": "文章内容",
+                        "name": "article_content",
                         "in": "body",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
@@ -1296,7 +1311,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.UserResponse"
+                                            "$ref": "#/definitions/schema.AssistantResponse"
                                         }
                                     }
                                 }
@@ -1309,8 +1324,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "429": {
+                        "description": "Too Many Requests",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -1320,48 +1335,40 @@ const docTemplate = `{
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/auth/rbac/enforce": {
+        "/api/auth/avatar": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "RBACAPI"
+                    "AuthAPI"
                 ],
-                "summary": "权限验证测试（仅管理员可用）",
+                "summary": "上传用户头像",
                 "parameters": [
                     {
-                        "description": "主体",
-                        "name": "subject",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "资源",
-                        "name": "object",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "动作",
-                        "name": "action",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "file",
+                        "description": "头像图片文件",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1376,7 +1383,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.EnforcerResponse"
+                                            "$ref": "#/definitions/schema.AvatarResponse"
                                         }
                                     }
                                 }
@@ -1398,64 +1405,85 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/rbac/policies": {
+        "/api/auth/captcha/id": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
+                "tags": [
+                    "AuthAPI"
+                ],
+                "summary": "获取验证码ID",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.Captcha"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     }
+                }
+            }
+        },
+        "/api/auth/captcha/image": {
+            "get": {
+                "produces": [
+                    "image/png"
                 ],
                 "tags": [
-                    "RBACAPI"
+                    "AuthAPI"
                 ],
-                "summary": "获取策略列表（仅管理员可用）",
+                "summary": "响应验证码图片（宽400px x 高160px）",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "页码",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "每页数量",
-                        "name": "page_size",
+                        "type": "string",
+                        "description": "验证码ID",
+                        "name": "id",
                         "in": "query",
                         "required": true
                     },
                     {
-                        "enum": [
-                            "p",
-                            "g"
-                        ],
-                        "type": "string",
-                        "description": "策略类型(p或g)",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "主体",
-                        "name": "subject",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "资源或角色，若策略类型为p，则为资源；若策略类型为g，则为角色",
-                        "name": "object",
+                        "type": "number",
+                        "description": "重新生成验证码（reload=1）",
+                        "name": "reload",
                         "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "验证码图片 - 尺寸: 400x160 像素",
+                        "schema": {
+                            "type": "string"
+                        }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/currentUser": {
+            "get": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "动作，策略类型为g时，该请求参数无效",
-                        "name": "action",
-                        "in": "query"
+                        "ApiKeyAuth": []
                     }
                 ],
+                "tags": [
+                    "AuthAPI"
+                ],
+                "summary": "获取当前用户信息",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -1468,15 +1496,15 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ListPolicyResponse"
+                                            "$ref": "#/definitions/schema.UserResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -1490,34 +1518,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/rbac/policy": {
+        "/api/auth/login": {
             "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
                 "tags": [
-                    "RBACAPI"
+                    "AuthAPI"
                 ],
-                "summary": "添加策略（仅管理员可用）",
+                "summary": "用户以用户名、密码和验证码登录",
                 "parameters": [
                     {
-                        "description": "策略类型(p或g)",
-                        "name": "type",
+                        "description": "用户名",
+                        "name": "username",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "string",
-                            "enum": [
-                                "p",
-                                "g"
-                            ]
+                            "type": "string"
                         }
                     },
                     {
-                        "description": "主体",
-                        "name": "subject",
+                        "description": "密码",
+                        "name": "password",
                         "in": "body",
                         "required": true,
                         "schema": {
@@ -1525,8 +1544,8 @@ const docTemplate = `{
                         }
                     },
                     {
-                        "description": "资源或角色，若策略类型为p，则为资源；若策略类型为g，则为角色",
-                        "name": "object",
+                        "description": "验证码",
+                        "name": "captcha",
                         "in": "body",
                         "required": true,
                         "schema": {
@@ -1534,9 +1553,10 @@ const docTemplate = `{
                         }
                     },
                     {
-                        "description": "动作，策略类型为p时必需",
-                        "name": "action",
+                        "description": "验证码ID",
+                        "name": "captcha_id",
                         "in": "body",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
@@ -1554,7 +1574,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.PolicyItem"
+                                            "$ref": "#/definitions/schema.LoginResponse"
                                         }
                                     }
                                 }
@@ -1567,8 +1587,29 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "409": {
-                        "description": "策略已存在",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "AuthAPI"
+                ],
+                "summary": "用户退出登录",
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -1582,31 +1623,87 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/rbac/policy/{id}": {
-            "delete": {
+        "/api/auth/profile": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "RBACAPI"
+                    "AuthAPI"
                 ],
-                "summary": "移除策略（仅管理员可用）",
+                "summary": "更新用户资料",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "策略ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "maxLength": 20,
+                        "minLength": 3,
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "format": "email",
+                        "description": "邮箱",
+                        "name": "email",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "个人简介",
+                        "name": "bio",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "头像URL",
+                        "name": "avatar",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "密码",
+                        "name": "password",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "旧密码",
+                        "name": "old_password",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.UserResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -1616,7 +1713,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "策略不存在",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -1630,18 +1727,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/auth/register": {
+        "/api/auth/rbac/enforce": {
             "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "AuthAPI"
+                    "RBACAPI"
                 ],
-                "summary": "用户以邮箱、用户名和密码注册",
+                "summary": "权限验证测试（仅管理员可用）",
                 "parameters": [
                     {
-                        "maxLength": 20,
-                        "minLength": 3,
-                        "description": "用户名",
-                        "name": "username",
+                        "description": "主体",
+                        "name": "subject",
                         "in": "body",
                         "required": true,
                         "schema": {
@@ -1649,9 +1749,8 @@ const docTemplate = `{
                         }
                     },
                     {
-                        "format": "email",
-                        "description": "邮箱",
-                        "name": "email",
+                        "description": "资源",
+                        "name": "object",
                         "in": "body",
                         "required": true,
                         "schema": {
@@ -1659,9 +1758,8 @@ const docTemplate = `{
                         }
                     },
                     {
-                        "minLength": 6,
-                        "description": "密码",
-                        "name": "password",
+                        "description": "动作",
+                        "name": "action",
                         "in": "body",
                         "required": true,
                         "schema": {
@@ -1681,7 +1779,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.LoginResponse"
+                                            "$ref": "#/definitions/schema.EnforcerResponse"
                                         }
                                     }
                                 }
@@ -1694,12 +1792,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1709,100 +1801,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles": {
+        "/api/auth/rbac/policies": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "ArticleAPI"
+                    "RBACAPI"
                 ],
-                "summary": "获取（搜索）文章列表",
+                "summary": "获取策略列表（仅管理员可用）",
                 "parameters": [
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "default": 1,
                         "description": "页码",
                         "name": "page",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "maximum": 100,
                         "minimum": 1,
                         "type": "integer",
-                        "default": 10,
-                        "description": "每页容量",
+                        "description": "每页数量",
                         "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "分类ID列表（可多选）",
-                        "name": "category_ids",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "array",
-                        "items": {
-                            "type": "integer"
-                        },
-                        "collectionFormat": "multi",
-                        "description": "标签ID列表（可多选）",
-                        "name": "tag_ids",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "作者名称（current 表示当前用户）",
-                        "name": "author",
-                        "in": "query"
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "enum": [
-                            "published",
-                            "draft"
+                            "p",
+                            "g"
                         ],
                         "type": "string",
-                        "description": "状态",
-                        "name": "status",
+                        "description": "策略类型(p或g)",
+                        "name": "type",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "newest",
-                            "views",
-                            "likes",
-                            "favorites",
-                            "comments"
-                        ],
                         "type": "string",
-                        "default": "newest",
-                        "description": "排序依据",
-                        "name": "sort_by",
+                        "description": "主体",
+                        "name": "subject",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "搜索关键词",
-                        "name": "keyword",
+                        "description": "资源或角色，若策略类型为p，则为资源；若策略类型为g，则为角色",
+                        "name": "object",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "today",
-                            "week",
-                            "month",
-                            "year",
-                            "all"
-                        ],
                         "type": "string",
-                        "default": "all",
-                        "description": "创建时间范围",
-                        "name": "time_range",
+                        "description": "动作，策略类型为g时，该请求参数无效",
+                        "name": "action",
                         "in": "query"
                     }
                 ],
@@ -1818,7 +1871,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
+                                            "$ref": "#/definitions/schema.ListPolicyResponse"
                                         }
                                     }
                                 }
@@ -1838,7 +1891,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/auth/rbac/policy": {
             "post": {
                 "security": [
                     {
@@ -1846,69 +1901,45 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "ArticleAPI"
+                    "RBACAPI"
                 ],
-                "summary": "创建文章",
+                "summary": "添加策略（仅管理员可用）",
                 "parameters": [
                     {
-                        "description": "文章标题",
-                        "name": "title",
+                        "description": "策略类型(p或g)",
+                        "name": "type",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "文章内容",
-                        "name": "content",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
+                            "type": "string",
+                            "enum": [
+                                "p",
+                                "g"
+                            ]
                         }
                     },
                     {
-                        "description": "文章摘要",
-                        "name": "summary",
+                        "description": "主体",
+                        "name": "subject",
                         "in": "body",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "minimum": 1,
-                        "description": "文章分类ID",
-                        "name": "category_id",
-                        "in": "body",
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    {
-                        "description": "文章标签ID列表",
-                        "name": "tag_ids",
-                        "in": "body",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "integer"
-                            }
-                        }
-                    },
-                    {
-                        "description": "文章封面图片URL",
-                        "name": "cover",
+                        "description": "资源或角色，若策略类型为p，则为资源；若策略类型为g，则为角色",
+                        "name": "object",
                         "in": "body",
+                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "文章状态",
-                        "name": "status",
+                        "description": "动作，策略类型为p时必需",
+                        "name": "action",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "string"
                         }
@@ -1926,7 +1957,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ArticleResponse"
+                                            "$ref": "#/definitions/schema.PolicyItem"
                                         }
                                     }
                                 }
@@ -1939,8 +1970,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "409": {
+                        "description": "策略已存在",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -1954,53 +1985,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/commented": {
-            "get": {
+        "/api/auth/rbac/policy/{id}": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "ArticleAPI"
+                    "RBACAPI"
                 ],
-                "summary": "获取用户评论过的文章",
+                "summary": "移除策略（仅管理员可用）",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 1,
-                        "description": "页数",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
                         "type": "integer",
-                        "default": 10,
-                        "description": "页容量",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "策略ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
                     "400": {
@@ -2009,6 +2018,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "404": {
+                        "description": "策略不存在",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2018,34 +2033,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/favorites": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
+        "/api/auth/register": {
+            "post": {
                 "tags": [
-                    "ArticleAPI"
+                    "AuthAPI"
                 ],
-                "summary": "获取用户收藏的文章",
+                "summary": "用户以邮箱、用户名和密码注册",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 1,
-                        "description": "页数",
-                        "name": "page",
-                        "in": "query"
+                        "maxLength": 20,
+                        "minLength": 3,
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     },
                     {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 10,
-                        "description": "页容量",
-                        "name": "page_size",
-                        "in": "query"
+                        "format": "email",
+                        "description": "邮箱",
+                        "name": "email",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "minLength": 6,
+                        "description": "密码",
+                        "name": "password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
@@ -2060,7 +2084,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
+                                            "$ref": "#/definitions/schema.LoginResponse"
                                         }
                                     }
                                 }
@@ -2073,6 +2097,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2082,23 +2112,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/history": {
+        "/api/blog/articles": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "获取用户浏览历史",
+                "summary": "获取（搜索）文章列表",
                 "parameters": [
                     {
                         "minimum": 1,
                         "type": "integer",
                         "default": 1,
-                        "description": "页数",
+                        "description": "页码",
                         "name": "page",
                         "in": "query"
                     },
@@ -2107,9 +2132,84 @@ const docTemplate = `{
                         "minimum": 1,
                         "type": "integer",
                         "default": 10,
-                        "description": "页容量",
+                        "description": "每页容量",
                         "name": "page_size",
                         "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "分类ID列表（可多选）",
+                        "name": "category_ids",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "标签ID列表（可多选）",
+                        "name": "tag_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "作者名称（current 表示当前用户）",
+                        "name": "author",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "published",
+                            "draft",
+                            "pending",
+                            "rejected",
+                            "changes_requested"
+                        ],
+                        "type": "string",
+                        "description": "状态（仅在 author=current 时可查询已发布以外的状态，其他情况只返回已发布的文章）",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "newest",
+                            "views",
+                            "likes",
+                            "favorites",
+                            "comments"
+                        ],
+                        "type": "string",
+                        "default": "newest",
+                        "description": "排序依据",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "搜索关键词",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "today",
+                            "week",
+                            "month",
+                            "year",
+                            "all"
+                        ],
+                        "type": "string",
+                        "default": "all",
+                        "description": "创建时间范围",
+                        "name": "time_range",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2144,21 +2244,80 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/blog/articles/hot": {
-            "get": {
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "获取热门文章",
+                "summary": "创建文章",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 5,
-                        "description": "限制数量",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "文章标题",
+                        "name": "title",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "文章内容",
+                        "name": "content",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "文章摘要",
+                        "name": "summary",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "minimum": 1,
+                        "description": "文章分类ID",
+                        "name": "category_id",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "文章标签ID列表",
+                        "name": "tag_ids",
+                        "in": "body",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    {
+                        "description": "文章封面图片URL",
+                        "name": "cover",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "文章状态",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
@@ -2173,10 +2332,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.ArticleListItem"
-                                            }
+                                            "$ref": "#/definitions/schema.ArticleResponse"
                                         }
                                     }
                                 }
@@ -2189,6 +2345,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2198,18 +2360,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/latest": {
+        "/api/blog/articles/commented": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "获取最新文章",
+                "summary": "获取用户评论过的文章",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "default": 5,
-                        "description": "限制数量",
-                        "name": "limit",
+                        "default": 1,
+                        "description": "页数",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
                         "in": "query"
                     }
                 ],
@@ -2225,10 +2402,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.ArticleListItem"
-                                            }
+                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
                                         }
                                     }
                                 }
@@ -2250,7 +2424,7 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/liked": {
+        "/api/blog/articles/continue": {
             "get": {
                 "security": [
                     {
@@ -2260,7 +2434,7 @@ const docTemplate = `{
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "获取用户点赞的文章",
+                "summary": "获取继续阅读列表（未读完的文章）",
                 "parameters": [
                     {
                         "minimum": 1,
@@ -2314,30 +2488,48 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/upload-cover": {
-            "post": {
+        "/api/blog/articles/duplicates": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "consumes": [
-                    "multipart/form-data"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "上传文章封面",
+                "summary": "获取疑似重复文章报告（仅管理员可用）",
                 "parameters": [
                     {
-                        "type": "file",
-                        "description": "文章封面图片",
-                        "name": "cover",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "被检测的文章ID",
+                        "name": "article_id",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 1,
+                        "minimum": 0,
+                        "type": "number",
+                        "description": "最低相似度",
+                        "name": "min_similarity",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2352,7 +2544,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CoverResponse"
+                                            "$ref": "#/definitions/schema.ArticleDuplicatePaginationResult"
                                         }
                                     }
                                 }
@@ -2365,6 +2557,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2374,20 +2572,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/{id}": {
+        "/api/blog/articles/favorites": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "获取文章详情",
+                "summary": "获取用户收藏的文章",
                 "parameters": [
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "description": "文章ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "页数",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2402,7 +2614,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ArticleResponse"
+                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
                                         }
                                     }
                                 }
@@ -2415,12 +2627,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2428,8 +2634,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/blog/articles/history": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -2438,74 +2646,73 @@ const docTemplate = `{
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "更新文章",
+                "summary": "获取用户浏览历史",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "文章ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "页数",
+                        "name": "page",
+                        "in": "query"
                     },
                     {
-                        "description": "文章标题",
-                        "name": "title",
-                        "in": "body",
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
-                    {
-                        "description": "文章内容",
-                        "name": "content",
-                        "in": "body",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    {
-                        "description": "文章摘要",
-                        "name": "summary",
-                        "in": "body",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
-                    },
-                    {
-                        "minimum": 1,
-                        "description": "文章分类ID",
-                        "name": "category_id",
-                        "in": "body",
-                        "schema": {
-                            "type": "integer"
-                        }
-                    },
-                    {
-                        "description": "文章标签ID列表",
-                        "name": "tag_ids",
-                        "in": "body",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "integer"
-                            }
-                        }
-                    },
-                    {
-                        "description": "文章封面图片URL",
-                        "name": "cover",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
+                    }
+                }
+            }
+        },
+        "/api/blog/articles/hot": {
+            "get": {
+                "tags": [
+                    "ArticleAPI"
+                ],
+                "summary": "获取热门文章",
+                "parameters": [
                     {
-                        "description": "文章状态",
-                        "name": "status",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "integer",
+                        "default": 5,
+                        "description": "限制数量",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2520,7 +2727,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ArticleResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.ArticleListItem"
+                                            }
                                         }
                                     }
                                 }
@@ -2533,14 +2743,54 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
+                    }
+                }
+            }
+        },
+        "/api/blog/articles/latest": {
+            "get": {
+                "tags": [
+                    "ArticleAPI"
+                ],
+                "summary": "获取最新文章",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 5,
+                        "description": "限制数量",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.ArticleListItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -2552,8 +2802,10 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/blog/articles/liked": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -2562,21 +2814,43 @@ const docTemplate = `{
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "删除文章",
+                "summary": "获取用户点赞的文章",
                 "parameters": [
                     {
+                        "minimum": 1,
                         "type": "integer",
-                        "description": "文章ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "页数",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -2585,18 +2859,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2606,8 +2868,8 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/{id}/favorite": {
-            "post": {
+        "/api/blog/articles/review": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
@@ -2616,14 +2878,59 @@ const docTemplate = `{
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "收藏/取消收藏文章",
+                "summary": "获取文章审核队列（仅审核人员可用）",
                 "parameters": [
+                    {
+                        "enum": [
+                            "pending",
+                            "rejected",
+                            "changes_requested"
+                        ],
+                        "type": "string",
+                        "default": "pending",
+                        "description": "文章状态，默认为待审核",
+                        "name": "status",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "文章ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "作者ID",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "标题关键词",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "按提交时间排序",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -2638,7 +2945,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ArticleInteractionResponse"
+                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
                                         }
                                     }
                                 }
@@ -2651,6 +2958,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2660,23 +2973,29 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/articles/{id}/like": {
+        "/api/blog/articles/upload-cover": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "ArticleAPI"
                 ],
-                "summary": "点赞/取消点赞文章",
+                "summary": "上传文章封面",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "文章ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "file",
+                        "description": "文章封面图片",
+                        "name": "cover",
+                        "in": "formData",
                         "required": true
                     }
                 ],
@@ -2692,7 +3011,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.ArticleInteractionResponse"
+                                            "$ref": "#/definitions/schema.CoverResponse"
                                         }
                                     }
                                 }
@@ -2714,12 +3033,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/categories": {
+        "/api/blog/articles/{id}": {
             "get": {
                 "tags": [
-                    "CategoryAPI"
+                    "ArticleAPI"
+                ],
+                "summary": "获取文章详情",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "获取所有分类（目前该 API 没用上）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -2732,47 +3061,106 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.CategoryResponse"
-                                            }
+                                            "$ref": "#/definitions/schema.ArticleResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
-                    }
-                }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "CategoryAPI"
+                    "ArticleAPI"
                 ],
-                "summary": "创建分类（仅管理员可用）",
+                "summary": "更新文章",
                 "parameters": [
                     {
-                        "description": "分类名称",
-                        "name": "name",
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "文章标题",
+                        "name": "title",
                         "in": "body",
-                        "required": true,
                         "schema": {
                             "type": "string"
                         }
                     },
                     {
-                        "description": "分类描述",
-                        "name": "description",
+                        "description": "文章内容",
+                        "name": "content",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "文章摘要",
+                        "name": "summary",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "minimum": 1,
+                        "description": "文章分类ID",
+                        "name": "category_id",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "文章标签ID列表",
+                        "name": "tag_ids",
+                        "in": "body",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    {
+                        "description": "文章封面图片URL",
+                        "name": "cover",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "文章状态",
+                        "name": "status",
                         "in": "body",
                         "schema": {
                             "type": "string"
@@ -2791,7 +3179,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CategoryResponse"
+                                            "$ref": "#/definitions/schema.ArticleResponse"
                                         }
                                     }
                                 }
@@ -2804,8 +3192,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -2817,90 +3211,31 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/blog/categories/paginate": {
-            "get": {
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "CategoryAPI"
+                    "ArticleAPI"
                 ],
-                "summary": "获取分类列表（带分页）",
+                "summary": "删除文章",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 1,
-                        "description": "页码",
-                        "name": "page",
-                        "in": "query"
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
                         "type": "integer",
-                        "default": 10,
-                        "description": "每页容量",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照分类ID排序",
-                        "name": "sort_by_id",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照分类的文章数量排序",
-                        "name": "sort_by_article_count",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照创建时间排序",
-                        "name": "sort_by_create",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "description": "如何按照更新时间排序",
-                        "name": "sort_by_update",
-                        "in": "query"
+                        "description": "文章ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.CategoryPaginationResult"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
                     "400": {
@@ -2909,6 +3244,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2918,17 +3265,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/categories/{id}": {
-            "get": {
+        "/api/blog/articles/{id}/favorite": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "CategoryAPI"
+                    "ArticleAPI"
                 ],
-                "summary": "获取分类详情",
+                "summary": "收藏/取消收藏文章",
                 "parameters": [
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "description": "分类ID",
+                        "description": "文章ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2946,7 +3297,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CategoryResponse"
+                                            "$ref": "#/definitions/schema.ArticleInteractionResponse"
                                         }
                                     }
                                 }
@@ -2959,12 +3310,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -2972,41 +3317,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/blog/articles/{id}/like": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "CategoryAPI"
+                    "ArticleAPI"
                 ],
-                "summary": "更新分类（仅管理员可用）",
+                "summary": "点赞/取消点赞文章",
                 "parameters": [
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "description": "分类ID",
+                        "description": "文章ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "分类名称",
-                        "name": "name",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    {
-                        "description": "分类描述",
-                        "name": "description",
-                        "in": "body",
-                        "schema": {
-                            "type": "string"
-                        }
                     }
                 ],
                 "responses": {
@@ -3021,7 +3351,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CategoryResponse"
+                                            "$ref": "#/definitions/schema.ArticleInteractionResponse"
                                         }
                                     }
                                 }
@@ -3034,12 +3364,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3047,22 +3371,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
+            }
+        },
+        "/api/blog/articles/{id}/progress": {
+            "get": {
+                "security": [
+                    {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "CategoryAPI"
+                    "ArticleAPI"
                 ],
-                "summary": "删除分类（仅管理员可用）",
+                "summary": "获取文章阅读进度",
                 "parameters": [
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "description": "分类ID",
+                        "description": "文章ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3072,7 +3397,19 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ReadingProgressResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
@@ -3081,12 +3418,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3094,14 +3425,35 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/blog/tags": {
-            "get": {
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "TagAPI"
+                    "ArticleAPI"
+                ],
+                "summary": "上报文章阅读进度",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "阅读进度",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.ReadingProgressRequest"
+                        }
+                    }
                 ],
-                "summary": "获取所有标签",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -3114,16 +3466,19 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.TagResponse"
-                                            }
+                                            "$ref": "#/definitions/schema.ReadingProgressResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3131,7 +3486,9 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/blog/articles/{id}/review": {
             "post": {
                 "security": [
                     {
@@ -3139,19 +3496,80 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "TagAPI"
+                    "ArticleAPI"
                 ],
-                "summary": "创建标签",
+                "summary": "审核文章（仅审核人员可用）",
                 "parameters": [
                     {
-                        "description": "标签名称",
-                        "name": "name",
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "审核信息",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/schema.ReviewArticleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     }
+                }
+            }
+        },
+        "/api/blog/articles/{id}/reviews": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "ArticleAPI"
+                ],
+                "summary": "获取文章审核历史（仅作者与审核人员可用）",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
@@ -3165,7 +3583,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.TagResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.ArticleReviewResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -3178,6 +3599,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3187,21 +3614,69 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/tags/hot": {
+        "/api/blog/categories": {
             "get": {
                 "tags": [
-                    "TagAPI"
+                    "CategoryAPI"
                 ],
-                "summary": "获取热门标签",
+                "summary": "获取所有分类（目前该 API 没用上）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.CategoryResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CategoryAPI"
+                ],
+                "summary": "创建分类（仅管理员可用）",
                 "parameters": [
                     {
-                        "maximum": 50,
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 10,
-                        "description": "限制",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "分类名称",
+                        "name": "name",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "分类描述",
+                        "name": "description",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
@@ -3216,10 +3691,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.TagResponse"
-                                            }
+                                            "$ref": "#/definitions/schema.CategoryResponse"
                                         }
                                     }
                                 }
@@ -3232,6 +3704,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3241,12 +3719,12 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/tags/paginate": {
+        "/api/blog/categories/paginate": {
             "get": {
                 "tags": [
-                    "TagAPI"
+                    "CategoryAPI"
                 ],
-                "summary": "获取标签列表（带分页）",
+                "summary": "获取分类列表（带分页）",
                 "parameters": [
                     {
                         "minimum": 1,
@@ -3271,7 +3749,7 @@ const docTemplate = `{
                             "asc"
                         ],
                         "type": "string",
-                        "description": "如何按照标签ID排序",
+                        "description": "如何按照分类ID排序",
                         "name": "sort_by_id",
                         "in": "query"
                     },
@@ -3281,7 +3759,7 @@ const docTemplate = `{
                             "asc"
                         ],
                         "type": "string",
-                        "description": "如何按照标签的文章数量排序",
+                        "description": "如何按照分类的文章数量排序",
                         "name": "sort_by_article_count",
                         "in": "query"
                     },
@@ -3318,7 +3796,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.TagPaginationResult"
+                                            "$ref": "#/definitions/schema.CategoryPaginationResult"
                                         }
                                     }
                                 }
@@ -3340,17 +3818,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/blog/tags/{id}": {
+        "/api/blog/categories/{id}": {
             "get": {
                 "tags": [
-                    "TagAPI"
+                    "CategoryAPI"
                 ],
-                "summary": "获取标签详情",
+                "summary": "获取分类详情",
                 "parameters": [
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "description": "标签ID",
+                        "description": "分类ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3368,7 +3846,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.TagResponse"
+                                            "$ref": "#/definitions/schema.CategoryResponse"
                                         }
                                     }
                                 }
@@ -3402,23 +3880,30 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "TagAPI"
+                    "CategoryAPI"
                 ],
-                "summary": "更新标签（仅管理员可用）",
+                "summary": "更新分类（仅管理员可用）",
                 "parameters": [
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "description": "标签ID",
+                        "description": "分类ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "标签名称",
+                        "description": "分类名称",
                         "name": "name",
                         "in": "body",
-                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "分类描述",
+                        "name": "description",
+                        "in": "body",
                         "schema": {
                             "type": "string"
                         }
@@ -3436,7 +3921,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.TagResponse"
+                                            "$ref": "#/definitions/schema.CategoryResponse"
                                         }
                                     }
                                 }
@@ -3455,12 +3940,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3476,14 +3955,14 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "TagAPI"
+                    "CategoryAPI"
                 ],
-                "summary": "删除标签（仅管理员可用）",
+                "summary": "删除分类（仅管理员可用）",
                 "parameters": [
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "description": "标签ID",
+                        "description": "分类ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -3502,6 +3981,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3511,7 +3996,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/comment": {
+        "/api/blog/folders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "FavoriteFolderAPI"
+                ],
+                "summary": "获取当前用户的收藏夹列表",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.FavoriteFolderResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
             "post": {
                 "security": [
                     {
@@ -3519,36 +4044,132 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "CommentAPI"
+                    "FavoriteFolderAPI"
                 ],
-                "summary": "创建评论（会增加文章评论数、响应刚创建评论的全部信息）",
+                "summary": "创建收藏夹",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "description": "文章ID",
-                        "name": "article_id",
+                        "description": "收藏夹信息",
+                        "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "integer"
+                            "$ref": "#/definitions/schema.CreateFavoriteFolderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.FavoriteFolderResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/blog/folders/user/{user_id}": {
+            "get": {
+                "tags": [
+                    "FavoriteFolderAPI"
+                ],
+                "summary": "获取指定用户的收藏夹列表（非本人仅返回公开收藏夹）",
+                "parameters": [
                     {
-                        "description": "评论内容",
-                        "name": "content",
-                        "in": "body",
-                        "required": true,
+                        "type": "integer",
+                        "description": "用户ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.FavoriteFolderResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/blog/folders/{id}": {
+            "put": {
+                "security": [
                     {
-                        "minimum": 1,
-                        "description": "父评论ID",
-                        "name": "parent_id",
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "FavoriteFolderAPI"
+                ],
+                "summary": "更新收藏夹",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "收藏夹ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "收藏夹信息",
+                        "name": "body",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "integer"
+                            "$ref": "#/definitions/schema.UpdateFavoriteFolderRequest"
                         }
                     }
                 ],
@@ -3564,7 +4185,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CommentResponse"
+                                            "$ref": "#/definitions/schema.FavoriteFolderResponse"
                                         }
                                     }
                                 }
@@ -3577,14 +4198,42 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "FavoriteFolderAPI"
+                ],
+                "summary": "删除收藏夹（其中的收藏移回默认收藏夹）",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "收藏夹ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
@@ -3598,18 +4247,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/comment/article/{article_id}": {
+        "/api/blog/folders/{id}/articles": {
             "get": {
                 "tags": [
-                    "CommentAPI"
+                    "FavoriteFolderAPI"
                 ],
-                "summary": "获取文章的顶级评论",
+                "summary": "获取收藏夹中的文章（私有收藏夹仅所有者可见）",
                 "parameters": [
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "description": "文章ID",
-                        "name": "article_id",
+                        "description": "收藏夹ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
@@ -3617,29 +4265,18 @@ const docTemplate = `{
                         "minimum": 1,
                         "type": "integer",
                         "default": 1,
-                        "description": "页码",
+                        "description": "页数",
                         "name": "page",
                         "in": "query"
                     },
                     {
-                        "maximum": 30,
+                        "maximum": 100,
                         "minimum": 1,
                         "type": "integer",
                         "default": 10,
                         "description": "页容量",
                         "name": "page_size",
                         "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
-                        "type": "string",
-                        "default": "desc",
-                        "description": "排序方式",
-                        "name": "sort_by_create",
-                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -3654,7 +4291,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CommentPaginationResult"
+                                            "$ref": "#/definitions/schema.ArticlePaginationResult"
                                         }
                                     }
                                 }
@@ -3674,156 +4311,40 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/comment/review": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "CommentAPI"
+                    "FavoriteFolderAPI"
                 ],
-                "summary": "获取评论列表（适用于审核和管理，仅管理员可用）",
+                "summary": "收藏文章到指定收藏夹",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "文章ID",
-                        "name": "article_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "评论作者ID",
-                        "name": "author_id",
-                        "in": "query"
+                        "description": "收藏夹ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "父评论ID",
-                        "name": "parent_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "根评论ID",
-                        "name": "root_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "评论层级",
-                        "name": "level",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            0,
-                            1,
-                            2
-                        ],
-                        "type": "integer",
-                        "description": "评论状态：0-待审核，1-已通过，2-已拒绝",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "关键词",
-                        "name": "keyword",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "创建开始时间，格式：2006-01-02 15:04:05",
-                        "name": "create_start_time",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "创建结束时间，格式：2006-01-02 15:04:05",
-                        "name": "create_end_time",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "审核开始时间，格式：2006-01-02 15:04:05",
-                        "name": "review_start_time",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "审核结束时间，格式：2006-01-02 15:04:05",
-                        "name": "review_end_time",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "审核人员ID",
-                        "name": "reviewer_id",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "create",
-                            "review"
-                        ],
-                        "type": "string",
-                        "default": "create",
-                        "description": "排序字段：create-创建时间，review-审核时间",
-                        "name": "sort_by",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "desc",
-                            "asc"
-                        ],
-                        "type": "string",
-                        "default": "desc",
-                        "description": "排序方式：desc-降序，asc-升序",
-                        "name": "sort_order",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 1,
-                        "description": "页码",
-                        "name": "page",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "maximum": 100,
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 10,
-                        "description": "页容量",
-                        "name": "page_size",
-                        "in": "query",
-                        "required": true
+                        "description": "文章信息",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddFavoriteFolderItemRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.CommentPaginationResult"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
                     "400": {
@@ -3839,47 +4360,41 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/blog/folders/{id}/articles/{article_id}": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "CommentAPI"
+                    "FavoriteFolderAPI"
                 ],
-                "summary": "审核评论（仅管理员可用）",
+                "summary": "将收藏的文章移动到另一个收藏夹",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "description": "评论ID",
-                        "name": "comment_id",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer"
-                        }
+                        "type": "integer",
+                        "description": "收藏夹ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "description": "审核状态：1-通过，2-拒绝",
-                        "name": "status",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "integer",
-                            "enum": [
-                                1,
-                                2
-                            ]
-                        }
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "description": "审核备注",
-                        "name": "review_remark",
+                        "description": "目标收藏夹",
+                        "name": "body",
                         "in": "body",
+                        "required": true,
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/schema.MoveFavoriteFolderItemRequest"
                         }
                     }
                 ],
@@ -3896,12 +4411,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -3909,55 +4418,38 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/comment/user": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "CommentAPI"
+                    "FavoriteFolderAPI"
                 ],
-                "summary": "获取用户的评论",
+                "summary": "将文章从收藏夹中移除（取消收藏）",
                 "parameters": [
                     {
-                        "minimum": 1,
                         "type": "integer",
-                        "default": 1,
-                        "description": "页码",
-                        "name": "page",
-                        "in": "query"
+                        "description": "收藏夹ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "maximum": 30,
-                        "minimum": 1,
                         "type": "integer",
-                        "default": 10,
-                        "description": "页容量",
-                        "name": "page_size",
-                        "in": "query"
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.CommentPaginationResult"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
                     "400": {
@@ -3975,39 +4467,55 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/comment/{id}": {
+        "/api/blog/oembed": {
             "get": {
+                "produces": [
+                    "application/json",
+                    "text/xml"
+                ],
                 "tags": [
-                    "CommentAPI"
+                    "ShareAPI"
                 ],
-                "summary": "获取评论详情",
+                "summary": "获取文章链接的 oEmbed 数据",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "文章链接",
+                        "name": "url",
+                        "in": "query",
+                        "required": true
+                    },
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "description": "评论ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "最大宽度",
+                        "name": "maxwidth",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "最大高度",
+                        "name": "maxheight",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "xml"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "响应格式",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.CommentResponse"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/schema.OEmbedResponse"
                         }
                     },
                     "400": {
@@ -4022,83 +4530,27 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "501": {
+                        "description": "Not Implemented",
                         "schema": {
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/blog/pages": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "CommentAPI"
+                    "PageAPI"
                 ],
-                "summary": "删除评论（回复也会被删除、文章评论数也会减少）",
+                "summary": "获取独立页面列表（仅管理员可用）",
                 "parameters": [
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "评论ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/comment/{id}/replies": {
-            "get": {
-                "tags": [
-                    "CommentAPI"
-                ],
-                "summary": "获取顶级评论的所有回复（扁平化列表，适合前端显示）",
-                "parameters": [
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "description": "评论ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "minimum": 1,
                         "type": "integer",
@@ -4108,38 +4560,28 @@ const docTemplate = `{
                         "in": "query"
                     },
                     {
-                        "maximum": 30,
+                        "maximum": 100,
                         "minimum": 1,
                         "type": "integer",
                         "default": 10,
-                        "description": "页容量",
+                        "description": "每页容量",
                         "name": "page_size",
                         "in": "query"
                     },
                     {
-                        "type": "boolean",
-                        "default": false,
-                        "description": "是否包含回复的回复",
-                        "name": "include_replies",
-                        "in": "query"
-                    },
-                    {
-                        "minimum": 0,
-                        "type": "integer",
-                        "default": 0,
-                        "description": "回复层数限制，i (i\u003e0) 表示获取到第 i 层回复，0表示不限制",
-                        "name": "max_depth",
+                        "enum": [
+                            "published",
+                            "draft"
+                        ],
+                        "type": "string",
+                        "description": "页面状态",
+                        "name": "status",
                         "in": "query"
                     },
                     {
-                        "enum": [
-                            "asc",
-                            "desc"
-                        ],
                         "type": "string",
-                        "default": "asc",
-                        "description": "排序方式",
-                        "name": "sort_by_create",
+                        "description": "按标题或标识搜索",
+                        "name": "keyword",
                         "in": "query"
                     }
                 ],
@@ -4155,7 +4597,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CommentPaginationResult"
+                                            "$ref": "#/definitions/schema.PagePaginationResult"
                                         }
                                     }
                                 }
@@ -4168,12 +4610,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4181,27 +4617,26 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/stat/activity": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "StatAPI"
+                    "PageAPI"
                 ],
-                "summary": "获取用户活跃度数据（仅管理员可用）",
+                "summary": "创建独立页面（仅管理员可用）",
                 "parameters": [
                     {
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 7,
-                        "description": "天数",
-                        "name": "days",
-                        "in": "query"
+                        "description": "页面信息",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.CreatePageRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -4216,10 +4651,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.UserActivityTrendItem"
-                                            }
+                                            "$ref": "#/definitions/schema.PageResponse"
                                         }
                                     }
                                 }
@@ -4232,6 +4664,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4241,27 +4679,12 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/stat/articles/creation": {
+        "/api/blog/pages/nav": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
                 "tags": [
-                    "StatAPI"
-                ],
-                "summary": "获取文章创作时间统计（仅管理员可用）",
-                "parameters": [
-                    {
-                        "minimum": 1,
-                        "type": "integer",
-                        "default": 30,
-                        "description": "天数",
-                        "name": "days",
-                        "in": "query"
-                    }
+                    "PageAPI"
                 ],
+                "summary": "获取导航中的独立页面",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4276,7 +4699,7 @@ const docTemplate = `{
                                         "data": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/schema.ArticleCreationTimeStatsItem"
+                                                "$ref": "#/definitions/schema.PageNavItem"
                                             }
                                         }
                                     }
@@ -4284,12 +4707,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/util.ResponseResult"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4299,17 +4716,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/stat/cache": {
+        "/api/blog/pages/slug/{slug}": {
             "get": {
-                "security": [
+                "tags": [
+                    "PageAPI"
+                ],
+                "summary": "通过页面标识获取已发布的独立页面",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "type": "string",
+                        "description": "页面标识",
+                        "name": "slug",
+                        "in": "path",
+                        "required": true
                     }
                 ],
-                "tags": [
-                    "StatAPI"
-                ],
-                "summary": "获取缓存信息（仅管理员可用）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4322,22 +4743,49 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CacheInfo"
+                                            "$ref": "#/definitions/schema.PageResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/stat/categories": {
+        "/api/blog/pages/{id}": {
             "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "tags": [
-                    "StatAPI"
+                    "PageAPI"
+                ],
+                "summary": "获取独立页面详情（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "页面ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "获取文章分类分布",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4350,16 +4798,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.CategoryDistItem"
-                                            }
+                                            "$ref": "#/definitions/schema.PageResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4367,19 +4824,36 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/stat/comments": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "StatAPI"
+                    "PageAPI"
+                ],
+                "summary": "更新独立页面（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "页面ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "页面信息",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdatePageRequest"
+                        }
+                    }
                 ],
-                "summary": "获取所有评论的统计数据（仅管理员可用）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4392,83 +4866,88 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.CommentStatisticResponse"
+                                            "$ref": "#/definitions/schema.PageResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
-            }
-        },
-        "/api/stat/cpu": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "StatAPI"
+                    "PageAPI"
                 ],
-                "summary": "获取 CPU 信息（仅管理员可用）",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.CPUInfo"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/api/stat/db": {
-            "get": {
-                "security": [
+                "summary": "删除独立页面（仅管理员可用）",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "页面ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
-                "tags": [
-                    "StatAPI"
-                ],
-                "summary": "获取数据库信息（仅管理员可用）",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.DatabaseInfo"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
                         }
                     }
                 }
             }
         },
-        "/api/stat/disk": {
+        "/api/blog/pages/{id}/revisions": {
             "get": {
                 "security": [
                     {
@@ -4476,42 +4955,19 @@ const docTemplate = `{
                     }
                 ],
                 "tags": [
-                    "StatAPI"
+                    "PageAPI"
                 ],
-                "summary": "获取硬盘信息（仅管理员可用）",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/util.ResponseResult"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "data": {
-                                            "$ref": "#/definitions/schema.DiskInfo"
-                                        }
-                                    }
-                                }
-                            ]
-                        }
-                    }
-                }
-            }
-        },
-        "/api/stat/go": {
-            "get": {
-                "security": [
+                "summary": "获取独立页面的历史版本（仅管理员可用）",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "页面ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
-                "tags": [
-                    "StatAPI"
-                ],
-                "summary": "获取 GO 运行时信息（仅管理员可用）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4524,105 +4980,72 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.GoRuntimeInfo"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.PageRevisionResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/stat/logger": {
-            "get": {
+        "/api/blog/pages/{id}/revisions/{revision_id}/restore": {
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "StatAPI"
+                    "PageAPI"
                 ],
-                "summary": "获取日志列表（仅管理员可用）",
+                "summary": "将独立页面恢复到历史版本（仅管理员可用）",
                 "parameters": [
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "default": 1,
-                        "description": "页码",
-                        "name": "page",
-                        "in": "query"
+                        "description": "页面ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "maximum": 100,
                         "minimum": 1,
                         "type": "integer",
-                        "default": 10,
-                        "description": "每页容量",
-                        "name": "page_size",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "debug",
-                            "info",
-                            "warn",
-                            "error",
-                            "dpanic",
-                            "panic",
-                            "fatal"
-                        ],
-                        "type": "string",
-                        "description": "日志级别",
-                        "name": "level",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "追踪ID",
-                        "name": "trace_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "用户名关键词",
-                        "name": "username",
-                        "in": "query"
-                    },
-                    {
-                        "enum": [
-                            "main",
-                            "recovery",
-                            "request",
-                            "login",
-                            "logout",
-                            "system",
-                            "operate",
-                            "ai"
-                        ],
-                        "type": "string",
-                        "description": "日志标签",
-                        "name": "tag",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "日志消息关键词",
-                        "name": "message",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "开始时间",
-                        "name": "start_time",
-                        "in": "query"
+                        "description": "历史版本ID",
+                        "name": "revision_id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "结束时间",
-                        "name": "end_time",
-                        "in": "query"
+                        "description": "修改说明",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.RestorePageRevisionRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -4637,7 +5060,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.LoggerPaginationResult"
+                                            "$ref": "#/definitions/schema.PageResponse"
                                         }
                                     }
                                 }
@@ -4656,6 +5079,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4665,17 +5094,12 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/stat/memory": {
+        "/api/blog/tags": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
                 "tags": [
-                    "StatAPI"
+                    "TagAPI"
                 ],
-                "summary": "获取内存信息（仅管理员可用）",
+                "summary": "获取所有标签",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4688,27 +5112,45 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.MemoryInfo"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.TagResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
-            }
-        },
-        "/api/stat/overview": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "StatAPI"
+                    "TagAPI"
+                ],
+                "summary": "创建标签",
+                "parameters": [
+                    {
+                        "description": "标签名称",
+                        "name": "name",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 ],
-                "summary": "获取站点概览统计信息（仅管理员可用）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4721,27 +5163,45 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteOverviewResponse"
+                                            "$ref": "#/definitions/schema.TagResponse"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/stat/system": {
+        "/api/blog/tags/hot": {
             "get": {
-                "security": [
+                "tags": [
+                    "TagAPI"
+                ],
+                "summary": "获取热门标签",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "maximum": 50,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "限制",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
-                "tags": [
-                    "StatAPI"
-                ],
-                "summary": "获取系统信息（仅管理员可用）",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4754,27 +5214,96 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SystemInfo"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.TagResponse"
+                                            }
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/stat/user/articles": {
+        "/api/blog/tags/paginate": {
             "get": {
-                "security": [
+                "tags": [
+                    "TagAPI"
+                ],
+                "summary": "获取标签列表（带分页）",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照标签ID排序",
+                        "name": "sort_by_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照标签的文章数量排序",
+                        "name": "sort_by_article_count",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照创建时间排序",
+                        "name": "sort_by_create",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "description": "如何按照更新时间排序",
+                        "name": "sort_by_update",
+                        "in": "query"
                     }
                 ],
-                "tags": [
-                    "StatAPI"
-                ],
-                "summary": "获取用户文章统计信息",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4787,34 +5316,49 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.SiteOverviewResponse"
+                                            "$ref": "#/definitions/schema.TagPaginationResult"
                                         }
                                     }
                                 }
                             ]
                         }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
                     }
                 }
             }
         },
-        "/api/stat/user/articles/visits": {
+        "/api/blog/tags/suggest": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
                 "tags": [
-                    "StatAPI"
+                    "TagAPI"
                 ],
-                "summary": "获取用户文章访问趋势",
+                "summary": "标签联想（支持前缀、拼音首字母与模糊匹配）",
                 "parameters": [
+                    {
+                        "maxLength": 50,
+                        "type": "string",
+                        "description": "输入关键词",
+                        "name": "keyword",
+                        "in": "query",
+                        "required": true
+                    },
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "default": 7,
-                        "description": "天数",
-                        "name": "days",
+                        "default": 10,
+                        "description": "返回数量",
+                        "name": "limit",
                         "in": "query"
                     }
                 ],
@@ -4830,7 +5374,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/schema.UserArticleVisitTrendResponse"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.TagResponse"
+                                            }
                                         }
                                     }
                                 }
@@ -4852,17 +5399,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/stat/user/categories": {
+        "/api/blog/tags/{id}": {
             "get": {
-                "security": [
+                "tags": [
+                    "TagAPI"
+                ],
+                "summary": "获取标签详情",
+                "parameters": [
                     {
-                        "ApiKeyAuth": []
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "标签ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
-                "tags": [
-                    "StatAPI"
-                ],
-                "summary": "获取用户文章分类分布",
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -4875,16 +5427,25 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.CategoryDistItem"
-                                            }
+                                            "$ref": "#/definitions/schema.TagResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4892,27 +5453,34 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/stat/visits": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
                 "tags": [
-                    "StatAPI"
+                    "TagAPI"
                 ],
-                "summary": "获取访问趋势数据（仅管理员可用）",
+                "summary": "更新标签（仅管理员可用）",
                 "parameters": [
                     {
                         "minimum": 1,
                         "type": "integer",
-                        "default": 7,
-                        "description": "天数",
-                        "name": "days",
-                        "in": "query"
+                        "description": "标签ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "标签名称",
+                        "name": "name",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 ],
                 "responses": {
@@ -4927,10 +5495,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/schema.APIAccessTrendItem"
-                                            }
+                                            "$ref": "#/definitions/schema.TagResponse"
                                         }
                                     }
                                 }
@@ -4943,6 +5508,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/util.ResponseResult"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -4950,127 +5527,6231 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "schema.APIAccessTrendItem": {
-            "type": "object",
-            "properties": {
-                "client_error_count": {
-                    "type": "integer"
-                },
-                "date": {
-                    "type": "string"
-                },
-                "server_error_count": {
-                    "type": "integer"
-                },
-                "success_count": {
-                    "type": "integer"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "TagAPI"
+                ],
+                "summary": "删除标签（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "标签ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "创建评论（非管理员的评论按垃圾评论评分自动通过、自动拒绝或进入待审核，响应刚创建评论的全部信息）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "评论内容",
+                        "name": "content",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "minimum": 1,
+                        "description": "父评论ID",
+                        "name": "parent_id",
+                        "in": "body",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/article/{article_id}": {
+            "get": {
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取文章的顶级评论",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "top"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "排序字段：create-创建时间，top-表态总数",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "排序方式",
+                        "name": "sort_by_create",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/article/{article_id}/settings": {
+            "get": {
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取文章生效的评论设置（评论开关与审核模式），未单独设置时为站点默认设置",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "更新文章的评论设置，字段为空表示使用站点默认设置（仅文章作者与管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "评论设置",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateCommentSettingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/article/{article_id}/tree": {
+            "get": {
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取文章的评论树（根评论分页，每条根评论附带嵌套的回复，未展示的回复以 more_replies 标记）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "top"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "根评论排序字段：create-创建时间，top-表态总数",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "根评论排序方式",
+                        "name": "sort_by_create",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 20,
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 5,
+                        "description": "最大递归深度，未指定时为 5",
+                        "name": "max_depth",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每条评论最多展示的直接回复数",
+                        "name": "max_children",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentTreeResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/guest": {
+            "post": {
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "以访客身份发表评论（需验证码），评论在访客通过邮件中的链接验证邮箱后才进入审核",
+                "parameters": [
+                    {
+                        "description": "访客评论",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.CreateGuestCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/guest/claim": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "使用认领邮件中的令牌将访客评论关联到当前用户的账号",
+                "parameters": [
+                    {
+                        "description": "认领令牌",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.GuestTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ClaimGuestCommentsResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/guest/claim/send": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "向当前用户的邮箱发送认领邮件，用于将使用该邮箱发表的访客评论关联到账号",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "邮件语言，为空时使用默认语言",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/guest/verify": {
+            "post": {
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "使用验证邮件中的令牌验证访客邮箱，验证后评论按文章的审核模式进入审核",
+                "parameters": [
+                    {
+                        "description": "验证令牌",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.GuestTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/moderation/queue": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取当前用户可管理的评论列表，即本人及指定其为协管员的作者的文章下的评论",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "评论作者ID",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "父评论ID",
+                        "name": "parent_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "根评论ID",
+                        "name": "root_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "评论层级",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1,
+                            2,
+                            3
+                        ],
+                        "type": "integer",
+                        "description": "评论状态：0-待审核，1-已通过，2-已拒绝，3-访客邮箱待验证",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建开始时间，格式：2006-01-02 15:04:05",
+                        "name": "create_start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建结束时间，格式：2006-01-02 15:04:05",
+                        "name": "create_end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "审核开始时间，格式：2006-01-02 15:04:05",
+                        "name": "review_start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "审核结束时间，格式：2006-01-02 15:04:05",
+                        "name": "review_end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "审核人员ID",
+                        "name": "reviewer_id",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "最低垃圾评论评分",
+                        "name": "min_spam_score",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "只查询尚未经审核的评论（待审核及先发后审的评论）",
+                        "name": "unreviewed",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "review",
+                            "spam"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "排序字段：create-创建时间，review-审核时间，spam-垃圾评论评分",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "排序方式：desc-降序，asc-升序",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/moderation/review": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "文章作者或协管员审核评论，指定 rereview 时可修改已审核评论的审核结论",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "description": "评论ID",
+                        "name": "comment_id",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "审核状态：1-通过，2-拒绝",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer",
+                            "enum": [
+                                1,
+                                2
+                            ]
+                        }
+                    },
+                    {
+                        "description": "审核备注",
+                        "name": "review_remark",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "是否修改已审核评论的审核结论，管理员或系统拒绝的评论不能由作者或协管员恢复",
+                        "name": "rereview",
+                        "in": "body",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/moderation/{id}/hide": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "文章作者或协管员隐藏已通过的评论，评论下的回复随之隐藏",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "隐藏原因",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.HideCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/moderation/{id}/pin": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "文章作者或协管员置顶或取消置顶文章的顶级评论",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "是否置顶",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.PinCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/moderators": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取当前用户指定的评论协管员",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.CommentModeratorResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "指定评论协管员，协管员可管理当前用户所有文章下的评论",
+                "parameters": [
+                    {
+                        "description": "协管员用户ID",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.AddCommentModeratorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/moderators/{user_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "移除评论协管员",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "协管员用户ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/review": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取评论列表（适用于审核和管理，仅管理员可用）",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "评论作者ID",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "父评论ID",
+                        "name": "parent_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "根评论ID",
+                        "name": "root_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "评论层级",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            0,
+                            1,
+                            2,
+                            3
+                        ],
+                        "type": "integer",
+                        "description": "评论状态：0-待审核，1-已通过，2-已拒绝，3-访客邮箱待验证",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "关键词",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建开始时间，格式：2006-01-02 15:04:05",
+                        "name": "create_start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "创建结束时间，格式：2006-01-02 15:04:05",
+                        "name": "create_end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "审核开始时间，格式：2006-01-02 15:04:05",
+                        "name": "review_start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "审核结束时间，格式：2006-01-02 15:04:05",
+                        "name": "review_end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "审核人员ID",
+                        "name": "reviewer_id",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 0,
+                        "type": "integer",
+                        "description": "最低垃圾评论评分",
+                        "name": "min_spam_score",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "只查询尚未经审核的评论（待审核及先发后审的评论）",
+                        "name": "unreviewed",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "create",
+                            "review",
+                            "spam"
+                        ],
+                        "type": "string",
+                        "default": "create",
+                        "description": "排序字段：create-创建时间，review-审核时间，spam-垃圾评论评分",
+                        "name": "sort_by",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "desc",
+                            "asc"
+                        ],
+                        "type": "string",
+                        "default": "desc",
+                        "description": "排序方式：desc-降序，asc-升序",
+                        "name": "sort_order",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "审核评论，指定 rereview 时可修改已审核评论的审核结论（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "description": "评论ID",
+                        "name": "comment_id",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer"
+                        }
+                    },
+                    {
+                        "description": "审核状态：1-通过，2-拒绝",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "integer",
+                            "enum": [
+                                1,
+                                2
+                            ]
+                        }
+                    },
+                    {
+                        "description": "审核备注",
+                        "name": "review_remark",
+                        "in": "body",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    {
+                        "description": "是否修改已审核评论的审核结论",
+                        "name": "rereview",
+                        "in": "body",
+                        "schema": {
+                            "type": "boolean"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/review/batch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "批量审核评论，按评论ID或按审核列表的筛选条件选择评论，在同一事务中审核（仅管理员可用）",
+                "parameters": [
+                    {
+                        "description": "批量审核请求",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.BatchReviewCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.BatchReviewCommentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/user": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取用户的评论",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/{id}": {
+            "get": {
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取评论详情",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "编辑评论（仅作者可在发表后的编辑时限内编辑，编辑前的内容会保留为历史版本）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "评论内容",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "删除评论（仍有回复时保留为\"该评论已删除\"的占位，否则直接删除）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/{id}/reactions": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "对评论表态/取消表态（点赞及固定的表情集合）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "表态类型：like-👍，heart-❤️，laugh-😄，hooray-🎉，confused-😕",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.ReactCommentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentReactionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/{id}/replies": {
+            "get": {
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取顶级评论的所有回复（扁平化列表，适合前端显示）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 30,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "是否包含回复的回复",
+                        "name": "include_replies",
+                        "in": "query"
+                    },
+                    {
+                        "minimum": 0,
+                        "type": "integer",
+                        "default": 0,
+                        "description": "回复层数限制，i (i\u003e0) 表示获取到第 i 层回复，0表示不限制",
+                        "name": "max_depth",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "default": "asc",
+                        "description": "排序方式",
+                        "name": "sort_by_create",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/comment/{id}/revisions": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "CommentAPI"
+                ],
+                "summary": "获取评论的历史版本（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "评论ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.CommentRevisionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/linkcheck/links": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "LinkCheckAPI"
+                ],
+                "summary": "获取全站的失效链接（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "broken",
+                            "unreachable"
+                        ],
+                        "type": "string",
+                        "description": "链接状态，为空时返回失效与无法访问的链接",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "作者ID",
+                        "name": "author_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "链接主机",
+                        "name": "host",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.BrokenLinkPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/linkcheck/links/{id}/recheck": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "LinkCheckAPI"
+                ],
+                "summary": "立即复查链接（仅文章作者与管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "链接ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/linkcheck/mine": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "LinkCheckAPI"
+                ],
+                "summary": "获取当前用户文章中的失效链接",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "broken",
+                            "unreachable"
+                        ],
+                        "type": "string",
+                        "description": "链接状态，为空时返回失效与无法访问的链接",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "article_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "链接主机",
+                        "name": "host",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.BrokenLinkPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/linkcheck/summary": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "LinkCheckAPI"
+                ],
+                "summary": "获取全站链接检查概况（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.LinkCheckSummary"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mail/digest": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MailAPI"
+                ],
+                "summary": "获取当前用户的通知摘要邮件设置",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.DigestSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MailAPI"
+                ],
+                "summary": "更新当前用户的通知摘要邮件设置（每日、每周或不发送）",
+                "parameters": [
+                    {
+                        "description": "摘要邮件设置",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateDigestRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.DigestSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mail/outbox": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MailAPI"
+                ],
+                "summary": "获取发件箱中的邮件（仅管理员）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "pending",
+                            "sent",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "发送状态",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.OutboxPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mail/test": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MailAPI"
+                ],
+                "summary": "发送测试邮件，用于检查 SMTP 配置（仅管理员）",
+                "parameters": [
+                    {
+                        "description": "收件人",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.SendTestMailRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mention/blocks": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MentionAPI"
+                ],
+                "summary": "屏蔽指定用户对当前用户的提及",
+                "parameters": [
+                    {
+                        "description": "被屏蔽的用户名",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.BlockMentionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.MentionSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mention/blocks/{user_id}": {
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MentionAPI"
+                ],
+                "summary": "取消屏蔽指定用户",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "被屏蔽的用户ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.MentionSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mention/mine": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MentionAPI"
+                ],
+                "summary": "获取当前用户被提及的记录",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "article",
+                            "comment"
+                        ],
+                        "type": "string",
+                        "description": "来源类型",
+                        "name": "source_type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.MentionPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/mention/settings": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MentionAPI"
+                ],
+                "summary": "获取当前用户的提及隐私设置",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.MentionSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "MentionAPI"
+                ],
+                "summary": "更新当前用户的提及隐私策略（everyone：所有人可提及，nobody：不允许提及）",
+                "parameters": [
+                    {
+                        "description": "提及隐私策略",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateMentionSettingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.MentionSettingResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "NotificationAPI"
+                ],
+                "summary": "获取当前用户的通知（同一对象上的点赞、收藏与关注会合并为一条）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "reply",
+                            "like",
+                            "favorite",
+                            "mention",
+                            "review",
+                            "follow"
+                        ],
+                        "type": "string",
+                        "description": "通知类型",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "是否只返回未读通知",
+                        "name": "unread_only",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.NotificationPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification/preferences": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "NotificationAPI"
+                ],
+                "summary": "获取当前用户的通知偏好（通知类型 -\u003e 是否接收）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "boolean"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "NotificationAPI"
+                ],
+                "summary": "更新当前用户的通知偏好，未提交的类型保持不变",
+                "parameters": [
+                    {
+                        "description": "通知偏好",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdatePreferencesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "object",
+                                            "additionalProperties": {
+                                                "type": "boolean"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification/read-all": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "NotificationAPI"
+                ],
+                "summary": "将未读通知全部标记为已读",
+                "parameters": [
+                    {
+                        "description": "通知类型，为空时标记所有类型",
+                        "name": "body",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/schema.MarkAllReadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.UnreadCountResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification/unread-count": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "NotificationAPI"
+                ],
+                "summary": "获取当前用户的未读通知数",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.UnreadCountResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notification/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "NotificationAPI"
+                ],
+                "summary": "将通知标记为已读",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "通知ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensitive/check": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SensitiveAPI"
+                ],
+                "summary": "使用当前词库检测文本（仅管理员可用）",
+                "parameters": [
+                    {
+                        "description": "待检测文本",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.CheckTextRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CheckTextResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensitive/words": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SensitiveAPI"
+                ],
+                "summary": "获取敏感词列表（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "敏感词关键字",
+                        "name": "keyword",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "分类",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "block",
+                            "mask",
+                            "review"
+                        ],
+                        "type": "string",
+                        "description": "处理方式",
+                        "name": "action",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SensitiveWordPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SensitiveAPI"
+                ],
+                "summary": "创建敏感词（仅管理员可用）",
+                "parameters": [
+                    {
+                        "description": "敏感词信息",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.CreateSensitiveWordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SensitiveWord"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensitive/words/batch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SensitiveAPI"
+                ],
+                "summary": "批量导入敏感词（仅管理员可用，已存在的敏感词将更新分类与处理方式）",
+                "parameters": [
+                    {
+                        "description": "敏感词列表",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.BatchCreateSensitiveWordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "integer"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensitive/words/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SensitiveAPI"
+                ],
+                "summary": "更新敏感词（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "敏感词ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "敏感词信息",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/schema.UpdateSensitiveWordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SensitiveWord"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "SensitiveAPI"
+                ],
+                "summary": "删除敏感词（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "description": "敏感词ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/activity": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取用户活跃度数据（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 7,
+                        "description": "天数",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.UserActivityTrendItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/articles/creation": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取文章创作时间统计（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 30,
+                        "description": "天数",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.ArticleCreationTimeStatsItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/articles/{id}/trend": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取单篇文章的每日统计趋势（仅作者与管理员可用）",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "文章ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "maximum": 365,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 30,
+                        "description": "天数",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.ArticleTrendResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/cache": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取缓存信息（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CacheInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/categories": {
+            "get": {
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取文章分类分布",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.CategoryDistItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/comments": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取所有评论的统计数据（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CommentStatisticResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/cpu": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取 CPU 信息（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.CPUInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/db": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取数据库信息（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.DatabaseInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/disk": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取硬盘信息（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.DiskInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/go": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取 GO 运行时信息（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.GoRuntimeInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/logger": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取日志列表（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 1,
+                        "description": "页码",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "maximum": 100,
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 10,
+                        "description": "每页容量",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "debug",
+                            "info",
+                            "warn",
+                            "error",
+                            "dpanic",
+                            "panic",
+                            "fatal"
+                        ],
+                        "type": "string",
+                        "description": "日志级别",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "追踪ID",
+                        "name": "trace_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户名关键词",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "main",
+                            "recovery",
+                            "request",
+                            "login",
+                            "logout",
+                            "system",
+                            "operate",
+                            "ai"
+                        ],
+                        "type": "string",
+                        "description": "日志标签",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "日志消息关键词",
+                        "name": "message",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "开始时间",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "结束时间",
+                        "name": "end_time",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.LoggerPaginationResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/memory": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取内存信息（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.MemoryInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/overview": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取站点概览统计信息（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SiteOverviewResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/system": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取系统信息（仅管理员可用）",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SystemInfo"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/user/articles": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取用户文章统计信息",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.SiteOverviewResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/user/articles/completion": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取用户文章的阅读完成度",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.ArticleCompletionItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/user/articles/visits": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取用户文章访问趋势",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 7,
+                        "description": "天数",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/schema.UserArticleVisitTrendResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/user/categories": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取用户文章分类分布",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.CategoryDistItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/stat/visits": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "StatAPI"
+                ],
+                "summary": "获取访问趋势数据（仅管理员可用）",
+                "parameters": [
+                    {
+                        "minimum": 1,
+                        "type": "integer",
+                        "default": 7,
+                        "description": "天数",
+                        "name": "days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/util.ResponseResult"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/schema.APIAccessTrendItem"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webmention": {
+            "post": {
+                "consumes": [
+                    "application/x-www-form-urlencoded"
+                ],
+                "tags": [
+                    "WebmentionAPI"
+                ],
+                "summary": "接收 Webmention（来源页面将被异步验证，验证通过后作为待审核评论展示）",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "来源页面地址",
+                        "name": "source",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "目标文章地址",
+                        "name": "target",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/util.ResponseResult"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "activitypub.Activity": {
+            "type": "object"
+        },
+        "activitypub.Actor": {
+            "type": "object",
+            "properties": {
+                "@context": {},
+                "endpoints": {
+                    "$ref": "#/definitions/activitypub.Endpoints"
+                },
+                "followers": {
+                    "type": "string"
+                },
+                "icon": {
+                    "$ref": "#/definitions/activitypub.Image"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inbox": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "outbox": {
+                    "type": "string"
+                },
+                "preferredUsername": {
+                    "type": "string"
+                },
+                "publicKey": {
+                    "$ref": "#/definitions/activitypub.PublicKey"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.Endpoints": {
+            "type": "object",
+            "properties": {
+                "sharedInbox": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.Image": {
+            "type": "object",
+            "properties": {
+                "mediaType": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.JRD": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/activitypub.JRDLink"
+                    }
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.JRDLink": {
+            "type": "object",
+            "properties": {
+                "href": {
+                    "type": "string"
+                },
+                "rel": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.Object": {
+            "type": "object",
+            "properties": {
+                "@context": {},
+                "attributedTo": {
+                    "type": "string"
+                },
+                "cc": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "content": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "inReplyTo": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "published": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "to": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updated": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.OrderedCollection": {
+            "type": "object",
+            "properties": {
+                "@context": {},
+                "first": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "last": {
+                    "type": "string"
+                },
+                "next": {
+                    "type": "string"
+                },
+                "orderedItems": {
+                    "type": "array",
+                    "items": {}
+                },
+                "partOf": {
+                    "type": "string"
+                },
+                "prev": {
+                    "type": "string"
+                },
+                "totalItems": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "activitypub.PublicKey": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "owner": {
+                    "type": "string"
+                },
+                "publicKeyPem": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.AIVerdict": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "类别",
+                    "type": "string"
+                },
+                "confidence": {
+                    "description": "置信度（0-1）",
+                    "type": "number"
+                },
+                "created_at": {
+                    "description": "给出建议的时间",
+                    "type": "string"
+                },
+                "model": {
+                    "description": "给出建议的模型",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "理由",
+                    "type": "string"
+                },
+                "suggestion": {
+                    "description": "建议",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.APIAccessTrendItem": {
+            "type": "object",
+            "properties": {
+                "client_error_count": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "server_error_count": {
+                    "type": "integer"
+                },
+                "success_count": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.AddCommentModeratorRequest": {
+            "type": "object",
+            "required": [
+                "user_id"
+            ],
+            "properties": {
+                "user_id": {
+                    "description": "协管员用户ID",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.AddFavoriteFolderItemRequest": {
+            "type": "object",
+            "required": [
+                "article_id"
+            ],
+            "properties": {
+                "article_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ArticleCompletionItem": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "type": "integer"
+                },
+                "avg_progress": {
+                    "description": "平均阅读百分比",
+                    "type": "number"
+                },
+                "completion_rate": {
+                    "description": "读完率（百分比）",
+                    "type": "number"
+                },
+                "finished_count": {
+                    "description": "读完的读者数",
+                    "type": "integer"
+                },
+                "reader_count": {
+                    "description": "上报过阅读进度的读者数",
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.ArticleCreationTimeStatsItem": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-05-01"
+                }
+            }
+        },
+        "schema.ArticleDuplicateItem": {
+            "type": "object",
+            "properties": {
+                "article_author": {
+                    "type": "string"
+                },
+                "article_author_id": {
+                    "type": "integer"
+                },
+                "article_id": {
+                    "type": "integer"
+                },
+                "article_status": {
+                    "type": "string"
+                },
+                "article_title": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "检测时间",
+                    "type": "string"
+                },
+                "distance": {
+                    "type": "integer"
+                },
+                "matched_article_id": {
+                    "type": "integer"
+                },
+                "matched_author": {
+                    "type": "string"
+                },
+                "matched_author_id": {
+                    "type": "integer"
+                },
+                "matched_created_at": {
+                    "type": "string"
+                },
+                "matched_status": {
+                    "type": "string"
+                },
+                "matched_title": {
+                    "type": "string"
+                },
+                "similarity": {
+                    "type": "number"
+                }
+            }
+        },
+        "schema.ArticleDuplicatePaginationResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ArticleDuplicateItem"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ArticleInteractionResponse": {
+            "type": "object",
+            "properties": {
+                "interacted": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "schema.ArticleListItem": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "作者名称",
+                    "type": "string"
+                },
+                "author_avatar": {
+                    "description": "作者头像",
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "integer"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "category_name": {
+                    "description": "分类名称",
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "cover": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "favorite_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interaction_time": {
+                    "description": "交互时间（用于历史记录等）",
+                    "type": "string"
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "read_position": {
+                    "description": "滚动位置（用于继续阅读）",
+                    "type": "integer"
+                },
+                "read_progress": {
+                    "description": "阅读进度（用于继续阅读）",
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "标签列表",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "view_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ArticlePaginationResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ArticleListItem"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ArticleResponse": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "description": "作者名称",
+                    "type": "string"
+                },
+                "author_avatar": {
+                    "description": "作者头像",
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "integer"
+                },
+                "category_id": {
+                    "type": "integer"
+                },
+                "category_name": {
+                    "description": "分类名称",
+                    "type": "string"
+                },
+                "comment_count": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "cover": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "favorite_count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "interactions": {
+                    "description": "交互状态",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.InteractionResponse"
+                        }
+                    ]
+                },
+                "like_count": {
+                    "type": "integer"
+                },
+                "mentions": {
+                    "description": "正文中被提及的用户",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.MentionedUser"
+                    }
+                },
+                "status": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "标签列表",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "view_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ArticleReviewResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "article_id": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "operator_avatar": {
+                    "description": "操作人头像",
+                    "type": "string"
+                },
+                "operator_id": {
+                    "type": "integer"
+                },
+                "operator_name": {
+                    "description": "操作人名称",
+                    "type": "string"
+                },
+                "remark": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.ArticleTrendItem": {
+            "type": "object",
+            "properties": {
+                "comments": {
+                    "type": "integer"
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2025-05-01"
+                },
+                "favorites": {
+                    "type": "integer"
+                },
+                "likes": {
+                    "type": "integer"
+                },
+                "unique_visitors": {
+                    "type": "integer"
+                },
+                "views": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ArticleTrendResponse": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.ArticleTrendItem"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "total_comments": {
+                    "description": "区间内新增评论数",
+                    "type": "integer"
+                },
+                "total_favorites": {
+                    "description": "区间内新增收藏数",
+                    "type": "integer"
+                },
+                "total_likes": {
+                    "description": "区间内新增点赞数",
+                    "type": "integer"
+                },
+                "total_views": {
+                    "description": "区间内浏览次数",
+                    "type": "integer"
+                },
+                "total_visitors": {
+                    "description": "区间内每日独立访客数之和",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.ArticleVisitTrendItem": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2023-01-01"
+                },
+                "visit_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.AssistantResponse": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "description": "数据流内容",
+                    "type": "string"
+                },
+                "contents": {
+                    "description": "非流式传输",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_error": {
+                    "description": "流式传输",
+                    "type": "boolean"
+                }
+            }
+        },
+        "schema.AuthorBrokenCount": {
+            "type": "object",
+            "properties": {
+                "author_id": {
+                    "type": "integer"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.AvatarResponse": {
+            "type": "object",
+            "properties": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.BatchCreateSensitiveWordRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "words"
+            ],
+            "properties": {
+                "action": {
+                    "enum": [
+                        "block",
+                        "mask",
+                        "review"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/sensitive.Action"
+                        }
+                    ]
+                },
+                "category": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "words": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "schema.BatchReviewCommentRequest": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "comment_ids": {
+                    "description": "评论ID列表",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "filter": {
+                    "description": "筛选条件，按评论ID升序最多选择批量审核上限条评论",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.CommentReviewFilter"
+                        }
+                    ]
+                },
+                "rereview": {
+                    "description": "是否同时修改已审核评论的审核结论，否则只审核待审核评论",
+                    "type": "boolean"
+                },
+                "review_remark": {
+                    "description": "审核备注",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "审核状态：1-通过，2-拒绝",
+                    "type": "integer",
+                    "enum": [
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "schema.BatchReviewCommentResponse": {
+            "type": "object",
+            "properties": {
+                "comment_ids": {
+                    "description": "审核状态发生变化的评论ID",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "reviewed": {
+                    "description": "审核状态发生变化的评论数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.BlockMentionRequest": {
+            "type": "object",
+            "required": [
+                "username"
+            ],
+            "properties": {
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.BrokenLinkPaginationResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.BrokenLinkResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.BrokenLinkResponse": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "type": "integer"
+                },
+                "article_title": {
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "integer"
+                },
+                "failures": {
+                    "type": "integer"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_checked_at": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "next_check_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.CPUInfo": {
+            "type": "object",
+            "properties": {
+                "cache_size": {
+                    "description": "CPU缓存大小（字节）",
+                    "type": "integer"
+                },
+                "cores": {
+                    "description": "CPU核心数",
+                    "type": "integer"
+                },
+                "frequency": {
+                    "description": "CPU频率（MHz）",
+                    "type": "number"
+                },
+                "model_name": {
+                    "description": "CPU型号信息",
+                    "type": "string"
+                },
+                "per_core_percent": {
+                    "description": "每个CPU核心的使用率",
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "usage_percent": {
+                    "description": "CPU使用率（百分比）",
+                    "type": "number"
+                }
+            }
+        },
+        "schema.CacheInfo": {
+            "type": "object",
+            "properties": {
+                "cache_type": {
+                    "description": "缓存类型",
+                    "type": "string"
+                },
+                "connected_clients": {
+                    "description": "已连接客户端数量",
+                    "type": "integer"
+                },
+                "hit_rate": {
+                    "description": "命中率",
+                    "type": "number"
+                },
+                "key_count": {
+                    "description": "键数量",
+                    "type": "integer"
+                },
+                "used_memory": {
+                    "description": "已使用内存（字节）",
+                    "type": "integer"
+                },
+                "used_memory_peak": {
+                    "description": "已使用内存峰值（字节）",
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "缓存版本",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.Captcha": {
+            "type": "object",
+            "properties": {
+                "captcha_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.CategoryDistItem": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.CategoryPaginationResult": {
+            "type": "object",
+            "properties": {
+                "categories_with_article": {
+                    "description": "有文章的分类数量",
+                    "type": "integer"
+                },
+                "category_name_with_most_article": {
+                    "description": "文章数量最多的分类名称",
+                    "type": "string"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.CategoryResponse"
+                    }
+                },
+                "most_article_counts": {
+                    "description": "分类中最多的文章数量",
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total_articles": {
+                    "description": "有分类的文章总数",
+                    "type": "integer"
+                },
+                "total_categories": {
+                    "description": "分类总数",
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "article_count": {
+                    "description": "文章数量",
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.CheckTextRequest": {
+            "type": "object",
+            "required": [
+                "text"
+            ],
+            "properties": {
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.CheckTextResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "需要采取的处理方式，未命中时为空",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/sensitive.Action"
+                        }
+                    ]
+                },
+                "hits": {
+                    "description": "所有命中",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/sensitive.Hit"
+                    }
+                },
+                "masked": {
+                    "description": "掩码后的文本",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.ClaimGuestCommentsResponse": {
+            "type": "object",
+            "properties": {
+                "claimed": {
+                    "description": "关联到当前账号的评论数量",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.CommentModeratorResponse": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "description": "协管员头像",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "指定时间",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "协管员用户ID",
+                    "type": "integer"
+                },
+                "username": {
+                    "description": "协管员用户名",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.CommentPaginationResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.CommentResponse"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.CommentReactionResponse": {
+            "type": "object",
+            "properties": {
+                "my_reactions": {
+                    "description": "当前用户的表态",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "reacted": {
+                    "description": "操作后当前用户是否持有该表态",
+                    "type": "boolean"
+                },
+                "reaction_count": {
+                    "description": "表态总数",
+                    "type": "integer"
+                },
+                "reactions": {
+                    "description": "各类表态的数量",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "schema.CommentResponse": {
+            "type": "object",
+            "properties": {
+                "ai_verdict": {
+                    "description": "AI 审核建议（仅审核列表返回）",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.AIVerdict"
+                        }
+                    ]
+                },
+                "article_id": {
+                    "type": "integer"
+                },
+                "article_title": {
+                    "description": "文章标题",
+                    "type": "string"
+                },
+                "author": {
+                    "description": "作者名称",
+                    "type": "string"
+                },
+                "author_id": {
+                    "type": "integer"
+                },
+                "avatar": {
+                    "description": "作者头像",
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted": {
+                    "description": "是否已删除（墓碑）",
+                    "type": "boolean"
+                },
+                "edited": {
+                    "description": "是否编辑过",
+                    "type": "boolean"
+                },
+                "edited_at": {
+                    "description": "最后编辑时间",
+                    "type": "string"
+                },
+                "hidden": {
+                    "description": "是否因祖先评论被拒绝而隐藏",
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "level": {
+                    "description": "评论层级",
+                    "type": "integer"
+                },
+                "mentions": {
+                    "description": "评论中被提及的用户",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.MentionedUser"
+                    }
+                },
+                "my_reactions": {
+                    "description": "当前用户的表态",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parent_author": {
+                    "description": "父评论作者",
+                    "type": "string"
                 },
-                "total_count": {
+                "parent_content": {
+                    "description": "父评论内容",
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "integer"
+                },
+                "pinned": {
+                    "description": "是否置顶",
+                    "type": "boolean"
+                },
+                "reaction_count": {
+                    "description": "表态总数",
+                    "type": "integer"
+                },
+                "reactions": {
+                    "description": "各类表态的数量",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "reply_count": {
+                    "description": "回复数量",
+                    "type": "integer"
+                },
+                "review_remark": {
+                    "description": "审核备注",
+                    "type": "string"
+                },
+                "reviewed_at": {
+                    "description": "审核时间",
+                    "type": "string"
+                },
+                "reviewer_avatar": {
+                    "description": "审核员头像",
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "description": "审核员ID",
+                    "type": "integer"
+                },
+                "reviewer_name": {
+                    "description": "审核员名称",
+                    "type": "string"
+                },
+                "root_id": {
+                    "description": "根评论ID",
+                    "type": "integer"
+                },
+                "source_author": {
+                    "description": "外部来源作者",
+                    "type": "string"
+                },
+                "source_url": {
+                    "description": "外部来源链接",
+                    "type": "string"
+                },
+                "spam_score": {
+                    "description": "垃圾评论评分（仅审核列表返回）",
+                    "type": "integer"
+                },
+                "spam_signals": {
+                    "description": "评分依据（仅审核列表返回）",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.SpamSignal"
+                    }
+                },
+                "status": {
+                    "description": "审核状态",
                     "type": "integer"
+                },
+                "type": {
+                    "description": "评论类型",
+                    "type": "string"
                 }
             }
         },
-        "schema.ArticleCreationTimeStatsItem": {
+        "schema.CommentReviewFilter": {
             "type": "object",
             "properties": {
-                "categories": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
-                    }
+                "article_id": {
+                    "description": "基本筛选",
+                    "type": "integer"
                 },
-                "count": {
+                "author_id": {
+                    "description": "评论作者ID",
                     "type": "integer"
                 },
-                "date": {
+                "create_end_time": {
+                    "description": "创建结束时间",
+                    "type": "string"
+                },
+                "create_start_time": {
+                    "description": "时间范围筛选",
+                    "type": "string"
+                },
+                "keyword": {
+                    "description": "关键词搜索",
+                    "type": "string"
+                },
+                "level": {
+                    "description": "评论层级",
+                    "type": "integer"
+                },
+                "min_spam_score": {
+                    "description": "垃圾评论评分筛选",
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "parent_id": {
+                    "description": "父评论ID",
+                    "type": "integer"
+                },
+                "review_end_time": {
+                    "description": "审核结束时间",
+                    "type": "string"
+                },
+                "review_start_time": {
+                    "description": "审核开始时间",
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "description": "审核人员筛选",
+                    "type": "integer"
+                },
+                "root_id": {
+                    "description": "根评论ID",
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "评论状态：0-待审核，1-已通过，2-已拒绝，3-访客邮箱待验证，不传则查询所有状态",
+                    "type": "integer",
+                    "enum": [
+                        0,
+                        1,
+                        2,
+                        3
+                    ]
+                },
+                "type": {
+                    "description": "评论类型：comment-站内评论，guest-访客评论，webmention-外部引用，activitypub-联邦回复，不传则查询所有类型",
                     "type": "string",
-                    "example": "2025-05-01"
+                    "enum": [
+                        "comment",
+                        "guest",
+                        "webmention",
+                        "activitypub"
+                    ]
+                },
+                "unreviewed": {
+                    "description": "审核进度筛选",
+                    "type": "boolean"
                 }
             }
         },
-        "schema.ArticleInteractionResponse": {
+        "schema.CommentRevisionResponse": {
             "type": "object",
             "properties": {
-                "interacted": {
+                "comment_id": {
+                    "type": "integer"
+                },
+                "content": {
+                    "description": "编辑前的评论内容",
+                    "type": "string"
+                },
+                "created_at": {
+                    "description": "编辑时间",
+                    "type": "string"
+                },
+                "editor": {
+                    "description": "编辑人名称",
+                    "type": "string"
+                },
+                "editor_id": {
+                    "description": "编辑人ID",
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "编辑前的审核状态",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.CommentSettingResponse": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "description": "文章ID",
+                    "type": "integer"
+                },
+                "auto_close_days": {
+                    "description": "自动关闭评论的天数",
+                    "type": "integer"
+                },
+                "closed": {
+                    "description": "当前是否已关闭评论",
+                    "type": "boolean"
+                },
+                "closes_at": {
+                    "description": "自动关闭评论的时间",
+                    "type": "string"
+                },
+                "customized": {
+                    "description": "是否为文章单独设置，否则为站点默认设置",
                     "type": "boolean"
+                },
+                "moderation": {
+                    "description": "审核模式",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "评论开关",
+                    "type": "string"
                 }
             }
         },
-        "schema.ArticleListItem": {
+        "schema.CommentStatisticResponse": {
+            "type": "object",
+            "properties": {
+                "passed_comments": {
+                    "type": "integer"
+                },
+                "pending_comments": {
+                    "type": "integer"
+                },
+                "rejected_comments": {
+                    "type": "integer"
+                },
+                "total_comments": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.CommentTreeNode": {
             "type": "object",
             "properties": {
+                "ai_verdict": {
+                    "description": "AI 审核建议（仅审核列表返回）",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.AIVerdict"
+                        }
+                    ]
+                },
+                "article_id": {
+                    "type": "integer"
+                },
+                "article_title": {
+                    "description": "文章标题",
+                    "type": "string"
+                },
                 "author": {
                     "description": "作者名称",
                     "type": "string"
                 },
-                "author_avatar": {
+                "author_id": {
+                    "type": "integer"
+                },
+                "avatar": {
                     "description": "作者头像",
                     "type": "string"
                 },
-                "author_id": {
+                "children": {
+                    "description": "已展示的直接回复，按创建时间升序排列",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.CommentTreeNode"
+                    }
+                },
+                "content": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted": {
+                    "description": "是否已删除（墓碑）",
+                    "type": "boolean"
+                },
+                "edited": {
+                    "description": "是否编辑过",
+                    "type": "boolean"
+                },
+                "edited_at": {
+                    "description": "最后编辑时间",
+                    "type": "string"
+                },
+                "hidden": {
+                    "description": "是否因祖先评论被拒绝而隐藏",
+                    "type": "boolean"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "category_id": {
+                "level": {
+                    "description": "评论层级",
                     "type": "integer"
                 },
-                "category_name": {
-                    "description": "分类名称",
-                    "type": "string"
+                "mentions": {
+                    "description": "评论中被提及的用户",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.MentionedUser"
+                    }
                 },
-                "comment_count": {
+                "more_replies": {
+                    "description": "因超出回复数上限或最大深度而未展示的直接回复数，大于 0 时可通过回复接口加载更多",
                     "type": "integer"
                 },
-                "cover": {
+                "my_reactions": {
+                    "description": "当前用户的表态",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "parent_author": {
+                    "description": "父评论作者",
                     "type": "string"
                 },
-                "created_at": {
+                "parent_content": {
+                    "description": "父评论内容",
                     "type": "string"
                 },
-                "favorite_count": {
+                "parent_id": {
                     "type": "integer"
                 },
-                "id": {
+                "pinned": {
+                    "description": "是否置顶",
+                    "type": "boolean"
+                },
+                "reaction_count": {
+                    "description": "表态总数",
                     "type": "integer"
                 },
-                "interaction_time": {
-                    "description": "交互时间（用于历史记录等）",
+                "reactions": {
+                    "description": "各类表态的数量",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "reply_count": {
+                    "description": "回复数量",
+                    "type": "integer"
+                },
+                "review_remark": {
+                    "description": "审核备注",
                     "type": "string"
                 },
-                "like_count": {
+                "reviewed_at": {
+                    "description": "审核时间",
+                    "type": "string"
+                },
+                "reviewer_avatar": {
+                    "description": "审核员头像",
+                    "type": "string"
+                },
+                "reviewer_id": {
+                    "description": "审核员ID",
+                    "type": "integer"
+                },
+                "reviewer_name": {
+                    "description": "审核员名称",
+                    "type": "string"
+                },
+                "root_id": {
+                    "description": "根评论ID",
                     "type": "integer"
                 },
-                "status": {
+                "source_author": {
+                    "description": "外部来源作者",
                     "type": "string"
                 },
-                "summary": {
+                "source_url": {
+                    "description": "外部来源链接",
                     "type": "string"
                 },
-                "tags": {
-                    "description": "标签列表",
+                "spam_score": {
+                    "description": "垃圾评论评分（仅审核列表返回）",
+                    "type": "integer"
+                },
+                "spam_signals": {
+                    "description": "评分依据（仅审核列表返回）",
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/schema.SpamSignal"
                     }
                 },
-                "title": {
-                    "type": "string"
-                },
-                "view_count": {
+                "status": {
+                    "description": "审核状态",
                     "type": "integer"
+                },
+                "type": {
+                    "description": "评论类型",
+                    "type": "string"
                 }
             }
         },
-        "schema.ArticlePaginationResult": {
+        "schema.CommentTreeResult": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.ArticleListItem"
+                        "$ref": "#/definitions/schema.CommentTreeNode"
                     }
                 },
                 "page": {
@@ -5087,237 +11768,428 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.ArticleResponse": {
+        "schema.CoverResponse": {
             "type": "object",
             "properties": {
-                "author": {
-                    "description": "作者名称",
-                    "type": "string"
-                },
-                "author_avatar": {
-                    "description": "作者头像",
+                "url": {
                     "type": "string"
+                }
+            }
+        },
+        "schema.CreateFavoriteFolderRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
                 },
-                "author_id": {
-                    "type": "integer"
+                "is_public": {
+                    "type": "boolean"
                 },
-                "category_id": {
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "schema.CreateGuestCommentRequest": {
+            "type": "object",
+            "required": [
+                "article_id",
+                "captcha",
+                "captcha_id",
+                "content",
+                "email",
+                "name"
+            ],
+            "properties": {
+                "article_id": {
                     "type": "integer"
                 },
-                "category_name": {
-                    "description": "分类名称",
+                "captcha": {
+                    "description": "验证码",
                     "type": "string"
                 },
-                "comment_count": {
-                    "type": "integer"
+                "captcha_id": {
+                    "description": "验证码ID",
+                    "type": "string"
                 },
                 "content": {
                     "type": "string"
                 },
-                "cover": {
-                    "type": "string"
+                "email": {
+                    "description": "访客邮箱，用于接收验证链接，不公开展示",
+                    "type": "string",
+                    "maxLength": 100
                 },
-                "created_at": {
-                    "type": "string"
+                "locale": {
+                    "description": "验证邮件语言，为空时使用默认语言",
+                    "type": "string",
+                    "maxLength": 20
                 },
-                "favorite_count": {
+                "name": {
+                    "description": "访客名称",
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "parent_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "schema.CreatePageRequest": {
+            "type": "object",
+            "required": [
+                "content",
+                "slug",
+                "title"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
                 },
-                "id": {
+                "nav_order": {
                     "type": "integer"
                 },
-                "interactions": {
-                    "description": "交互状态",
+                "remark": {
+                    "description": "修改说明",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "show_in_nav": {
+                    "description": "不传时默认显示在导航中",
+                    "type": "boolean"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "status": {
+                    "description": "不传时为草稿",
+                    "type": "string",
+                    "enum": [
+                        "published",
+                        "draft"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "schema.CreateSensitiveWordRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "word"
+            ],
+            "properties": {
+                "action": {
+                    "enum": [
+                        "block",
+                        "mask",
+                        "review"
+                    ],
                     "allOf": [
                         {
-                            "$ref": "#/definitions/schema.InteractionResponse"
+                            "$ref": "#/definitions/sensitive.Action"
                         }
                     ]
                 },
-                "like_count": {
+                "category": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "word": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
+        "schema.DatabaseInfo": {
+            "type": "object",
+            "properties": {
+                "active_transactions": {
+                    "description": "活跃事务数量",
                     "type": "integer"
                 },
-                "status": {
-                    "type": "string"
+                "cache_hit_rate": {
+                    "description": "缓存命中率（百分比）",
+                    "type": "number"
                 },
-                "summary": {
+                "db_size": {
+                    "description": "数据库大小（字节）",
+                    "type": "integer"
+                },
+                "db_type": {
+                    "description": "数据库类型",
                     "type": "string"
                 },
-                "tags": {
-                    "description": "标签列表",
-                    "type": "array",
-                    "items": {
-                        "type": "integer"
-                    }
+                "index_count": {
+                    "description": "索引数量",
+                    "type": "integer"
                 },
-                "title": {
-                    "type": "string"
+                "pool": {
+                    "description": "连接池状态",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/schema.PoolStatus"
+                        }
+                    ]
                 },
-                "updated_at": {
-                    "type": "string"
+                "qps": {
+                    "description": "每秒查询数",
+                    "type": "number"
                 },
-                "view_count": {
+                "slow_query_count": {
+                    "description": "慢查询数量（最近1小时）",
                     "type": "integer"
+                },
+                "slow_query_threshold": {
+                    "description": "慢查询阈值（秒）",
+                    "type": "number"
+                },
+                "table_count": {
+                    "description": "表数量",
+                    "type": "integer"
+                },
+                "uptime": {
+                    "description": "数据库运行时间（秒）",
+                    "type": "integer"
+                },
+                "version": {
+                    "description": "数据库版本",
+                    "type": "string"
                 }
             }
         },
-        "schema.ArticleVisitTrendItem": {
+        "schema.DigestSettingResponse": {
             "type": "object",
             "properties": {
-                "date": {
-                    "type": "string",
-                    "example": "2023-01-01"
+                "frequency": {
+                    "description": "摘要频率",
+                    "type": "string"
                 },
-                "visit_count": {
-                    "type": "integer"
+                "last_sent_at": {
+                    "description": "最近一次发送摘要的时间",
+                    "type": "string"
+                },
+                "locale": {
+                    "description": "邮件语言",
+                    "type": "string"
                 }
             }
         },
-        "schema.AssistantResponse": {
+        "schema.DiskInfo": {
             "type": "object",
             "properties": {
-                "content": {
-                    "description": "数据流内容",
-                    "type": "string"
+                "io_counters_read_bytes": {
+                    "description": "自系统启动以来读取的总字节数",
+                    "type": "integer"
                 },
-                "contents": {
-                    "description": "非流式传输",
+                "io_counters_read_count": {
+                    "description": "自系统启动以来的读取操作总次数",
+                    "type": "integer"
+                },
+                "io_counters_write_bytes": {
+                    "description": "自系统启动以来写入的总字节数",
+                    "type": "integer"
+                },
+                "io_counters_write_count": {
+                    "description": "自系统启动以来的写入操作总次数",
+                    "type": "integer"
+                },
+                "partitions": {
+                    "description": "磁盘分区信息",
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/schema.PartitionInfo"
                     }
-                },
-                "is_error": {
-                    "description": "流式传输",
-                    "type": "boolean"
                 }
             }
         },
-        "schema.AvatarResponse": {
+        "schema.EnforcerResponse": {
             "type": "object",
             "properties": {
-                "url": {
-                    "type": "string"
+                "allowed": {
+                    "type": "boolean"
                 }
             }
         },
-        "schema.CPUInfo": {
+        "schema.FavoriteFolderResponse": {
             "type": "object",
             "properties": {
-                "cache_size": {
-                    "description": "CPU缓存大小（字节）",
+                "article_count": {
+                    "description": "收藏夹中的文章数量",
                     "type": "integer"
                 },
-                "cores": {
-                    "description": "CPU核心数",
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "frequency": {
-                    "description": "CPU频率（MHz）",
-                    "type": "number"
+                "is_default": {
+                    "type": "boolean"
                 },
-                "model_name": {
-                    "description": "CPU型号信息",
+                "is_public": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
-                "per_core_percent": {
-                    "description": "每个CPU核心的使用率",
-                    "type": "array",
-                    "items": {
-                        "type": "number"
-                    }
+                "updated_at": {
+                    "type": "string"
                 },
-                "usage_percent": {
-                    "description": "CPU使用率（百分比）",
-                    "type": "number"
+                "user_id": {
+                    "type": "integer"
                 }
             }
         },
-        "schema.CacheInfo": {
+        "schema.GoRuntimeInfo": {
             "type": "object",
             "properties": {
-                "cache_type": {
-                    "description": "缓存类型",
-                    "type": "string"
-                },
-                "connected_clients": {
-                    "description": "已连接客户端数量",
+                "gc_count": {
+                    "description": "自程序启动以来GC次数",
                     "type": "integer"
                 },
-                "hit_rate": {
-                    "description": "命中率",
-                    "type": "number"
+                "gc_pause_ns": {
+                    "description": "最近一次GC暂停时间（纳秒）",
+                    "type": "integer"
                 },
-                "key_count": {
-                    "description": "键数量",
+                "goroutines": {
+                    "description": "当前goroutine数量",
                     "type": "integer"
                 },
-                "used_memory": {
-                    "description": "已使用内存（字节）",
+                "heap_alloc": {
+                    "description": "堆分配字节数",
                     "type": "integer"
                 },
-                "used_memory_peak": {
-                    "description": "已使用内存峰值（字节）",
+                "heap_objects": {
+                    "description": "堆对象数量",
                     "type": "integer"
                 },
                 "version": {
-                    "description": "缓存版本",
+                    "description": "Go版本",
                     "type": "string"
                 }
             }
         },
-        "schema.Captcha": {
+        "schema.GuestTokenRequest": {
             "type": "object",
+            "required": [
+                "token"
+            ],
             "properties": {
-                "captcha_id": {
+                "token": {
+                    "description": "邮件链接中的令牌",
                     "type": "string"
                 }
             }
         },
-        "schema.CategoryDistItem": {
+        "schema.HideCommentRequest": {
+            "type": "object",
+            "properties": {
+                "review_remark": {
+                    "description": "隐藏原因",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.HostBrokenCount": {
             "type": "object",
             "properties": {
                 "count": {
                     "type": "integer"
                 },
-                "name": {
+                "host": {
                     "type": "string"
                 }
             }
         },
-        "schema.CategoryPaginationResult": {
+        "schema.InteractionResponse": {
             "type": "object",
             "properties": {
-                "categories_with_article": {
-                    "description": "有文章的分类数量",
+                "favorited": {
+                    "description": "是否已收藏",
+                    "type": "boolean"
+                },
+                "liked": {
+                    "description": "是否已点赞",
+                    "type": "boolean"
+                }
+            }
+        },
+        "schema.LinkCheckSummary": {
+            "type": "object",
+            "properties": {
+                "affected_articles": {
+                    "description": "包含失效或无法访问链接的文章数",
                     "type": "integer"
                 },
-                "category_name_with_most_article": {
-                    "description": "文章数量最多的分类名称",
+                "broken": {
+                    "description": "失效链接数",
+                    "type": "integer"
+                },
+                "last_checked_at": {
+                    "description": "最近一次检查时间",
                     "type": "string"
                 },
-                "items": {
+                "ok": {
+                    "description": "可用链接数",
+                    "type": "integer"
+                },
+                "pending": {
+                    "description": "等待检查的链接数",
+                    "type": "integer"
+                },
+                "top_authors": {
+                    "description": "失效链接最多的作者",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.CategoryResponse"
+                        "$ref": "#/definitions/schema.AuthorBrokenCount"
                     }
                 },
-                "most_article_counts": {
-                    "description": "分类中最多的文章数量",
+                "top_hosts": {
+                    "description": "失效链接最多的主机",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.HostBrokenCount"
+                    }
+                },
+                "total_links": {
+                    "description": "链接总数",
                     "type": "integer"
                 },
-                "page": {
+                "unreachable": {
+                    "description": "无法访问的链接数",
                     "type": "integer"
+                }
+            }
+        },
+        "schema.ListModelsResponse": {
+            "type": "object",
+            "properties": {
+                "models": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.Model"
+                    }
                 },
-                "page_size": {
+                "page": {
                     "type": "integer"
                 },
-                "total_articles": {
-                    "description": "有分类的文章总数",
+                "page_size": {
                     "type": "integer"
                 },
-                "total_categories": {
-                    "description": "分类总数",
+                "total": {
                     "type": "integer"
                 },
                 "total_pages": {
@@ -5325,37 +12197,81 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.CategoryResponse": {
+        "schema.ListPolicyResponse": {
             "type": "object",
             "properties": {
-                "article_count": {
-                    "description": "文章数量",
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.PolicyItem"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
                     "type": "integer"
                 },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.Logger": {
+            "type": "object",
+            "properties": {
                 "created_at": {
+                    "description": "创建时间",
                     "type": "string"
                 },
-                "description": {
+                "data": {
+                    "description": "日志数据",
                     "type": "string"
                 },
                 "id": {
-                    "type": "integer"
+                    "description": "日志ID",
+                    "type": "string"
                 },
-                "name": {
+                "level": {
+                    "description": "日志级别",
                     "type": "string"
                 },
-                "updated_at": {
+                "message": {
+                    "description": "日志消息",
+                    "type": "string"
+                },
+                "stack": {
+                    "description": "错误堆栈",
+                    "type": "string"
+                },
+                "tag": {
+                    "description": "日志标签",
+                    "type": "string"
+                },
+                "trace_id": {
+                    "description": "链路ID",
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "用户ID",
+                    "type": "integer"
+                },
+                "username": {
+                    "description": "用户名称（不存储在数据库中）",
                     "type": "string"
                 }
             }
         },
-        "schema.CommentPaginationResult": {
+        "schema.LoggerPaginationResult": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.CommentResponse"
+                        "$ref": "#/definitions/schema.Logger"
                     }
                 },
                 "page": {
@@ -5372,252 +12288,422 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.CommentResponse": {
+        "schema.LoginResponse": {
             "type": "object",
             "properties": {
-                "article_id": {
+                "token": {
+                    "$ref": "#/definitions/schema.LoginToken"
+                },
+                "user": {
+                    "$ref": "#/definitions/schema.UserResponse"
+                }
+            }
+        },
+        "schema.LoginToken": {
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string"
+                },
+                "expires_at": {
                     "type": "integer"
                 },
-                "article_title": {
-                    "description": "文章标题",
+                "token_type": {
                     "type": "string"
+                }
+            }
+        },
+        "schema.MailOutbox": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
                 },
-                "author": {
-                    "description": "作者名称",
+                "created_at": {
                     "type": "string"
                 },
-                "author_id": {
+                "id": {
                     "type": "integer"
                 },
-                "avatar": {
-                    "description": "作者头像",
+                "last_error": {
                     "type": "string"
                 },
-                "content": {
+                "locale": {
                     "type": "string"
                 },
-                "created_at": {
+                "next_attempt_at": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
+                "sent_at": {
+                    "type": "string"
                 },
-                "level": {
-                    "description": "评论层级",
-                    "type": "integer"
+                "status": {
+                    "type": "string"
                 },
-                "parent_author": {
-                    "description": "父评论作者",
+                "subject": {
                     "type": "string"
                 },
-                "parent_content": {
-                    "description": "父评论内容",
+                "template": {
                     "type": "string"
                 },
-                "parent_id": {
+                "to": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.MarkAllReadRequest": {
+            "type": "object",
+            "properties": {
+                "type": {
+                    "description": "为空时标记所有类型",
+                    "type": "string",
+                    "enum": [
+                        "reply",
+                        "like",
+                        "favorite",
+                        "mention",
+                        "review",
+                        "follow"
+                    ]
+                }
+            }
+        },
+        "schema.MemoryInfo": {
+            "type": "object",
+            "properties": {
+                "available": {
+                    "description": "可用内存（字节）",
                     "type": "integer"
                 },
-                "reply_count": {
-                    "description": "回复数量",
+                "swap_free": {
+                    "description": "可用交换分区（字节）",
                     "type": "integer"
                 },
-                "review_remark": {
-                    "description": "审核备注",
-                    "type": "string"
+                "swap_total": {
+                    "description": "交换分区总大小（字节）",
+                    "type": "integer"
                 },
-                "reviewed_at": {
-                    "description": "审核时间",
-                    "type": "string"
+                "swap_usage_percent": {
+                    "description": "交换分区使用率（百分比）",
+                    "type": "number"
                 },
-                "reviewer_avatar": {
-                    "description": "审核员头像",
-                    "type": "string"
+                "swap_used": {
+                    "description": "交换分区已用（字节）",
+                    "type": "integer"
                 },
-                "reviewer_id": {
-                    "description": "审核员ID",
+                "total": {
+                    "description": "总内存（字节）",
+                    "type": "integer"
+                },
+                "usage_percent": {
+                    "description": "内存使用率（百分比）",
+                    "type": "number"
+                },
+                "used": {
+                    "description": "已使用内存（字节）",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.MentionPaginationResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.MentionResponse"
+                    }
+                },
+                "page": {
                     "type": "integer"
                 },
-                "reviewer_name": {
-                    "description": "审核员名称",
-                    "type": "string"
+                "page_size": {
+                    "type": "integer"
                 },
-                "root_id": {
-                    "description": "根评论ID",
+                "total": {
                     "type": "integer"
                 },
-                "status": {
-                    "description": "审核状态",
+                "total_pages": {
                     "type": "integer"
                 }
             }
         },
-        "schema.CommentStatisticResponse": {
+        "schema.MentionResponse": {
             "type": "object",
             "properties": {
-                "passed_comments": {
+                "article_id": {
                     "type": "integer"
                 },
-                "pending_comments": {
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "rejected_comments": {
+                "mentioner": {
+                    "type": "string"
+                },
+                "mentioner_id": {
                     "type": "integer"
                 },
-                "total_comments": {
+                "source_id": {
                     "type": "integer"
+                },
+                "source_type": {
+                    "type": "string"
                 }
             }
         },
-        "schema.CoverResponse": {
+        "schema.MentionSettingResponse": {
             "type": "object",
             "properties": {
-                "url": {
+                "blocked": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.MentionedUser"
+                    }
+                },
+                "policy": {
                     "type": "string"
                 }
             }
         },
-        "schema.DatabaseInfo": {
+        "schema.MentionedUser": {
             "type": "object",
             "properties": {
-                "active_transactions": {
-                    "description": "活跃事务数量",
+                "id": {
                     "type": "integer"
                 },
-                "cache_hit_rate": {
-                    "description": "缓存命中率（百分比）",
+                "url": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.Model": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "是否激活",
+                    "type": "boolean"
+                },
+                "api_key": {
+                    "description": "API密钥",
+                    "type": "string"
+                },
+                "avg_latency": {
+                    "description": "请求平均延迟（毫秒）",
                     "type": "number"
                 },
-                "db_size": {
-                    "description": "数据库大小（字节）",
+                "created_at": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "current_tokens": {
+                    "description": "当前可用令牌数",
                     "type": "integer"
                 },
-                "db_type": {
-                    "description": "数据库类型",
+                "description": {
+                    "description": "描述",
                     "type": "string"
                 },
-                "index_count": {
-                    "description": "索引数量",
+                "endpoint": {
+                    "description": "API端点",
+                    "type": "string"
+                },
+                "failure_count": {
+                    "description": "失败请求数",
                     "type": "integer"
                 },
-                "pool": {
-                    "description": "连接池状态",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/schema.PoolStatus"
-                        }
-                    ]
+                "id": {
+                    "description": "主键",
+                    "type": "integer"
                 },
-                "qps": {
-                    "description": "每秒查询数",
-                    "type": "number"
+                "last_refill_time": {
+                    "description": "上次令牌补充时间",
+                    "type": "string"
                 },
-                "slow_query_count": {
-                    "description": "慢查询数量（最近1小时）",
-                    "type": "integer"
+                "model_name": {
+                    "description": "模型名称",
+                    "type": "string"
                 },
-                "slow_query_threshold": {
-                    "description": "慢查询阈值（秒）",
-                    "type": "number"
+                "provider": {
+                    "description": "提供商",
+                    "type": "string"
                 },
-                "table_count": {
-                    "description": "表数量",
+                "rpm": {
+                    "description": "每分钟最大请求数",
                     "type": "integer"
                 },
-                "uptime": {
-                    "description": "数据库运行时间（秒）",
+                "success_count": {
+                    "description": "成功请求数",
                     "type": "integer"
                 },
-                "version": {
-                    "description": "数据库版本",
+                "temperature": {
+                    "description": "温度参数",
+                    "type": "number"
+                },
+                "timeout": {
+                    "description": "访问超时时间（秒）",
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "description": "更新时间",
                     "type": "string"
+                },
+                "weight": {
+                    "description": "令牌桶与加权轮询相关",
+                    "type": "integer"
                 }
             }
         },
-        "schema.DiskInfo": {
+        "schema.ModelOverallStatsResponse": {
             "type": "object",
             "properties": {
-                "io_counters_read_bytes": {
-                    "description": "自系统启动以来读取的总字节数",
+                "active_models": {
                     "type": "integer"
                 },
-                "io_counters_read_count": {
-                    "description": "自系统启动以来的读取操作总次数",
+                "fastest_model": {
+                    "type": "object",
+                    "properties": {
+                        "avg_latency": {
+                            "type": "number"
+                        },
+                        "id": {
+                            "type": "integer"
+                        },
+                        "model_name": {
+                            "type": "string"
+                        },
+                        "provider": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "most_successful_model": {
+                    "type": "object",
+                    "properties": {
+                        "id": {
+                            "type": "integer"
+                        },
+                        "model_name": {
+                            "type": "string"
+                        },
+                        "provider": {
+                            "type": "string"
+                        },
+                        "success_rate": {
+                            "description": "百分比形式",
+                            "type": "number"
+                        }
+                    }
+                },
+                "most_used_model": {
+                    "type": "object",
+                    "properties": {
+                        "id": {
+                            "type": "integer"
+                        },
+                        "model_name": {
+                            "type": "string"
+                        },
+                        "provider": {
+                            "type": "string"
+                        },
+                        "request_count": {
+                            "type": "integer"
+                        }
+                    }
+                },
+                "overall_avg_latency": {
+                    "type": "number"
+                },
+                "overall_success_rate": {
+                    "description": "百分比形式",
+                    "type": "number"
+                },
+                "total_available_tokens": {
                     "type": "integer"
                 },
-                "io_counters_write_bytes": {
-                    "description": "自系统启动以来写入的总字节数",
+                "total_failure": {
                     "type": "integer"
                 },
-                "io_counters_write_count": {
-                    "description": "自系统启动以来的写入操作总次数",
+                "total_models": {
                     "type": "integer"
                 },
-                "partitions": {
-                    "description": "磁盘分区信息",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/schema.PartitionInfo"
-                    }
-                }
-            }
-        },
-        "schema.EnforcerResponse": {
-            "type": "object",
-            "properties": {
-                "allowed": {
-                    "type": "boolean"
+                "total_requests": {
+                    "type": "integer"
+                },
+                "total_success": {
+                    "type": "integer"
                 }
             }
         },
-        "schema.GoRuntimeInfo": {
+        "schema.ModerationLog": {
             "type": "object",
             "properties": {
-                "gc_count": {
-                    "description": "自程序启动以来GC次数",
-                    "type": "integer"
+                "action": {
+                    "description": "执行的动作",
+                    "type": "string"
                 },
-                "gc_pause_ns": {
-                    "description": "最近一次GC暂停时间（纳秒）",
+                "category": {
+                    "description": "审核类别",
+                    "type": "string"
+                },
+                "comment_id": {
+                    "description": "评论ID",
                     "type": "integer"
                 },
-                "goroutines": {
-                    "description": "当前goroutine数量",
+                "confidence": {
+                    "description": "置信度",
+                    "type": "number"
+                },
+                "created_at": {
+                    "description": "创建时间",
+                    "type": "string"
+                },
+                "error": {
+                    "description": "失败原因",
+                    "type": "string"
+                },
+                "id": {
+                    "description": "主键",
                     "type": "integer"
                 },
-                "heap_alloc": {
-                    "description": "堆分配字节数",
+                "latency": {
+                    "description": "模型响应耗时（毫秒）",
                     "type": "integer"
                 },
-                "heap_objects": {
-                    "description": "堆对象数量",
+                "model_id": {
+                    "description": "模型ID",
                     "type": "integer"
                 },
-                "version": {
-                    "description": "Go版本",
+                "model_name": {
+                    "description": "模型名称",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "审核理由",
                     "type": "string"
-                }
-            }
-        },
-        "schema.InteractionResponse": {
-            "type": "object",
-            "properties": {
-                "favorited": {
-                    "description": "是否已收藏",
-                    "type": "boolean"
                 },
-                "liked": {
-                    "description": "是否已点赞",
-                    "type": "boolean"
+                "suggestion": {
+                    "description": "审核建议",
+                    "type": "string"
                 }
             }
         },
-        "schema.ListModelsResponse": {
+        "schema.ModerationLogPaginationResult": {
             "type": "object",
             "properties": {
-                "models": {
+                "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.Model"
+                        "$ref": "#/definitions/schema.ModerationLog"
                     }
                 },
                 "page": {
@@ -5634,13 +12720,24 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.ListPolicyResponse": {
+        "schema.MoveFavoriteFolderItemRequest": {
+            "type": "object",
+            "required": [
+                "target_folder_id"
+            ],
+            "properties": {
+                "target_folder_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.NotificationPaginationResult": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.PolicyItem"
+                        "$ref": "#/definitions/schema.NotificationResponse"
                     }
                 },
                 "page": {
@@ -5657,58 +12754,119 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.Logger": {
+        "schema.NotificationResponse": {
             "type": "object",
             "properties": {
+                "actor_count": {
+                    "description": "合并的触发者人数",
+                    "type": "integer"
+                },
+                "actor_id": {
+                    "description": "最近一位触发者的用户ID",
+                    "type": "integer"
+                },
+                "actor_name": {
+                    "description": "最近一位触发者的名称",
+                    "type": "string"
+                },
+                "article_id": {
+                    "description": "相关文章ID",
+                    "type": "integer"
+                },
+                "article_title": {
+                    "description": "相关文章标题",
+                    "type": "string"
+                },
+                "comment_id": {
+                    "description": "相关评论ID",
+                    "type": "integer"
+                },
+                "content": {
+                    "description": "评论摘要或审核备注",
+                    "type": "string"
+                },
                 "created_at": {
                     "description": "创建时间",
                     "type": "string"
                 },
-                "data": {
-                    "description": "日志数据",
+                "id": {
+                    "type": "integer"
+                },
+                "is_read": {
+                    "description": "是否已读",
+                    "type": "boolean"
+                },
+                "message": {
+                    "description": "展示文案",
                     "type": "string"
                 },
-                "id": {
-                    "description": "日志ID",
+                "read_at": {
+                    "description": "阅读时间",
                     "type": "string"
                 },
-                "level": {
-                    "description": "日志级别",
+                "result": {
+                    "description": "审核结果",
                     "type": "string"
                 },
-                "message": {
-                    "description": "日志消息",
+                "target_id": {
+                    "description": "对象ID",
+                    "type": "integer"
+                },
+                "target_type": {
+                    "description": "对象类型",
                     "type": "string"
                 },
-                "stack": {
-                    "description": "错误堆栈",
+                "type": {
                     "type": "string"
                 },
-                "tag": {
-                    "description": "日志标签",
+                "updated_at": {
+                    "description": "最近一次触发的时间",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.OEmbedResponse": {
+            "type": "object",
+            "properties": {
+                "author_name": {
                     "type": "string"
                 },
-                "trace_id": {
-                    "description": "链路ID",
+                "cache_age": {
+                    "type": "integer"
+                },
+                "provider_name": {
                     "type": "string"
                 },
-                "user_id": {
-                    "description": "用户ID",
+                "provider_url": {
+                    "type": "string"
+                },
+                "thumbnail_height": {
                     "type": "integer"
                 },
-                "username": {
-                    "description": "用户名称（不存储在数据库中）",
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "thumbnail_width": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "version": {
                     "type": "string"
                 }
             }
         },
-        "schema.LoggerPaginationResult": {
+        "schema.OutboxPaginationResult": {
             "type": "object",
             "properties": {
                 "items": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/schema.Logger"
+                        "$ref": "#/definitions/schema.MailOutbox"
                     }
                 },
                 "page": {
@@ -5725,223 +12883,117 @@ const docTemplate = `{
                 }
             }
         },
-        "schema.LoginResponse": {
+        "schema.PageNavItem": {
             "type": "object",
             "properties": {
-                "token": {
-                    "$ref": "#/definitions/schema.LoginToken"
+                "nav_order": {
+                    "type": "integer"
                 },
-                "user": {
-                    "$ref": "#/definitions/schema.UserResponse"
-                }
-            }
-        },
-        "schema.LoginToken": {
-            "type": "object",
-            "properties": {
-                "access_token": {
+                "slug": {
                     "type": "string"
                 },
-                "expires_at": {
-                    "type": "integer"
-                },
-                "token_type": {
+                "title": {
                     "type": "string"
                 }
             }
         },
-        "schema.MemoryInfo": {
+        "schema.PagePaginationResult": {
             "type": "object",
             "properties": {
-                "available": {
-                    "description": "可用内存（字节）",
-                    "type": "integer"
-                },
-                "swap_free": {
-                    "description": "可用交换分区（字节）",
-                    "type": "integer"
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.PageResponse"
+                    }
                 },
-                "swap_total": {
-                    "description": "交换分区总大小（字节）",
+                "page": {
                     "type": "integer"
                 },
-                "swap_usage_percent": {
-                    "description": "交换分区使用率（百分比）",
-                    "type": "number"
-                },
-                "swap_used": {
-                    "description": "交换分区已用（字节）",
+                "page_size": {
                     "type": "integer"
                 },
                 "total": {
-                    "description": "总内存（字节）",
                     "type": "integer"
                 },
-                "usage_percent": {
-                    "description": "内存使用率（百分比）",
-                    "type": "number"
-                },
-                "used": {
-                    "description": "已使用内存（字节）",
+                "total_pages": {
                     "type": "integer"
                 }
             }
         },
-        "schema.Model": {
+        "schema.PageResponse": {
             "type": "object",
             "properties": {
-                "active": {
-                    "description": "是否激活",
-                    "type": "boolean"
-                },
-                "api_key": {
-                    "description": "API密钥",
+                "content": {
                     "type": "string"
                 },
-                "avg_latency": {
-                    "description": "请求平均延迟（毫秒）",
-                    "type": "number"
-                },
                 "created_at": {
-                    "description": "创建时间",
-                    "type": "string"
-                },
-                "current_tokens": {
-                    "description": "当前可用令牌数",
-                    "type": "integer"
-                },
-                "description": {
-                    "description": "描述",
                     "type": "string"
                 },
-                "endpoint": {
-                    "description": "API端点",
+                "editor": {
+                    "description": "最后编辑人名称",
                     "type": "string"
                 },
-                "failure_count": {
-                    "description": "失败请求数",
+                "editor_id": {
                     "type": "integer"
                 },
                 "id": {
-                    "description": "主键",
                     "type": "integer"
                 },
-                "last_refill_time": {
-                    "description": "上次令牌补充时间",
-                    "type": "string"
+                "nav_order": {
+                    "type": "integer"
                 },
-                "model_name": {
-                    "description": "模型名称",
-                    "type": "string"
+                "show_in_nav": {
+                    "type": "boolean"
                 },
-                "provider": {
-                    "description": "提供商",
+                "slug": {
                     "type": "string"
                 },
-                "rpm": {
-                    "description": "每分钟最大请求数",
-                    "type": "integer"
-                },
-                "success_count": {
-                    "description": "成功请求数",
-                    "type": "integer"
-                },
-                "temperature": {
-                    "description": "温度参数",
-                    "type": "number"
+                "status": {
+                    "type": "string"
                 },
-                "timeout": {
-                    "description": "访问超时时间（秒）",
-                    "type": "integer"
+                "title": {
+                    "type": "string"
                 },
                 "updated_at": {
-                    "description": "更新时间",
                     "type": "string"
                 },
-                "weight": {
-                    "description": "令牌桶与加权轮询相关",
+                "version": {
                     "type": "integer"
                 }
             }
         },
-        "schema.ModelOverallStatsResponse": {
+        "schema.PageRevisionResponse": {
             "type": "object",
             "properties": {
-                "active_models": {
-                    "type": "integer"
-                },
-                "fastest_model": {
-                    "type": "object",
-                    "properties": {
-                        "avg_latency": {
-                            "type": "number"
-                        },
-                        "id": {
-                            "type": "integer"
-                        },
-                        "model_name": {
-                            "type": "string"
-                        },
-                        "provider": {
-                            "type": "string"
-                        }
-                    }
-                },
-                "most_successful_model": {
-                    "type": "object",
-                    "properties": {
-                        "id": {
-                            "type": "integer"
-                        },
-                        "model_name": {
-                            "type": "string"
-                        },
-                        "provider": {
-                            "type": "string"
-                        },
-                        "success_rate": {
-                            "description": "百分比形式",
-                            "type": "number"
-                        }
-                    }
-                },
-                "most_used_model": {
-                    "type": "object",
-                    "properties": {
-                        "id": {
-                            "type": "integer"
-                        },
-                        "model_name": {
-                            "type": "string"
-                        },
-                        "provider": {
-                            "type": "string"
-                        },
-                        "request_count": {
-                            "type": "integer"
-                        }
-                    }
+                "content": {
+                    "type": "string"
                 },
-                "overall_avg_latency": {
-                    "type": "number"
+                "created_at": {
+                    "type": "string"
                 },
-                "overall_success_rate": {
-                    "description": "百分比形式",
-                    "type": "number"
+                "editor": {
+                    "description": "编辑人名称",
+                    "type": "string"
                 },
-                "total_available_tokens": {
+                "editor_id": {
                     "type": "integer"
                 },
-                "total_failure": {
+                "id": {
                     "type": "integer"
                 },
-                "total_models": {
+                "page_id": {
                     "type": "integer"
                 },
-                "total_requests": {
-                    "type": "integer"
+                "remark": {
+                    "type": "string"
                 },
-                "total_success": {
+                "slug": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "version": {
                     "type": "integer"
                 }
             }
@@ -5979,6 +13031,15 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.PinCommentRequest": {
+            "type": "object",
+            "properties": {
+                "pinned": {
+                    "description": "true-置顶，false-取消置顶",
+                    "type": "boolean"
+                }
+            }
+        },
         "schema.PolicyItem": {
             "type": "object",
             "properties": {
@@ -6037,6 +13098,157 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.ReactCommentRequest": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "type": {
+                    "description": "表态类型",
+                    "type": "string",
+                    "enum": [
+                        "like",
+                        "heart",
+                        "laugh",
+                        "hooray",
+                        "confused"
+                    ]
+                }
+            }
+        },
+        "schema.ReadingProgressRequest": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "description": "滚动位置（像素）",
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "progress": {
+                    "description": "阅读百分比",
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                }
+            }
+        },
+        "schema.ReadingProgressResponse": {
+            "type": "object",
+            "properties": {
+                "article_id": {
+                    "type": "integer"
+                },
+                "finished": {
+                    "type": "boolean"
+                },
+                "position": {
+                    "type": "integer"
+                },
+                "progress": {
+                    "type": "number"
+                },
+                "updated_at": {
+                    "description": "从未阅读时为空",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.RestorePageRevisionRequest": {
+            "type": "object",
+            "properties": {
+                "remark": {
+                    "description": "修改说明",
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "schema.ReviewArticleRequest": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "description": "审核操作：approve-通过，reject-拒绝，request_changes-要求修改",
+                    "type": "string",
+                    "enum": [
+                        "approve",
+                        "reject",
+                        "request_changes"
+                    ]
+                },
+                "remark": {
+                    "description": "审核备注，拒绝或要求修改时必填",
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "schema.SendTestMailRequest": {
+            "type": "object",
+            "required": [
+                "to"
+            ],
+            "properties": {
+                "locale": {
+                    "description": "邮件语言，为空时使用默认语言",
+                    "type": "string",
+                    "maxLength": 20
+                },
+                "to": {
+                    "description": "收件人地址",
+                    "type": "string"
+                }
+            }
+        },
+        "schema.SensitiveWord": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/sensitive.Action"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "word": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.SensitiveWordPaginationResult": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/schema.SensitiveWord"
+                    }
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "page_size": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
         "schema.SiteOverviewResponse": {
             "type": "object",
             "properties": {
@@ -6060,6 +13272,23 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.SpamSignal": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "description": "说明",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "信号名称",
+                    "type": "string"
+                },
+                "score": {
+                    "description": "信号分数，负数表示倾向正常",
+                    "type": "integer"
+                }
+            }
+        },
         "schema.SystemInfo": {
             "type": "object",
             "properties": {
@@ -6149,6 +13378,193 @@ const docTemplate = `{
                 }
             }
         },
+        "schema.UnreadCountResponse": {
+            "type": "object",
+            "properties": {
+                "by_type": {
+                    "description": "各类型的未读数",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "total": {
+                    "description": "未读总数",
+                    "type": "integer"
+                }
+            }
+        },
+        "schema.UpdateCommentRequest": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                }
+            }
+        },
+        "schema.UpdateCommentSettingRequest": {
+            "type": "object",
+            "properties": {
+                "auto_close_days": {
+                    "description": "自动关闭评论的天数，从文章首次发布时起算",
+                    "type": "integer",
+                    "maximum": 3650,
+                    "minimum": 1
+                },
+                "moderation": {
+                    "description": "审核模式：pre-先审后发，post-先发后审，trusted-仅限受信任用户评论",
+                    "type": "string",
+                    "enum": [
+                        "pre",
+                        "post",
+                        "trusted"
+                    ]
+                },
+                "status": {
+                    "description": "评论开关：open-开放，closed-关闭，auto-发布指定天数后自动关闭",
+                    "type": "string",
+                    "enum": [
+                        "open",
+                        "closed",
+                        "auto"
+                    ]
+                }
+            }
+        },
+        "schema.UpdateDigestRequest": {
+            "type": "object",
+            "required": [
+                "frequency"
+            ],
+            "properties": {
+                "frequency": {
+                    "description": "摘要频率",
+                    "type": "string",
+                    "enum": [
+                        "none",
+                        "daily",
+                        "weekly"
+                    ]
+                },
+                "locale": {
+                    "description": "邮件语言，为空时使用默认语言",
+                    "type": "string",
+                    "maxLength": 20
+                }
+            }
+        },
+        "schema.UpdateFavoriteFolderRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "is_public": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "schema.UpdateMentionSettingRequest": {
+            "type": "object",
+            "required": [
+                "policy"
+            ],
+            "properties": {
+                "policy": {
+                    "type": "string",
+                    "enum": [
+                        "everyone",
+                        "nobody"
+                    ]
+                }
+            }
+        },
+        "schema.UpdatePageRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "nav_order": {
+                    "type": "integer"
+                },
+                "remark": {
+                    "description": "修改说明",
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "show_in_nav": {
+                    "type": "boolean"
+                },
+                "slug": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "published",
+                        "draft"
+                    ]
+                },
+                "title": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "schema.UpdatePreferencesRequest": {
+            "type": "object",
+            "required": [
+                "preferences"
+            ],
+            "properties": {
+                "preferences": {
+                    "description": "通知类型 -\u003e 是否接收",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "boolean"
+                    }
+                }
+            }
+        },
+        "schema.UpdateSensitiveWordRequest": {
+            "type": "object",
+            "required": [
+                "action",
+                "word"
+            ],
+            "properties": {
+                "action": {
+                    "enum": [
+                        "block",
+                        "mask",
+                        "review"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/sensitive.Action"
+                        }
+                    ]
+                },
+                "category": {
+                    "type": "string",
+                    "maxLength": 50
+                },
+                "word": {
+                    "type": "string",
+                    "maxLength": 100
+                }
+            }
+        },
         "schema.UserActivityTrendItem": {
             "type": "object",
             "properties": {
@@ -6203,6 +13619,53 @@ const docTemplate = `{
                 }
             }
         },
+        "sensitive.Action": {
+            "type": "string",
+            "enum": [
+                "mask",
+                "review",
+                "block"
+            ],
+            "x-enum-comments": {
+                "ActionBlock": "直接拒绝",
+                "ActionMask": "替换为掩码后放行",
+                "ActionReview": "转人工审核"
+            },
+            "x-enum-varnames": [
+                "ActionMask",
+                "ActionReview",
+                "ActionBlock"
+            ]
+        },
+        "sensitive.Hit": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "处理方式",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/sensitive.Action"
+                        }
+                    ]
+                },
+                "category": {
+                    "description": "敏感词分类",
+                    "type": "string"
+                },
+                "end": {
+                    "description": "在原文中的结束位置（不含，按字符计）",
+                    "type": "integer"
+                },
+                "start": {
+                    "description": "在原文中的起始位置（按字符计）",
+                    "type": "integer"
+                },
+                "word": {
+                    "description": "命中的敏感词",
+                    "type": "string"
+                }
+            }
+        },
         "util.ResponseResult": {
             "type": "object",
             "properties": {
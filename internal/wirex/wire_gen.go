@@ -9,26 +9,54 @@ package wirex
 import (
 	"context"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub"
+	api9 "github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/api"
+	biz9 "github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/biz"
+	dal9 "github.com/codeExpert666/goinkblog-backend/internal/mods/activitypub/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/ai"
-	api5 "github.com/codeExpert666/goinkblog-backend/internal/mods/ai/api"
-	biz5 "github.com/codeExpert666/goinkblog-backend/internal/mods/ai/biz"
-	dal5 "github.com/codeExpert666/goinkblog-backend/internal/mods/ai/dal"
+	api12 "github.com/codeExpert666/goinkblog-backend/internal/mods/ai/api"
+	biz12 "github.com/codeExpert666/goinkblog-backend/internal/mods/ai/biz"
+	dal12 "github.com/codeExpert666/goinkblog-backend/internal/mods/ai/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/auth"
-	"github.com/codeExpert666/goinkblog-backend/internal/mods/auth/api"
-	"github.com/codeExpert666/goinkblog-backend/internal/mods/auth/biz"
-	"github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
+	api2 "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/api"
+	biz2 "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/biz"
+	dal2 "github.com/codeExpert666/goinkblog-backend/internal/mods/auth/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/blog"
-	api2 "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/api"
-	biz2 "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/biz"
-	dal2 "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
+	api6 "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/api"
+	biz6 "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/biz"
+	dal4 "github.com/codeExpert666/goinkblog-backend/internal/mods/blog/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/comment"
-	api3 "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/api"
-	biz3 "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/biz"
-	dal3 "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/dal"
+	api7 "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/api"
+	biz7 "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/biz"
+	dal8 "github.com/codeExpert666/goinkblog-backend/internal/mods/comment/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck"
+	api10 "github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/api"
+	biz10 "github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/biz"
+	dal10 "github.com/codeExpert666/goinkblog-backend/internal/mods/linkcheck/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mail"
+	api4 "github.com/codeExpert666/goinkblog-backend/internal/mods/mail/api"
+	biz4 "github.com/codeExpert666/goinkblog-backend/internal/mods/mail/biz"
+	dal5 "github.com/codeExpert666/goinkblog-backend/internal/mods/mail/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/mention"
+	api5 "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/api"
+	biz5 "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/biz"
+	dal6 "github.com/codeExpert666/goinkblog-backend/internal/mods/mention/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/notification"
+	api3 "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/api"
+	biz3 "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/biz"
+	dal3 "github.com/codeExpert666/goinkblog-backend/internal/mods/notification/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/api"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/biz"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/sensitive/dal"
 	"github.com/codeExpert666/goinkblog-backend/internal/mods/stat"
-	api4 "github.com/codeExpert666/goinkblog-backend/internal/mods/stat/api"
-	biz4 "github.com/codeExpert666/goinkblog-backend/internal/mods/stat/biz"
-	dal4 "github.com/codeExpert666/goinkblog-backend/internal/mods/stat/dal"
+	api11 "github.com/codeExpert666/goinkblog-backend/internal/mods/stat/api"
+	biz11 "github.com/codeExpert666/goinkblog-backend/internal/mods/stat/biz"
+	dal11 "github.com/codeExpert666/goinkblog-backend/internal/mods/stat/dal"
+	"github.com/codeExpert666/goinkblog-backend/internal/mods/webmention"
+	api8 "github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/api"
+	biz8 "github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/biz"
+	dal7 "github.com/codeExpert666/goinkblog-backend/internal/mods/webmention/dal"
 	"github.com/codeExpert666/goinkblog-backend/pkg/util"
 )
 
@@ -51,30 +79,50 @@ func BuildInjector(ctx context.Context) (*Injector, func(), error) {
 		cleanup()
 		return nil, nil, err
 	}
-	userRepository := &dal.UserRepository{
+	sensitiveWordRepository := &dal.SensitiveWordRepository{
 		DB: db,
 	}
-	authService := &biz.AuthService{
-		UserRepository: userRepository,
-		Auth:           auther,
-		Cache:          cacher,
+	sensitiveFilter := &biz.SensitiveFilter{
+		Cache:                   cacher,
+		SensitiveWordRepository: sensitiveWordRepository,
+	}
+	sensitiveWordService := &biz.SensitiveWordService{
+		SensitiveWordRepository: sensitiveWordRepository,
+		SensitiveFilter:         sensitiveFilter,
+	}
+	sensitiveWordHandler := &api.SensitiveWordHandler{
+		SensitiveWordService: sensitiveWordService,
+	}
+	sensitiveSensitive := &sensitive.Sensitive{
+		DB:                   db,
+		SensitiveFilter:      sensitiveFilter,
+		SensitiveWordHandler: sensitiveWordHandler,
+	}
+	userRepository := &dal2.UserRepository{
+		DB: db,
 	}
-	authHandler := &api.AuthHandler{
+	authService := &biz2.AuthService{
+		UserRepository:  userRepository,
+		Auth:            auther,
+		Cache:           cacher,
+		SensitiveFilter: sensitiveFilter,
+	}
+	authHandler := &api2.AuthHandler{
 		AuthService: authService,
 	}
-	casbinRepository := &dal.CasbinRepository{
+	casbinRepository := &dal2.CasbinRepository{
 		Cache: cacher,
 		DB:    db,
 	}
-	casbinx := biz.Casbinx{
+	casbinx := biz2.Casbinx{
 		Cache:            cacher,
 		CasbinRepository: casbinRepository,
 	}
-	casbinService := &biz.CasbinService{
+	casbinService := &biz2.CasbinService{
 		CasbinRepository: casbinRepository,
 		Casbinx:          casbinx,
 	}
-	casbinHandler := &api.CasbinHandler{
+	casbinHandler := &api2.CasbinHandler{
 		CasbinService: casbinService,
 	}
 	authAuth := &auth.Auth{
@@ -82,119 +130,334 @@ func BuildInjector(ctx context.Context) (*Injector, func(), error) {
 		AuthHandler:   authHandler,
 		CasbinHandler: casbinHandler,
 	}
-	articleRepository := &dal2.ArticleRepository{
+	notificationRepository := &dal3.NotificationRepository{
 		DB: db,
 	}
-	categoryRepository := &dal2.CategoryRepository{
+	articleRepository := &dal4.ArticleRepository{
 		DB: db,
 	}
-	tagRepository := &dal2.TagRepository{
+	trans := util.Trans{
 		DB: db,
 	}
-	articleTagRepository := &dal2.ArticleTagRepository{
+	notificationService := &biz3.NotificationService{
+		NotificationRepository: notificationRepository,
+		UserRepository:         userRepository,
+		ArticleRepository:      articleRepository,
+		Trans:                  trans,
+	}
+	notificationHandler := &api3.NotificationHandler{
+		NotificationService: notificationService,
+	}
+	notificationNotification := &notification.Notification{
+		DB:                  db,
+		NotificationHandler: notificationHandler,
+	}
+	mailRepository := &dal5.MailRepository{
 		DB: db,
 	}
-	interactionRepository := &dal2.InteractionRepository{
+	mailService := &biz4.MailService{
+		MailRepository:         mailRepository,
+		UserRepository:         userRepository,
+		NotificationRepository: notificationRepository,
+	}
+	mailHandler := &api4.MailHandler{
+		MailService: mailService,
+	}
+	mailMail := &mail.Mail{
+		DB:          db,
+		MailService: mailService,
+		MailHandler: mailHandler,
+	}
+	mentionRepository := &dal6.MentionRepository{
 		DB: db,
 	}
-	trans := util.Trans{
+	mentionService := &biz5.MentionService{
+		MentionRepository:   mentionRepository,
+		UserRepository:      userRepository,
+		NotificationService: notificationService,
+	}
+	mentionHandler := &api5.MentionHandler{
+		MentionService: mentionService,
+	}
+	mentionMention := &mention.Mention{
+		DB:             db,
+		MentionHandler: mentionHandler,
+	}
+	categoryRepository := &dal4.CategoryRepository{
 		DB: db,
 	}
-	articleService := &biz2.ArticleService{
-		ArticleRepository:     articleRepository,
-		CategoryRepository:    categoryRepository,
-		TagRepository:         tagRepository,
-		ArticleTagRepository:  articleTagRepository,
-		InteractionRepository: interactionRepository,
+	tagRepository := &dal4.TagRepository{
+		DB: db,
+	}
+	articleTagRepository := &dal4.ArticleTagRepository{
+		DB: db,
+	}
+	interactionRepository := &dal4.InteractionRepository{
+		DB: db,
+	}
+	readingProgressRepository := &dal4.ReadingProgressRepository{
+		DB: db,
+	}
+	favoriteFolderRepository := &dal4.FavoriteFolderRepository{
+		DB: db,
+	}
+	favoriteFolderService := &biz6.FavoriteFolderService{
+		FavoriteFolderRepository: favoriteFolderRepository,
+		ArticleRepository:        articleRepository,
+		InteractionRepository:    interactionRepository,
+		Trans:                    trans,
+	}
+	articleReviewRepository := &dal4.ArticleReviewRepository{
+		DB: db,
+	}
+	articleDuplicateRepository := &dal4.ArticleDuplicateRepository{
+		DB: db,
+	}
+	tagSuggester := &biz6.TagSuggester{
+		Cache:         cacher,
+		TagRepository: tagRepository,
+	}
+	webmentionRepository := &dal7.WebmentionRepository{
+		DB: db,
+	}
+	commentRepository := &dal8.CommentRepository{
+		DB: db,
+	}
+	commentReactionRepository := &dal8.CommentReactionRepository{
+		DB: db,
+	}
+	commentRevisionRepository := &dal8.CommentRevisionRepository{
+		DB: db,
+	}
+	commentSpamRepository := &dal8.CommentSpamRepository{
+		DB: db,
+	}
+	commentModeratorRepository := &dal8.CommentModeratorRepository{
+		DB: db,
+	}
+	commentSettingRepository := &dal8.CommentSettingRepository{
+		DB: db,
+	}
+	commentGuestTokenRepository := &dal8.CommentGuestTokenRepository{
+		DB: db,
+	}
+	commentService := &biz7.CommentService{
+		CommentRepository:           commentRepository,
+		CommentReactionRepository:   commentReactionRepository,
+		CommentRevisionRepository:   commentRevisionRepository,
+		CommentSpamRepository:       commentSpamRepository,
+		CommentModeratorRepository:  commentModeratorRepository,
+		CommentSettingRepository:    commentSettingRepository,
+		CommentGuestTokenRepository: commentGuestTokenRepository,
+		ArticleRepository:           articleRepository,
+		UserRepository:              userRepository,
+		AuthService:                 authService,
+		MailService:                 mailService,
+		SensitiveFilter:             sensitiveFilter,
+		MentionService:              mentionService,
+		NotificationService:         notificationService,
+		Trans:                       trans,
+	}
+	webmentionService := &biz8.WebmentionService{
+		WebmentionRepository: webmentionRepository,
+		ArticleRepository:    articleRepository,
+		CommentService:       commentService,
+	}
+	activityPubRepository := &dal9.ActivityPubRepository{
+		DB: db,
+	}
+	activityPubService := &biz9.ActivityPubService{
+		ActivityPubRepository: activityPubRepository,
 		UserRepository:        userRepository,
+		ArticleRepository:     articleRepository,
+		CommentRepository:     commentRepository,
+		CommentService:        commentService,
+		NotificationService:   notificationService,
 		Trans:                 trans,
 	}
-	articleHandler := &api2.ArticleHandler{
+	articleService := &biz6.ArticleService{
+		ArticleRepository:          articleRepository,
+		CategoryRepository:         categoryRepository,
+		TagRepository:              tagRepository,
+		ArticleTagRepository:       articleTagRepository,
+		InteractionRepository:      interactionRepository,
+		ReadingProgressRepository:  readingProgressRepository,
+		FavoriteFolderService:      favoriteFolderService,
+		ArticleReviewRepository:    articleReviewRepository,
+		ArticleDuplicateRepository: articleDuplicateRepository,
+		UserRepository:             userRepository,
+		TagSuggester:               tagSuggester,
+		SensitiveFilter:            sensitiveFilter,
+		WebmentionService:          webmentionService,
+		ActivityPubService:         activityPubService,
+		MentionService:             mentionService,
+		NotificationService:        notificationService,
+		Trans:                      trans,
+	}
+	articleHandler := &api6.ArticleHandler{
 		ArticleService: articleService,
 	}
-	categoryService := &biz2.CategoryService{
+	categoryService := &biz6.CategoryService{
 		CategoryRepository: categoryRepository,
 	}
-	categoryHandler := &api2.CategoryHandler{
+	categoryHandler := &api6.CategoryHandler{
 		CategoryService: categoryService,
 	}
 	utilTrans := &util.Trans{
 		DB: db,
 	}
-	tagService := &biz2.TagService{
+	tagService := &biz6.TagService{
 		TagRepository:        tagRepository,
 		ArticleTagRepository: articleTagRepository,
+		TagSuggester:         tagSuggester,
 		Trans:                utilTrans,
 	}
-	tagHandler := &api2.TagHandler{
+	tagHandler := &api6.TagHandler{
 		TagService: tagService,
 	}
-	blogBlog := &blog.Blog{
-		DB:              db,
-		ArticleHandler:  articleHandler,
-		CategoryHandler: categoryHandler,
-		TagHandler:      tagHandler,
+	favoriteFolderHandler := &api6.FavoriteFolderHandler{
+		FavoriteFolderService: favoriteFolderService,
+	}
+	shareService := &biz6.ShareService{
+		ArticleRepository: articleRepository,
+		UserRepository:    userRepository,
 	}
-	commentRepository := &dal3.CommentRepository{
+	shareHandler := &api6.ShareHandler{
+		ShareService: shareService,
+	}
+	pageRepository := &dal4.PageRepository{
 		DB: db,
 	}
-	commentService := &biz3.CommentService{
-		CommentRepository: commentRepository,
-		ArticleRepository: articleRepository,
-		Trans:             trans,
+	pageService := &biz6.PageService{
+		PageRepository: pageRepository,
+		UserRepository: userRepository,
+		Trans:          trans,
 	}
-	commentHandler := &api3.CommentHandler{
+	pageHandler := &api6.PageHandler{
+		PageService: pageService,
+	}
+	blogBlog := &blog.Blog{
+		DB:                    db,
+		ArticleHandler:        articleHandler,
+		CategoryHandler:       categoryHandler,
+		TagHandler:            tagHandler,
+		FavoriteFolderHandler: favoriteFolderHandler,
+		ShareHandler:          shareHandler,
+		PageHandler:           pageHandler,
+	}
+	commentHandler := &api7.CommentHandler{
 		CommentService: commentService,
 	}
 	commentComment := &comment.Comment{
 		DB:             db,
 		CommentHandler: commentHandler,
+		CommentService: commentService,
+	}
+	webmentionHandler := &api8.WebmentionHandler{
+		WebmentionService: webmentionService,
+	}
+	webmentionWebmention := &webmention.Webmention{
+		DB:                db,
+		WebmentionService: webmentionService,
+		WebmentionHandler: webmentionHandler,
 	}
-	statRepository := &dal4.StatRepository{
+	activityPubHandler := &api9.ActivityPubHandler{
+		ActivityPubService: activityPubService,
+	}
+	activityPub := &activitypub.ActivityPub{
+		DB:                 db,
+		ActivityPubService: activityPubService,
+		ActivityPubHandler: activityPubHandler,
+	}
+	linkCheckRepository := &dal10.LinkCheckRepository{
 		DB: db,
 	}
-	statService := &biz4.StatService{
-		StatRepository: statRepository,
-		Cache:          cacher,
+	linkCheckService := &biz10.LinkCheckService{
+		LinkCheckRepository: linkCheckRepository,
+		UserRepository:      userRepository,
+	}
+	linkCheckHandler := &api10.LinkCheckHandler{
+		LinkCheckService: linkCheckService,
 	}
-	statHandler := &api4.StatHandler{
+	linkCheck := &linkcheck.LinkCheck{
+		DB:               db,
+		LinkCheckService: linkCheckService,
+		LinkCheckHandler: linkCheckHandler,
+	}
+	statRepository := &dal11.StatRepository{
+		DB: db,
+	}
+	articleDailyStatRepository := &dal11.ArticleDailyStatRepository{
+		DB: db,
+	}
+	statService := &biz11.StatService{
+		StatRepository:             statRepository,
+		ArticleDailyStatRepository: articleDailyStatRepository,
+		ArticleRepository:          articleRepository,
+		Cache:                      cacher,
+	}
+	statHandler := &api11.StatHandler{
 		StatService: statService,
 	}
+	articleRollup := &biz11.ArticleRollup{
+		ArticleDailyStatRepository: articleDailyStatRepository,
+	}
 	statStat := &stat.Stat{
-		DB:          db,
-		StatHandler: statHandler,
+		DB:            db,
+		StatHandler:   statHandler,
+		ArticleRollup: articleRollup,
 	}
-	modelRepository := &dal5.ModelRepository{
+	modelRepository := &dal12.ModelRepository{
 		Cache: cacher,
 		DB:    db,
 	}
-	modelService := &biz5.ModelService{
+	modelService := &biz12.ModelService{
 		ModelRepository: modelRepository,
 	}
-	modelHandler := &api5.ModelHandler{
+	modelHandler := &api12.ModelHandler{
 		ModelService: modelService,
 	}
-	selector := &biz5.Selector{
+	selector := &biz12.Selector{
 		Cache:           cacher,
 		ModelRepository: modelRepository,
 	}
-	assistantService := &biz5.AssistantService{
+	assistantService := &biz12.AssistantService{
 		Selector: selector,
 	}
-	assistantHandler := &api5.AssistantHandler{
+	assistantHandler := &api12.AssistantHandler{
 		AssistantService: assistantService,
 	}
+	moderationRepository := &dal12.ModerationRepository{
+		DB: db,
+	}
+	moderationService := &biz12.ModerationService{
+		AssistantService:     assistantService,
+		ModerationRepository: moderationRepository,
+		CommentRepository:    commentRepository,
+		CommentService:       commentService,
+	}
+	moderationHandler := &api12.ModerationHandler{
+		ModerationService: moderationService,
+	}
 	aiAI := &ai.AI{
-		DB:               db,
-		ModelHandler:     modelHandler,
-		AssistantHandler: assistantHandler,
+		DB:                db,
+		ModelHandler:      modelHandler,
+		AssistantHandler:  assistantHandler,
+		ModerationHandler: moderationHandler,
 	}
 	modsMods := &mods.Mods{
-		Auth:    authAuth,
-		Blog:    blogBlog,
-		Comment: commentComment,
-		Stat:    statStat,
-		AI:      aiAI,
+		Sensitive:    sensitiveSensitive,
+		Auth:         authAuth,
+		Notification: notificationNotification,
+		Mail:         mailMail,
+		Mention:      mentionMention,
+		Blog:         blogBlog,
+		Comment:      commentComment,
+		Webmention:   webmentionWebmention,
+		ActivityPub:  activityPub,
+		LinkCheck:    linkCheck,
+		Stat:         statStat,
+		AI:           aiAI,
 	}
 	injector := &Injector{
 		DB:    db,
@@ -0,0 +1,218 @@
+// Package activitypub 实现 ActivityPub 联邦协议所需的数据结构、HTTP 签名与投递客户端。
+package activitypub
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const (
+	// ContentType ActivityPub 对象的媒体类型
+	ContentType = "application/activity+json"
+	// ContentTypeLD 使用 JSON-LD 声明的 ActivityPub 媒体类型
+	ContentTypeLD = `application/ld+json; profile="https://www.w3.org/ns/activitystreams"`
+	// ContentTypeJRD WebFinger 响应的媒体类型
+	ContentTypeJRD = "application/jrd+json"
+
+	// ContextActivityStreams ActivityStreams 上下文
+	ContextActivityStreams = "https://www.w3.org/ns/activitystreams"
+	// ContextSecurity 公钥声明使用的安全上下文
+	ContextSecurity = "https://w3id.org/security/v1"
+	// PublicCollection 表示公开可见的特殊集合
+	PublicCollection = "https://www.w3.org/ns/activitystreams#Public"
+)
+
+// 活动类型
+const (
+	ActivityCreate = "Create"
+	ActivityUpdate = "Update"
+	ActivityDelete = "Delete"
+	ActivityFollow = "Follow"
+	ActivityAccept = "Accept"
+	ActivityUndo   = "Undo"
+	ActivityLike   = "Like"
+)
+
+// Actor 参与者（本站中对应一个用户）
+type Actor struct {
+	Context           interface{} `json:"@context,omitempty"`
+	ID                string      `json:"id"`
+	Type              string      `json:"type"`
+	PreferredUsername string      `json:"preferredUsername,omitempty"`
+	Name              string      `json:"name,omitempty"`
+	Summary           string      `json:"summary,omitempty"`
+	URL               string      `json:"url,omitempty"`
+	Inbox             string      `json:"inbox"`
+	Outbox            string      `json:"outbox,omitempty"`
+	Followers         string      `json:"followers,omitempty"`
+	Icon              *Image      `json:"icon,omitempty"`
+	PublicKey         *PublicKey  `json:"publicKey,omitempty"`
+	Endpoints         *Endpoints  `json:"endpoints,omitempty"`
+}
+
+// SharedInbox 参与者的共享收件箱，未声明时返回个人收件箱
+func (a *Actor) SharedInbox() string {
+	if a.Endpoints != nil && a.Endpoints.SharedInbox != "" {
+		return a.Endpoints.SharedInbox
+	}
+	return a.Inbox
+}
+
+// DisplayName 参与者的展示名称
+func (a *Actor) DisplayName() string {
+	if a.Name != "" {
+		return a.Name
+	}
+	if a.PreferredUsername != "" {
+		return a.PreferredUsername
+	}
+	return a.ID
+}
+
+// PublicKey 参与者用于验证 HTTP 签名的公钥
+type PublicKey struct {
+	ID           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+// Endpoints 参与者的附加端点
+type Endpoints struct {
+	SharedInbox string `json:"sharedInbox,omitempty"`
+}
+
+// Image 图片
+type Image struct {
+	Type      string `json:"type"`
+	MediaType string `json:"mediaType,omitempty"`
+	URL       string `json:"url"`
+}
+
+// Object 内容对象，如文章（Article）与回复（Note）
+type Object struct {
+	Context      interface{} `json:"@context,omitempty"`
+	ID           string      `json:"id"`
+	Type         string      `json:"type"`
+	AttributedTo string      `json:"attributedTo,omitempty"`
+	Name         string      `json:"name,omitempty"`
+	Summary      string      `json:"summary,omitempty"`
+	Content      string      `json:"content,omitempty"`
+	URL          string      `json:"url,omitempty"`
+	InReplyTo    string      `json:"inReplyTo,omitempty"`
+	Published    *time.Time  `json:"published,omitempty"`
+	Updated      *time.Time  `json:"updated,omitempty"`
+	To           []string    `json:"to,omitempty"`
+	Cc           []string    `json:"cc,omitempty"`
+}
+
+// Activity 活动，Object 可以是对象的 IRI 或内嵌对象
+type Activity struct {
+	Context   interface{}     `json:"@context,omitempty"`
+	ID        string          `json:"id,omitempty"`
+	Type      string          `json:"type"`
+	Actor     string          `json:"actor"`
+	Object    json.RawMessage `json:"object,omitempty"`
+	Published *time.Time      `json:"published,omitempty"`
+	To        []string        `json:"to,omitempty"`
+	Cc        []string        `json:"cc,omitempty"`
+}
+
+// NewActivity 创建活动，object 可以是 IRI 字符串或任意对象
+func NewActivity(activityType, id, actor string, object interface{}) (*Activity, error) {
+	raw, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	return &Activity{
+		Context:   ContextActivityStreams,
+		ID:        id,
+		Type:      activityType,
+		Actor:     actor,
+		Object:    raw,
+		Published: &now,
+	}, nil
+}
+
+// ObjectID 返回活动对象的 IRI，无论对象以 IRI 还是内嵌对象的形式给出
+func (a *Activity) ObjectID() string {
+	var id string
+	if err := json.Unmarshal(a.Object, &id); err == nil {
+		return id
+	}
+	var obj struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(a.Object, &obj); err == nil {
+		return obj.ID
+	}
+	return ""
+}
+
+// ObjectActivity 将活动对象解析为内嵌活动（如 Undo 的对象），对象仅为 IRI 时返回 false
+func (a *Activity) ObjectActivity() (*Activity, bool) {
+	var inner Activity
+	if err := json.Unmarshal(a.Object, &inner); err != nil || inner.Type == "" {
+		return nil, false
+	}
+	return &inner, true
+}
+
+// ObjectValue 将活动对象解析为内嵌对象（如 Create 的对象），对象仅为 IRI 时返回 false
+func (a *Activity) ObjectValue() (*Object, bool) {
+	var obj Object
+	if err := json.Unmarshal(a.Object, &obj); err != nil || obj.Type == "" {
+		return nil, false
+	}
+	return &obj, true
+}
+
+// OrderedCollection 有序集合，用于发件箱与关注者列表，分页时使用 OrderedCollectionPage
+type OrderedCollection struct {
+	Context      interface{}   `json:"@context,omitempty"`
+	ID           string        `json:"id"`
+	Type         string        `json:"type"`
+	TotalItems   int64         `json:"totalItems"`
+	First        string        `json:"first,omitempty"`
+	Last         string        `json:"last,omitempty"`
+	PartOf       string        `json:"partOf,omitempty"`
+	Next         string        `json:"next,omitempty"`
+	Prev         string        `json:"prev,omitempty"`
+	OrderedItems []interface{} `json:"orderedItems,omitempty"`
+}
+
+// JRD WebFinger 响应（JSON Resource Descriptor）
+type JRD struct {
+	Subject string    `json:"subject"`
+	Aliases []string  `json:"aliases,omitempty"`
+	Links   []JRDLink `json:"links"`
+}
+
+// JRDLink WebFinger 链接
+type JRDLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href,omitempty"`
+}
+
+// IsActivityPubType 判断请求或响应的媒体类型是否为 ActivityPub 对象
+func IsActivityPubType(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return strings.Contains(contentType, "application/activity+json") ||
+		(strings.Contains(contentType, "application/ld+json") && strings.Contains(contentType, "activitystreams"))
+}
+
+// ParseAcct 解析 WebFinger 资源 acct:user@host，返回用户名与主机
+func ParseAcct(resource string) (string, string, bool) {
+	acct, ok := strings.CutPrefix(resource, "acct:")
+	if !ok {
+		return "", "", false
+	}
+	acct = strings.TrimPrefix(acct, "@")
+	user, host, ok := strings.Cut(acct, "@")
+	if !ok || user == "" || host == "" {
+		return "", "", false
+	}
+	return user, host, true
+}
@@ -0,0 +1,107 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// Options 客户端选项
+type Options struct {
+	Timeout           time.Duration // 单次请求超时时间
+	MaxBodySize       int64         // 读取响应体的最大字节数
+	UserAgent         string        // 请求使用的 User-Agent
+	AllowPrivateHosts bool          // 是否允许访问内网地址（仅用于本地测试）
+}
+
+// Client ActivityPub 客户端，负责获取远程参与者与投递活动
+type Client struct {
+	httpClient  *http.Client
+	maxBodySize int64
+	userAgent   string
+}
+
+// NewClient 创建 ActivityPub 客户端
+func NewClient(opts Options) *Client {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = 1 << 20
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = "goinkblog-activitypub"
+	}
+
+	return &Client{
+		httpClient:  util.NewExternalHTTPClient(opts.Timeout, opts.AllowPrivateHosts),
+		maxBodySize: opts.MaxBodySize,
+		userAgent:   opts.UserAgent,
+	}
+}
+
+// FetchActor 获取远程参与者
+func (c *Client) FetchActor(ctx context.Context, id string) (*Actor, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, id, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Accept", ContentType+", "+ContentTypeLD)
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxBodySize))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errors.Errorf("activitypub: fetch actor failed with status %d", resp.StatusCode)
+	}
+
+	var actor Actor
+	if err := json.Unmarshal(body, &actor); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if actor.ID == "" || actor.Inbox == "" {
+		return nil, errors.New("activitypub: invalid actor document")
+	}
+	return &actor, nil
+}
+
+// Deliver 将签名后的活动投递到收件箱
+func (c *Client) Deliver(ctx context.Context, inbox string, payload []byte, keyID string, key *rsa.PrivateKey) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, inbox, bytes.NewReader(payload))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", ContentTypeLD)
+	req.Header.Set("Accept", ContentType)
+	req.Header.Set("User-Agent", c.userAgent)
+	if err := SignRequest(req, keyID, key, payload); err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, c.maxBodySize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("activitypub: inbox responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package activitypub
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+)
+
+var blankLines = regexp.MustCompile(`\n{3,}`)
+
+// HTMLToText 将远程对象的 HTML 内容转换为纯文本，段落与换行转换为换行符
+func HTMLToText(content string) string {
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return strings.TrimSpace(content)
+	}
+
+	var sb strings.Builder
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		switch {
+		case n.Type == html.TextNode:
+			sb.WriteString(n.Data)
+		case n.Type == html.ElementNode && n.Data == "br":
+			sb.WriteString("\n")
+		case n.Type == html.ElementNode && (n.Data == "script" || n.Data == "style"):
+			return
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "p", "div", "blockquote", "li", "pre", "h1", "h2", "h3", "h4", "h5", "h6":
+				sb.WriteString("\n\n")
+			}
+		}
+	}
+	walk(doc)
+	return strings.TrimSpace(blankLines.ReplaceAllString(sb.String(), "\n\n"))
+}
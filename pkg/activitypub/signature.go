@@ -0,0 +1,161 @@
+package activitypub
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+)
+
+// ErrInvalidSignature HTTP 签名无效
+var ErrInvalidSignature = errors.New("activitypub: invalid http signature")
+
+// 签名覆盖的请求头，带请求体的请求额外覆盖 digest
+var (
+	signedHeaders         = []string{"(request-target)", "host", "date"}
+	signedHeadersWithBody = []string{"(request-target)", "host", "date", "digest"}
+)
+
+// Digest 计算请求体的 SHA-256 摘要头
+func Digest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// SignRequest 使用 RSA-SHA256 为请求生成 HTTP 签名（draft-cavage-http-signatures）
+// body 为请求体，为 nil 时不计算摘要
+func SignRequest(req *http.Request, keyID string, key *rsa.PrivateKey, body []byte) error {
+	if req.Header.Get("Date") == "" {
+		req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	}
+	if req.Host == "" {
+		req.Host = req.URL.Host
+	}
+
+	headers := signedHeaders
+	if body != nil {
+		req.Header.Set("Digest", Digest(body))
+		headers = signedHeadersWithBody
+	}
+
+	hashed := sha256.Sum256([]byte(signingString(req, headers)))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	req.Header.Set("Signature", `keyId="`+keyID+`",algorithm="rsa-sha256",headers="`+strings.Join(headers, " ")+
+		`",signature="`+base64.StdEncoding.EncodeToString(sig)+`"`)
+	return nil
+}
+
+// KeyLookup 根据签名中的 keyId 获取公钥
+type KeyLookup func(keyID string) (*rsa.PublicKey, error)
+
+// VerifyRequest 验证请求的 HTTP 签名，返回签名使用的 keyId
+// 签名必须覆盖 (request-target)、host 与 date，请求时间与当前时间的偏差不能超过 maxSkew；
+// 带请求体的请求还必须覆盖 digest 且摘要与请求体一致
+func VerifyRequest(req *http.Request, body []byte, maxSkew time.Duration, lookup KeyLookup) (string, error) {
+	params := parseSignature(req.Header.Get("Signature"))
+	keyID, sigValue := params["keyId"], params["signature"]
+	if keyID == "" || sigValue == "" {
+		return "", ErrInvalidSignature
+	}
+	if alg := strings.ToLower(params["algorithm"]); alg != "" && alg != "rsa-sha256" && alg != "hs2019" {
+		return "", errors.Wrapf(ErrInvalidSignature, "unsupported algorithm %q", alg)
+	}
+
+	headers := strings.Fields(strings.ToLower(params["headers"]))
+	if len(headers) == 0 {
+		headers = []string{"date"}
+	}
+	required := signedHeaders
+	if len(body) > 0 {
+		required = signedHeadersWithBody
+	}
+	for _, h := range required {
+		if !slices.Contains(headers, h) {
+			return "", errors.Wrapf(ErrInvalidSignature, "header %q is not signed", h)
+		}
+	}
+
+	date, err := http.ParseTime(req.Header.Get("Date"))
+	if err != nil {
+		return "", errors.Wrap(ErrInvalidSignature, "invalid date header")
+	}
+	if skew := time.Since(date); skew > maxSkew || skew < -maxSkew {
+		return "", errors.Wrap(ErrInvalidSignature, "date header is out of range")
+	}
+	if len(body) > 0 && req.Header.Get("Digest") != Digest(body) {
+		return "", errors.Wrap(ErrInvalidSignature, "digest mismatch")
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(sigValue)
+	if err != nil {
+		return "", errors.Wrap(ErrInvalidSignature, "malformed signature")
+	}
+	key, err := lookup(keyID)
+	if err != nil {
+		return "", err
+	}
+
+	hashed := sha256.Sum256([]byte(signingString(req, headers)))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], sig); err != nil {
+		return "", ErrInvalidSignature
+	}
+	return keyID, nil
+}
+
+// signingString 按签名头的顺序构造待签名字符串
+func signingString(req *http.Request, headers []string) string {
+	lines := make([]string, 0, len(headers))
+	for _, h := range headers {
+		switch h {
+		case "(request-target)":
+			lines = append(lines, h+": "+strings.ToLower(req.Method)+" "+req.URL.RequestURI())
+		case "host":
+			host := req.Host
+			if host == "" {
+				host = req.URL.Host
+			}
+			lines = append(lines, h+": "+host)
+		default:
+			lines = append(lines, h+": "+strings.Join(req.Header.Values(h), ", "))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseSignature 解析 Signature 请求头中的参数
+func parseSignature(header string) map[string]string {
+	params := make(map[string]string)
+	for header != "" {
+		key, rest, ok := strings.Cut(header, "=")
+		if !ok {
+			break
+		}
+		key = strings.TrimSpace(strings.TrimLeft(key, ", "))
+		rest = strings.TrimSpace(rest)
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			end := strings.Index(rest[1:], `"`)
+			if end < 0 {
+				break
+			}
+			value, rest = rest[1:end+1], rest[end+2:]
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		params[key] = value
+		header = strings.TrimLeft(rest, ", ")
+	}
+	return params
+}
@@ -289,6 +289,18 @@ func InternalServerError(message string, a ...interface{}) Error {
 	}
 }
 
+// NotImplemented 功能未实现
+func NotImplemented(message string, a ...interface{}) Error {
+	if message == "" {
+		message = "功能未实现"
+	}
+	return &ResponseError{
+		StatusCode: 501,
+		ErrorCode:  501,
+		ErrorMsg:   fmt.Sprintf(message, a...),
+	}
+}
+
 func ServiceUnavailableError(message string, a ...interface{}) Error {
 	if message == "" {
 		message = "服务暂时不可用，请稍后再试"
@@ -413,3 +425,8 @@ func WithStack(err error) error {
 func Errorf(format string, args ...interface{}) error {
 	return errors.Errorf(format, args...)
 }
+
+// New 创建错误
+func New(message string) error {
+	return errors.New(message)
+}
@@ -0,0 +1,226 @@
+// Package linkcheck 检查外部链接的可用性，支持并发限制与按主机的访问间隔。
+package linkcheck
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+// 链接状态常量
+const (
+	StatusOK          = "ok"          // 链接可用
+	StatusBroken      = "broken"      // 链接已失效（如 404、410）
+	StatusUnreachable = "unreachable" // 暂时无法访问（网络错误、超时、5xx、429 等），可能只是临时故障
+)
+
+// drainSize GET 请求读取并丢弃的最大响应体字节数，便于复用连接
+const drainSize = 64 << 10
+
+// Options 检查器选项
+type Options struct {
+	Timeout           time.Duration // 单次请求超时时间
+	Concurrency       int           // 同时进行的最大请求数
+	HostDelay         time.Duration // 对同一主机两次请求之间的最小间隔
+	UserAgent         string        // 请求使用的 User-Agent
+	AllowPrivateHosts bool          // 是否允许访问内网地址（仅用于本地测试）
+}
+
+// Result 单个链接的检查结果
+type Result struct {
+	URL        string    // 被检查的链接
+	Status     string    // 链接状态
+	StatusCode int       // 最终响应的状态码，请求失败时为 0
+	Err        error     // 请求失败原因
+	CheckedAt  time.Time // 检查时间
+}
+
+// Checker 链接检查器，可在多次检查之间复用，按主机的访问间隔在整个生命周期内生效
+type Checker struct {
+	httpClient *http.Client
+	userAgent  string
+	hostDelay  time.Duration
+	sem        chan struct{}
+
+	mu    sync.Mutex
+	hosts map[string]*hostGate
+}
+
+// hostGate 同一主机的请求串行执行，并保持最小间隔
+type hostGate struct {
+	mu   sync.Mutex
+	last time.Time
+}
+
+// NewChecker 创建链接检查器
+func NewChecker(opts Options) *Checker {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.Concurrency <= 0 {
+		opts.Concurrency = 4
+	}
+	if opts.HostDelay < 0 {
+		opts.HostDelay = 0
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = "goinkblog-linkcheck"
+	}
+
+	return &Checker{
+		httpClient: util.NewExternalHTTPClient(opts.Timeout, opts.AllowPrivateHosts),
+		userAgent:  opts.UserAgent,
+		hostDelay:  opts.HostDelay,
+		sem:        make(chan struct{}, opts.Concurrency),
+		hosts:      make(map[string]*hostGate),
+	}
+}
+
+// CheckAll 并发检查多个链接，结果与输入顺序一致
+func (c *Checker) CheckAll(ctx context.Context, urls []string) []Result {
+	results := make([]Result, len(urls))
+	var wg sync.WaitGroup
+	for i, rawURL := range urls {
+		wg.Add(1)
+		go func(i int, rawURL string) {
+			defer wg.Done()
+			results[i] = c.Check(ctx, rawURL)
+		}(i, rawURL)
+	}
+	wg.Wait()
+	return results
+}
+
+// Check 检查单个链接：先发送 HEAD 请求，失败或返回错误状态时再用 GET 请求确认（部分站点不支持 HEAD）
+func (c *Checker) Check(ctx context.Context, rawURL string) Result {
+	result := c.check(ctx, rawURL)
+	result.URL = rawURL
+	result.CheckedAt = time.Now()
+	return result
+}
+
+// check 执行检查
+func (c *Checker) check(ctx context.Context, rawURL string) Result {
+	var result Result
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		result.Status = StatusBroken
+		result.Err = errors.Errorf("invalid url: %s", rawURL)
+		return result
+	}
+
+	// 同一主机的请求串行执行，HEAD 与回退的 GET 请求同样遵守访问间隔
+	gate := c.gate(strings.ToLower(u.Host))
+	gate.mu.Lock()
+	defer gate.mu.Unlock()
+
+	code, err := c.request(ctx, gate, http.MethodHead, rawURL)
+	if err != nil || classify(code) != StatusOK {
+		if ctx.Err() != nil {
+			result.Status = StatusUnreachable
+			result.Err = ctx.Err()
+			return result
+		}
+		code, err = c.request(ctx, gate, http.MethodGet, rawURL)
+	}
+
+	result.StatusCode = code
+	result.Err = err
+	if err != nil {
+		result.Status = StatusUnreachable
+	} else {
+		result.Status = classify(code)
+	}
+	return result
+}
+
+// request 等待主机的访问间隔后占用并发名额发起请求，调用方需持有主机的锁
+// 先等待间隔再占用名额，避免等待中的请求占满名额
+func (c *Checker) request(ctx context.Context, gate *hostGate, method, rawURL string) (int, error) {
+	if err := c.waitHost(ctx, gate); err != nil {
+		return 0, err
+	}
+
+	select {
+	case c.sem <- struct{}{}:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	defer func() { <-c.sem }()
+	defer func() { gate.last = time.Now() }()
+
+	return c.do(ctx, method, rawURL)
+}
+
+// do 发起请求并返回状态码
+func (c *Checker) do(ctx context.Context, method, rawURL string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "*/*")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	if method == http.MethodGet {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, drainSize))
+	}
+	return resp.StatusCode, nil
+}
+
+// gate 获取主机对应的访问控制
+func (c *Checker) gate(host string) *hostGate {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	g, ok := c.hosts[host]
+	if !ok {
+		g = &hostGate{}
+		c.hosts[host] = g
+	}
+	return g
+}
+
+// waitHost 等待距上次访问该主机满足最小间隔
+func (c *Checker) waitHost(ctx context.Context, gate *hostGate) error {
+	if c.hostDelay <= 0 || gate.last.IsZero() {
+		return nil
+	}
+	wait := time.Until(gate.last.Add(c.hostDelay))
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// classify 根据状态码判断链接状态
+// 401、403 说明资源存在但需要授权，视为可用；429 与 5xx 通常是临时故障
+func classify(code int) string {
+	switch {
+	case code < 400, code == http.StatusUnauthorized, code == http.StatusForbidden:
+		return StatusOK
+	case code == http.StatusTooManyRequests, code >= 500:
+		return StatusUnreachable
+	default:
+		return StatusBroken
+	}
+}
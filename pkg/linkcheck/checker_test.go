@@ -0,0 +1,205 @@
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const testHostDelay = 30 * time.Millisecond
+
+func newTestChecker(concurrency int) *Checker {
+	return NewChecker(Options{
+		Timeout:           2 * time.Second,
+		Concurrency:       concurrency,
+		HostDelay:         testHostDelay,
+		AllowPrivateHosts: true,
+	})
+}
+
+func TestCheckClassification(t *testing.T) {
+	// /status/{code} 对 HEAD 与 GET 请求均返回指定状态码
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		code, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/status/"))
+		if err != nil {
+			code = http.StatusBadRequest
+		}
+		w.WriteHeader(code)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		code int
+		want string
+	}{
+		{http.StatusOK, StatusOK},
+		{http.StatusNoContent, StatusOK},
+		{http.StatusUnauthorized, StatusOK},
+		{http.StatusForbidden, StatusOK},
+		{http.StatusNotFound, StatusBroken},
+		{http.StatusGone, StatusBroken},
+		{http.StatusTooManyRequests, StatusUnreachable},
+		{http.StatusInternalServerError, StatusUnreachable},
+		{http.StatusBadGateway, StatusUnreachable},
+		{http.StatusServiceUnavailable, StatusUnreachable},
+	}
+
+	checker := newTestChecker(4)
+	for _, tt := range tests {
+		t.Run(strconv.Itoa(tt.code), func(t *testing.T) {
+			result := checker.Check(context.Background(), fmt.Sprintf("%s/status/%d", server.URL, tt.code))
+			if result.Status != tt.want {
+				t.Errorf("Check() status = %q, want %q", result.Status, tt.want)
+			}
+			if result.StatusCode != tt.code {
+				t.Errorf("Check() status code = %d, want %d", result.StatusCode, tt.code)
+			}
+			if result.Err != nil {
+				t.Errorf("Check() error = %v", result.Err)
+			}
+		})
+	}
+}
+
+func TestCheckInvalidURL(t *testing.T) {
+	checker := newTestChecker(1)
+	for _, rawURL := range []string{"ftp://example.com/file", "not a url", "http://"} {
+		result := checker.Check(context.Background(), rawURL)
+		if result.Status != StatusBroken || result.Err == nil {
+			t.Errorf("Check(%q) = %q, %v, want broken with error", rawURL, result.Status, result.Err)
+		}
+	}
+}
+
+func TestCheckUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	rawURL := server.URL
+	server.Close()
+
+	result := newTestChecker(1).Check(context.Background(), rawURL)
+	if result.Status != StatusUnreachable || result.Err == nil || result.StatusCode != 0 {
+		t.Errorf("Check() = %q, %d, %v, want unreachable with error", result.Status, result.StatusCode, result.Err)
+	}
+}
+
+func TestCheckHeadFallback(t *testing.T) {
+	var mu sync.Mutex
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		methods = append(methods, r.Method)
+		mu.Unlock()
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	}))
+	defer server.Close()
+
+	result := newTestChecker(1).Check(context.Background(), server.URL)
+	if result.Status != StatusOK || result.StatusCode != http.StatusOK {
+		t.Errorf("Check() = %q, %d, want ok 200", result.Status, result.StatusCode)
+	}
+	if got := strings.Join(methods, ","); got != "HEAD,GET" {
+		t.Errorf("request methods = %s, want HEAD,GET", got)
+	}
+
+	// HEAD 请求成功时不再发送 GET 请求
+	methods = nil
+	ok := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		methods = append(methods, r.Method)
+		mu.Unlock()
+	}))
+	defer ok.Close()
+	newTestChecker(1).Check(context.Background(), ok.URL)
+	if got := strings.Join(methods, ","); got != "HEAD" {
+		t.Errorf("request methods = %s, want HEAD", got)
+	}
+}
+
+func TestCheckAllConcurrency(t *testing.T) {
+	const (
+		concurrency = 2
+		hosts       = 6
+	)
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(50 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	})
+
+	// 每个测试服务器监听不同端口，视为不同主机，不受访问间隔限制
+	urls := make([]string, 0, hosts)
+	for i := 0; i < hosts; i++ {
+		server := httptest.NewServer(handler)
+		defer server.Close()
+		urls = append(urls, server.URL)
+	}
+
+	results := newTestChecker(concurrency).CheckAll(context.Background(), urls)
+	for i, result := range results {
+		if result.URL != urls[i] || result.Status != StatusOK {
+			t.Errorf("CheckAll()[%d] = %s %q, want %s ok", i, result.URL, result.Status, urls[i])
+		}
+	}
+	if maxInFlight > concurrency {
+		t.Errorf("max concurrent requests = %d, want <= %d", maxInFlight, concurrency)
+	}
+	if maxInFlight < concurrency {
+		t.Errorf("max concurrent requests = %d, want requests to different hosts to run in parallel", maxInFlight)
+	}
+}
+
+func TestCheckAllHostDelay(t *testing.T) {
+	var mu sync.Mutex
+	var times []time.Time
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	urls := []string{server.URL + "/a", server.URL + "/b", server.URL + "/c", server.URL + "/d"}
+	newTestChecker(len(urls)).CheckAll(context.Background(), urls)
+
+	if len(times) != len(urls) {
+		t.Fatalf("requests = %d, want %d", len(times), len(urls))
+	}
+	for i := 1; i < len(times); i++ {
+		// 间隔从上次请求完成时起算，服务端记录的是请求到达时间，允许少量误差
+		if gap := times[i].Sub(times[i-1]); gap < testHostDelay-5*time.Millisecond {
+			t.Errorf("gap between request %d and %d = %v, want >= %v", i-1, i, gap, testHostDelay)
+		}
+	}
+}
+
+func TestCheckCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result := newTestChecker(1).Check(ctx, server.URL)
+	if result.Status != StatusUnreachable || result.Err == nil {
+		t.Errorf("Check() = %q, %v, want unreachable with error", result.Status, result.Err)
+	}
+}
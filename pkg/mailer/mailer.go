@@ -0,0 +1,210 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TLS 模式常量
+const (
+	TLSNone     = ""         // 明文连接（如本地 MailHog 等测试服务）
+	TLSStartTLS = "starttls" // 明文连接后通过 STARTTLS 升级
+	TLSImplicit = "tls"      // 直接建立 TLS 连接（通常为 465 端口）
+)
+
+// Message 邮件
+type Message struct {
+	To      []string // 收件人地址
+	Subject string   // 主题
+	Text    string   // 纯文本正文
+	HTML    string   // HTML 正文，为空时只发送纯文本
+}
+
+// Transport 邮件发送方式
+type Transport interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// Options SMTP 发送选项
+type Options struct {
+	Host     string        // SMTP 服务器地址
+	Port     int           // SMTP 服务器端口
+	Username string        // 用户名，为空时不认证
+	Password string        // 密码
+	From     string        // 发件人地址
+	FromName string        // 发件人名称
+	TLS      string        // TLS 模式
+	Timeout  time.Duration // 单封邮件的发送超时
+}
+
+// SMTPTransport 通过 SMTP 发送邮件
+type SMTPTransport struct {
+	opts Options
+}
+
+// NewSMTPTransport 创建 SMTP 发送器
+func NewSMTPTransport(opts Options) *SMTPTransport {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	return &SMTPTransport{opts: opts}
+}
+
+// Send 发送邮件
+func (t *SMTPTransport) Send(ctx context.Context, msg *Message) error {
+	if len(msg.To) == 0 {
+		return fmt.Errorf("缺少收件人")
+	}
+	data, err := t.build(msg)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.opts.Timeout)
+	defer cancel()
+
+	addr := net.JoinHostPort(t.opts.Host, strconv.Itoa(t.opts.Port))
+	dialer := &net.Dialer{}
+	var conn net.Conn
+	if t.opts.TLS == TLSImplicit {
+		conn, err = (&tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: t.opts.Host}}).DialContext(ctx, "tcp", addr)
+	} else {
+		conn, err = dialer.DialContext(ctx, "tcp", addr)
+	}
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, t.opts.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if t.opts.TLS == TLSStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return fmt.Errorf("SMTP 服务器不支持 STARTTLS")
+		}
+		if err := client.StartTLS(&tls.Config{ServerName: t.opts.Host}); err != nil {
+			return err
+		}
+	}
+	if t.opts.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", t.opts.Username, t.opts.Password, t.opts.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(t.opts.From); err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		if err := client.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		w.Close()
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// build 生成 MIME 格式的邮件内容，同时包含 HTML 正文时使用 multipart/alternative
+func (t *SMTPTransport) build(msg *Message) ([]byte, error) {
+	var buf bytes.Buffer
+	header := func(key, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", key, value)
+	}
+
+	from := mail.Address{Name: t.opts.FromName, Address: t.opts.From}
+	header("From", from.String())
+	header("To", strings.Join(msg.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", time.Now().Format(time.RFC1123Z))
+	header("Message-ID", messageID(t.opts.From))
+	header("MIME-Version", "1.0")
+
+	if msg.HTML == "" {
+		header("Content-Type", "text/plain; charset=UTF-8")
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, msg.Text); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	}
+
+	mw := multipart.NewWriter(&buf)
+	header("Content-Type", "multipart/alternative; boundary="+mw.Boundary())
+	buf.WriteString("\r\n")
+	parts := []struct {
+		contentType string
+		body        string
+	}{
+		{"text/plain; charset=UTF-8", msg.Text},
+		{"text/html; charset=UTF-8", msg.HTML},
+	}
+	for _, part := range parts {
+		w, err := mw.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := writeQuotedPrintable(w, part.body); err != nil {
+			return nil, err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeQuotedPrintable 以 quoted-printable 编码写入正文
+func writeQuotedPrintable(w io.Writer, body string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// messageID 生成邮件的 Message-ID
+func messageID(from string) string {
+	domain := "localhost"
+	if i := strings.LastIndex(from, "@"); i >= 0 {
+		domain = from[i+1:]
+	}
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("<%d.%s@%s>", time.Now().UnixNano(), hex.EncodeToString(b), domain)
+}
@@ -0,0 +1,260 @@
+package mailer
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeSMTP 只支持发送流程所需命令的 SMTP 服务器，记录收到的邮件
+type fakeSMTP struct {
+	listener net.Listener
+	rejectTo string // 拒绝该收件人
+
+	from string
+	to   []string
+	data string
+	done chan struct{}
+}
+
+func newFakeSMTP(t *testing.T) *fakeSMTP {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	s := &fakeSMTP{listener: listener, done: make(chan struct{})}
+	t.Cleanup(func() { listener.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *fakeSMTP) options() Options {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return Options{
+		Host:     "127.0.0.1",
+		Port:     addr.Port,
+		From:     "noreply@example.com",
+		FromName: "墨迹博客",
+		Timeout:  2 * time.Second,
+	}
+}
+
+func (s *fakeSMTP) serve() {
+	defer close(s.done)
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = io.WriteString(conn, line+"\r\n") }
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			reply("250-localhost")
+			reply("250 8BITMIME")
+		case strings.HasPrefix(cmd, "MAIL FROM:"):
+			s.from = path(line)
+			reply("250 OK")
+		case strings.HasPrefix(cmd, "RCPT TO:"):
+			to := path(line)
+			if to == s.rejectTo {
+				reply("550 no such user")
+				continue
+			}
+			s.to = append(s.to, to)
+			reply("250 OK")
+		case cmd == "DATA":
+			reply("354 end with <CRLF>.<CRLF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(l, "."))
+			}
+			s.data = data.String()
+			reply("250 queued")
+		case cmd == "RSET", cmd == "NOOP":
+			reply("250 OK")
+		case cmd == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+// path 提取 MAIL FROM 与 RCPT TO 命令中尖括号内的地址，忽略其后的参数
+func path(line string) string {
+	_, rest, _ := strings.Cut(line, "<")
+	addr, _, _ := strings.Cut(rest, ">")
+	return addr
+}
+
+func (s *fakeSMTP) wait(t *testing.T) {
+	t.Helper()
+	select {
+	case <-s.done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("fake SMTP server did not finish")
+	}
+}
+
+func TestSMTPTransportSendText(t *testing.T) {
+	server := newFakeSMTP(t)
+	transport := NewSMTPTransport(server.options())
+
+	err := transport.Send(context.Background(), &Message{
+		To:      []string{"alice@example.com", "bob@example.com"},
+		Subject: "测试 subject",
+		Text:    "你好，Alice\n",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	server.wait(t)
+
+	if server.from != "noreply@example.com" {
+		t.Errorf("MAIL FROM = %q", server.from)
+	}
+	if got := strings.Join(server.to, ","); got != "alice@example.com,bob@example.com" {
+		t.Errorf("RCPT TO = %q", got)
+	}
+
+	msg, err := mail.ReadMessage(strings.NewReader(server.data))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	from, err := msg.Header.AddressList("From")
+	if err != nil || len(from) != 1 || from[0].Name != "墨迹博客" || from[0].Address != "noreply@example.com" {
+		t.Errorf("From = %v, %v", from, err)
+	}
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "测试 subject" {
+		t.Errorf("Subject = %q, %v", subject, err)
+	}
+	if msg.Header.Get("Message-ID") == "" || !strings.HasSuffix(msg.Header.Get("Message-ID"), "@example.com>") {
+		t.Errorf("Message-ID = %q", msg.Header.Get("Message-ID"))
+	}
+	if ct := msg.Header.Get("Content-Type"); ct != "text/plain; charset=UTF-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	if err != nil || string(body) != "你好，Alice\r\n" && string(body) != "你好，Alice\n" {
+		t.Errorf("body = %q, %v", body, err)
+	}
+}
+
+func TestSMTPTransportSendHTML(t *testing.T) {
+	server := newFakeSMTP(t)
+	transport := NewSMTPTransport(server.options())
+
+	err := transport.Send(context.Background(), &Message{
+		To:      []string{"alice@example.com"},
+		Subject: "digest",
+		Text:    "plain body",
+		HTML:    "<p>html body</p>",
+	})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	server.wait(t)
+
+	msg, err := mail.ReadMessage(strings.NewReader(server.data))
+	if err != nil {
+		t.Fatalf("parse message: %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q, %v", msg.Header.Get("Content-Type"), err)
+	}
+
+	want := []struct{ contentType, body string }{
+		{"text/plain; charset=UTF-8", "plain body"},
+		{"text/html; charset=UTF-8", "<p>html body</p>"},
+	}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for i, w := range want {
+		part, err := mr.NextPart()
+		if err != nil {
+			t.Fatalf("part %d: %v", i, err)
+		}
+		if ct := part.Header.Get("Content-Type"); ct != w.contentType {
+			t.Errorf("part %d Content-Type = %q, want %q", i, ct, w.contentType)
+		}
+		// multipart.Reader 会自动解码 quoted-printable 并移除该头部
+		body, err := io.ReadAll(part)
+		if err != nil || string(body) != w.body {
+			t.Errorf("part %d body = %q, %v, want %q", i, body, err, w.body)
+		}
+	}
+	if _, err := mr.NextPart(); err != io.EOF {
+		t.Errorf("unexpected extra part: %v", err)
+	}
+}
+
+func TestSMTPTransportErrors(t *testing.T) {
+	t.Run("缺少收件人", func(t *testing.T) {
+		transport := NewSMTPTransport(Options{Host: "127.0.0.1", Port: 1})
+		if err := transport.Send(context.Background(), &Message{Subject: "x", Text: "x"}); err == nil {
+			t.Error("Send() error = nil, want error")
+		}
+	})
+
+	t.Run("收件人被拒绝", func(t *testing.T) {
+		server := newFakeSMTP(t)
+		server.rejectTo = "nobody@example.com"
+		transport := NewSMTPTransport(server.options())
+		err := transport.Send(context.Background(), &Message{To: []string{"nobody@example.com"}, Subject: "x", Text: "x"})
+		if err == nil || !strings.Contains(err.Error(), "550") {
+			t.Errorf("Send() error = %v, want 550", err)
+		}
+	})
+
+	t.Run("服务器不支持 STARTTLS", func(t *testing.T) {
+		server := newFakeSMTP(t)
+		opts := server.options()
+		opts.TLS = TLSStartTLS
+		err := NewSMTPTransport(opts).Send(context.Background(), &Message{To: []string{"alice@example.com"}, Subject: "x", Text: "x"})
+		if err == nil || !strings.Contains(err.Error(), "STARTTLS") {
+			t.Errorf("Send() error = %v, want STARTTLS error", err)
+		}
+	})
+
+	t.Run("连接失败", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("listen: %v", err)
+		}
+		port := listener.Addr().(*net.TCPAddr).Port
+		listener.Close()
+
+		transport := NewSMTPTransport(Options{Host: "127.0.0.1", Port: port, From: "noreply@example.com", Timeout: time.Second})
+		if err := transport.Send(context.Background(), &Message{To: []string{"alice@example.com"}, Subject: "x", Text: "x"}); err == nil {
+			t.Errorf("Send() to closed port %d error = nil", port)
+		}
+	})
+}
@@ -0,0 +1,94 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"strings"
+	texttemplate "text/template"
+)
+
+// Renderer 按语言渲染邮件模板
+//
+// 模板按 <locale>/<name>.txt.tmpl 与 <locale>/<name>.html.tmpl 组织：
+// 纯文本模板需定义 "subject" 与 "text" 两个模板，HTML 模板可选。
+// 查找顺序为指定语言（如 en-US）、基础语言（如 en）、默认语言。
+type Renderer struct {
+	fsys          fs.FS
+	defaultLocale string
+}
+
+// NewRenderer 创建模板渲染器
+func NewRenderer(fsys fs.FS, defaultLocale string) *Renderer {
+	return &Renderer{fsys: fsys, defaultLocale: defaultLocale}
+}
+
+// Render 渲染指定模板，返回不含收件人的邮件
+func (r *Renderer) Render(name, locale string, data any) (*Message, error) {
+	dir, err := r.resolve(name, locale)
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := texttemplate.ParseFS(r.fsys, dir+"/"+name+".txt.tmpl")
+	if err != nil {
+		return nil, err
+	}
+	subject, err := execute(text.Lookup("subject"), data)
+	if err != nil {
+		return nil, err
+	}
+	body, err := execute(text.Lookup("text"), data)
+	if err != nil {
+		return nil, err
+	}
+	msg := &Message{
+		Subject: strings.Join(strings.Fields(subject), " "),
+		Text:    strings.TrimSpace(body) + "\n",
+	}
+
+	if _, err := fs.Stat(r.fsys, dir+"/"+name+".html.tmpl"); err == nil {
+		html, err := htmltemplate.ParseFS(r.fsys, dir+"/"+name+".html.tmpl")
+		if err != nil {
+			return nil, err
+		}
+		var buf bytes.Buffer
+		if err := html.Execute(&buf, data); err != nil {
+			return nil, err
+		}
+		msg.HTML = buf.String()
+	}
+	return msg, nil
+}
+
+// resolve 查找包含指定模板的语言目录
+func (r *Renderer) resolve(name, locale string) (string, error) {
+	candidates := []string{locale}
+	if i := strings.IndexAny(locale, "-_"); i > 0 {
+		candidates = append(candidates, locale[:i])
+	}
+	candidates = append(candidates, r.defaultLocale)
+
+	for _, dir := range candidates {
+		if dir == "" {
+			continue
+		}
+		if _, err := fs.Stat(r.fsys, dir+"/"+name+".txt.tmpl"); err == nil {
+			return dir, nil
+		}
+	}
+	return "", fmt.Errorf("邮件模板 %s 不存在（语言：%s）", name, locale)
+}
+
+// execute 执行纯文本模板
+func execute(tmpl *texttemplate.Template, data any) (string, error) {
+	if tmpl == nil {
+		return "", fmt.Errorf("邮件模板缺少 subject 或 text 定义")
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,109 @@
+package mailer
+
+import (
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func newTestRenderer() *Renderer {
+	fsys := fstest.MapFS{
+		"zh-CN/welcome.txt.tmpl": {Data: []byte(`{{define "subject"}}  欢迎
+			{{.Name}}  {{end}}{{define "text"}}
+你好，{{.Name}}
+{{end}}`)},
+		"en/welcome.txt.tmpl":  {Data: []byte(`{{define "subject"}}Welcome {{.Name}}{{end}}{{define "text"}}Hello, {{.Name}}{{end}}`)},
+		"en/welcome.html.tmpl": {Data: []byte(`<p>Hello, {{.Name}}</p>`)},
+		"en/broken.txt.tmpl":   {Data: []byte(`{{define "text"}}no subject{{end}}`)},
+	}
+	return NewRenderer(fsys, "zh-CN")
+}
+
+func TestRenderLocale(t *testing.T) {
+	tests := []struct {
+		name        string
+		locale      string
+		wantSubject string
+		wantText    string
+		wantHTML    bool
+	}{
+		{"指定语言", "en", "Welcome <Bob>", "Hello, <Bob>\n", true},
+		{"地区语言回退到基础语言", "en-US", "Welcome <Bob>", "Hello, <Bob>\n", true},
+		{"下划线分隔的地区语言", "en_GB", "Welcome <Bob>", "Hello, <Bob>\n", true},
+		{"未知语言使用默认语言", "fr", "欢迎 <Bob>", "你好，<Bob>\n", false},
+		{"未指定语言使用默认语言", "", "欢迎 <Bob>", "你好，<Bob>\n", false},
+	}
+
+	renderer := newTestRenderer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := renderer.Render("welcome", tt.locale, map[string]any{"Name": "<Bob>"})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if msg.Subject != tt.wantSubject {
+				t.Errorf("Render() subject = %q, want %q", msg.Subject, tt.wantSubject)
+			}
+			if msg.Text != tt.wantText {
+				t.Errorf("Render() text = %q, want %q", msg.Text, tt.wantText)
+			}
+			if (msg.HTML != "") != tt.wantHTML {
+				t.Errorf("Render() html = %q, want html %v", msg.HTML, tt.wantHTML)
+			}
+		})
+	}
+}
+
+func TestRenderHTMLEscape(t *testing.T) {
+	msg, err := newTestRenderer().Render("welcome", "en", map[string]any{"Name": "<Bob>"})
+	if err != nil {
+		t.Fatalf("Render() error = %v", err)
+	}
+	if want := "<p>Hello, &lt;Bob&gt;</p>"; msg.HTML != want {
+		t.Errorf("Render() html = %q, want %q", msg.HTML, want)
+	}
+}
+
+func TestRenderErrors(t *testing.T) {
+	renderer := newTestRenderer()
+	if _, err := renderer.Render("missing", "en", nil); err == nil {
+		t.Error("Render() error = nil, want error for missing template")
+	}
+	if _, err := renderer.Render("broken", "en", nil); err == nil {
+		t.Error("Render() error = nil, want error for template without subject")
+	}
+}
+
+// TestRenderBundledTemplates 渲染项目自带的测试邮件模板，确认各语言的模板均可正常解析
+func TestRenderBundledTemplates(t *testing.T) {
+	renderer := NewRenderer(os.DirFS("../../configs/mail"), "zh-CN")
+	data := map[string]any{
+		"AppName": "InkBlog",
+		"SentAt":  time.Date(2025, 5, 1, 8, 30, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		locale      string
+		wantSubject string
+		wantText    string
+	}{
+		{"en", "[InkBlog] Test email", "sent at 2025-05-01 08:30:00"},
+		{"zh-CN", "[InkBlog] 测试邮件", "发送于 2025-05-01 08:30:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.locale, func(t *testing.T) {
+			msg, err := renderer.Render("test", tt.locale, data)
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if msg.Subject != tt.wantSubject {
+				t.Errorf("Render() subject = %q, want %q", msg.Subject, tt.wantSubject)
+			}
+			if !strings.Contains(msg.Text, tt.wantText) {
+				t.Errorf("Render() text = %q, want it to contain %q", msg.Text, tt.wantText)
+			}
+		})
+	}
+}
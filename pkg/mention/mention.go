@@ -0,0 +1,99 @@
+package mention
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxUsernameLen 用户名的最大字符数，超出的部分不会作为用户名的一部分
+const MaxUsernameLen = 20
+
+// isNameRune 判断字符能否出现在用户名中，汉字等文字同样视为字母
+func isNameRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+}
+
+// isBoundary 判断 '@' 前的字符是否允许提及，ASCII 字母数字等紧邻 '@' 时视为邮箱地址
+// 汉字与 '@' 相邻时仍视为提及，如 "你好@alice"
+func isBoundary(r rune) bool {
+	if r >= utf8.RuneSelf {
+		return true
+	}
+	return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '.' || r == '-' || r == '@')
+}
+
+// Scan 查找正文中所有的候选提及，返回每个 '@' 之后连续的用户名字符（最多 MaxUsernameLen 个），忽略 Markdown 代码块与行内代码
+// 由于用户名可能包含汉字，"@张三你好" 中用户名的边界无法在此确定，需要结合用户表通过 Resolve 判断
+func Scan(text string) []string {
+	var runs []string
+	prev := ' '
+	for i := 0; i < len(text); {
+		// 跳过代码块与行内代码
+		if text[i] == '`' {
+			fence := "`"
+			if strings.HasPrefix(text[i:], "```") {
+				fence = "```"
+			}
+			end := strings.Index(text[i+len(fence):], fence)
+			if end < 0 {
+				// 未闭合的反引号（如 "it`s"）按普通字符处理，继续扫描其后的内容
+				i++
+				prev = '`'
+				continue
+			}
+			i += len(fence) + end + len(fence)
+			prev = '`'
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if (r == '@' || r == '＠') && isBoundary(prev) {
+			j := i + size
+			n := 0
+			for j < len(text) && n < MaxUsernameLen {
+				c, s := utf8.DecodeRuneInString(text[j:])
+				if !isNameRune(c) {
+					break
+				}
+				j += s
+				n++
+			}
+			if n > 0 {
+				runs = append(runs, text[i+size:j])
+			}
+		}
+		prev = r
+		i += size
+	}
+	return runs
+}
+
+// Prefixes 返回候选提及所有可能的用户名，由长到短排列
+func Prefixes(run string) []string {
+	prefixes := make([]string, 0, utf8.RuneCountInString(run))
+	for end := len(run); end > 0; {
+		prefixes = append(prefixes, run[:end])
+		_, size := utf8.DecodeLastRuneInString(run[:end])
+		end -= size
+	}
+	return prefixes
+}
+
+// Resolve 以最长匹配确定每处提及的用户名并去重，lookup 返回用户名在用户表中的写法
+func Resolve(runs []string, lookup func(name string) (string, bool)) []string {
+	var usernames []string
+	seen := make(map[string]bool)
+	for _, run := range runs {
+		for _, prefix := range Prefixes(run) {
+			if username, ok := lookup(prefix); ok {
+				if !seen[username] {
+					seen[username] = true
+					usernames = append(usernames, username)
+				}
+				break
+			}
+		}
+	}
+	return usernames
+}
@@ -0,0 +1,73 @@
+package mention
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"普通提及", "hi @alice and @bob_1", []string{"alice", "bob_1"}},
+		{"行首提及", "@alice", []string{"alice"}},
+		{"全角 @", "感谢＠alice", []string{"alice"}},
+		{"未闭合的反引号", "it`s @alice", []string{"alice"}},
+		{"未闭合的代码块", "```go\n@alice", []string{"alice"}},
+		{"汉字紧邻 @", "你好@alice", []string{"alice"}},
+		{"用户名后紧跟汉字", "@张三你好", []string{"张三你好"}},
+		{"邮箱地址", "mail me at alice@example.com", nil},
+		{"邮箱地址中的点与连字符", "a.b@example.com x-y@example.com", nil},
+		{"连续的 @", "@@alice", nil},
+		{"行内代码", "use `@alice` or @bob", []string{"bob"}},
+		{"代码块", "```\n@alice\n```\n@bob", []string{"bob"}},
+		{"标点之后", "(@alice), @bob!", []string{"alice", "bob"}},
+		{"只有 @", "@ alone", nil},
+		{"超长用户名", "@" + strings.Repeat("a", MaxUsernameLen+5), []string{strings.Repeat("a", MaxUsernameLen)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Scan(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Scan(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrefixes(t *testing.T) {
+	want := []string{"张三你好", "张三你", "张三", "张"}
+	if got := Prefixes("张三你好"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Prefixes() = %q, want %q", got, want)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	users := map[string]string{"张三": "张三", "alice": "Alice", "alice_bob": "alice_bob"}
+	lookup := func(name string) (string, bool) {
+		username, ok := users[strings.ToLower(name)]
+		return username, ok
+	}
+
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{"最长匹配", "@张三你好", []string{"张三"}},
+		{"优先匹配更长的用户名", "@alice_bob", []string{"alice_bob"}},
+		{"使用用户表中的写法并去重", "@ALICE @alice", []string{"Alice"}},
+		{"不存在的用户", "@nobody", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Resolve(Scan(tt.text), lookup); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Resolve(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package middleware
 
 import (
+	"bytes"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/codeExpert666/goinkblog-backend/pkg/util"
 	"github.com/gin-gonic/gin"
@@ -12,6 +15,12 @@ type StaticConfig struct {
 	SkippedPathPrefixes []string
 	// 静态文件根目录
 	Root string
+	// 需要注入页面元数据的爬虫 User-Agent 关键字（不区分大小写）
+	CrawlerUserAgents []string
+	// 为爬虫请求生成注入 index.html <head> 的 HTML 片段，返回空字符串表示不注入
+	RenderHead func(c *gin.Context) string
+	// 返回前端页面（index.html）时附加的响应头，如 Webmention 端点发现所需的 Link 头
+	IndexHeaders map[string]string
 }
 
 func StaticWithConfig(config StaticConfig) gin.HandlerFunc {
@@ -28,10 +37,28 @@ func StaticWithConfig(config StaticConfig) gin.HandlerFunc {
 
 		// filepath.FromSlash 用于将URL中的斜杠转换为系统对应的路径分隔符
 		fpath := filepath.Join(config.Root, filepath.FromSlash(p))
+		indexPath := filepath.Join(config.Root, "index.html")
 
 		fileInfo, err := os.Stat(fpath)
 		if (err != nil && os.IsNotExist(err)) || fileInfo.IsDir() { // 文件不存在或为目录
-			fpath = filepath.Join(config.Root, "index.html")
+			fpath = indexPath
+		}
+
+		if fpath == indexPath {
+			for k, v := range config.IndexHeaders {
+				c.Header(k, v)
+			}
+		}
+
+		// 爬虫请求前端页面时注入元数据（如 Open Graph 标签），便于分享时生成预览
+		if fpath == indexPath && config.RenderHead != nil && isCrawler(c, config.CrawlerUserAgents) {
+			if head := config.RenderHead(c); head != "" {
+				if page, err := os.ReadFile(fpath); err == nil {
+					c.Data(http.StatusOK, "text/html; charset=utf-8", injectHead(page, head))
+					c.Abort()
+					return
+				}
+			}
 		}
 
 		if !util.IsImageFile(fpath) && !util.IsFrontendFile(fpath) { // 非图片文件、非前端文件支持下载
@@ -46,3 +73,31 @@ func StaticWithConfig(config StaticConfig) gin.HandlerFunc {
 		c.Abort()
 	}
 }
+
+// isCrawler 根据 User-Agent 判断请求是否来自爬虫
+func isCrawler(c *gin.Context, agents []string) bool {
+	ua := strings.ToLower(c.GetHeader("User-Agent"))
+	if ua == "" {
+		return false
+	}
+	for _, agent := range agents {
+		if agent != "" && strings.Contains(ua, strings.ToLower(agent)) {
+			return true
+		}
+	}
+	return false
+}
+
+// injectHead 将 HTML 片段插入到页面的 </head> 之前，页面没有 </head> 时插入到开头
+func injectHead(page []byte, head string) []byte {
+	idx := bytes.Index(bytes.ToLower(page), []byte("</head>"))
+	if idx < 0 {
+		return append([]byte(head), page...)
+	}
+
+	buf := make([]byte, 0, len(page)+len(head))
+	buf = append(buf, page[:idx]...)
+	buf = append(buf, head...)
+	buf = append(buf, page[idx:]...)
+	return buf
+}
@@ -0,0 +1,98 @@
+// Package outbox 提供数据库任务队列的公共部分：定期或被唤醒时执行的后台任务、带租约的任务领取与失败重试的指数退避。
+package outbox
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+)
+
+// maxBackoff 指数退避的最大等待时间
+const maxBackoff = 24 * time.Hour
+
+// Worker 后台任务，定期或被唤醒时执行处理函数，零值可直接使用
+type Worker struct {
+	ticker *time.Ticker
+	kick   chan struct{}
+	done   chan struct{}
+	once   sync.Once
+}
+
+// Start 启动后台任务，每隔 interval 或被 Kick 唤醒时调用 fn，tick 表示本次是否由定时器触发
+func (w *Worker) Start(ctx context.Context, interval time.Duration, fn func(ctx context.Context, tick bool)) {
+	w.kick = make(chan struct{}, 1)
+	w.done = make(chan struct{})
+	w.ticker = time.NewTicker(interval)
+	go func() {
+		for {
+			select {
+			case <-w.done:
+				return
+			case <-w.ticker.C:
+				fn(ctx, true)
+			case <-w.kick:
+				fn(ctx, false)
+			}
+		}
+	}()
+}
+
+// Kick 唤醒后台任务，已有待处理的唤醒或后台任务未启动时忽略
+func (w *Worker) Kick() {
+	select {
+	case w.kick <- struct{}{}:
+	default:
+	}
+}
+
+// Stop 停止后台任务，可重复调用
+func (w *Worker) Stop() {
+	if w.ticker != nil {
+		w.ticker.Stop()
+	}
+	if w.done != nil {
+		w.once.Do(func() { close(w.done) })
+	}
+}
+
+// Backoff 第 attempts 次失败后的重试等待时间，从 base 开始每次翻倍，最长不超过一天
+func Backoff(base time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	return delay
+}
+
+// Lease 单个任务领取后的保护时间，取单次请求超时时间的 3 倍，超过该时间未完成的任务可被重新领取
+func Lease(timeout time.Duration) time.Duration {
+	return 3 * timeout
+}
+
+// Claim 领取已查询出的到期任务：逐个以查询时 column 的值为条件将其推后到 until，
+// 只返回推后成功（即未被其他实例抢先领取）的任务，领取后任务在 until 之前不会被重复领取
+// db 每次调用需返回新的查询，due 返回任务的ID与查询时 column 的值（为空表示 NULL）
+func Claim[T any](db func() *gorm.DB, items []T, column string, until time.Time, due func(item *T) (uint, *time.Time)) ([]T, error) {
+	claimed := items[:0]
+	for i := range items {
+		id, at := due(&items[i])
+		query := db().Where("id = ?", id)
+		if at == nil {
+			query = query.Where(column + " IS NULL")
+		} else {
+			query = query.Where(column+" = ?", *at)
+		}
+		result := query.UpdateColumn(column, until)
+		if result.Error != nil {
+			return nil, errors.WithStack(result.Error)
+		}
+		if result.RowsAffected == 1 {
+			claimed = append(claimed, items[i])
+		}
+	}
+	return claimed, nil
+}
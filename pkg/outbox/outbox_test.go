@@ -0,0 +1,52 @@
+package outbox
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	base := time.Minute
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{6, 32 * time.Minute},
+		// 超过一天后不再翻倍
+		{12, 2048 * time.Minute},
+		{50, 2048 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := Backoff(base, tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%v, %d) = %v, want %v", base, tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestWorker(t *testing.T) {
+	var w Worker
+	// 未启动时唤醒为空操作
+	w.Kick()
+
+	calls := make(chan bool, 10)
+	w.Start(context.Background(), time.Hour, func(ctx context.Context, tick bool) {
+		calls <- tick
+	})
+	w.Kick()
+	select {
+	case tick := <-calls:
+		if tick {
+			t.Error("Kick() triggered a tick run")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Kick() did not wake up the worker")
+	}
+
+	w.Stop()
+	w.Stop()
+}
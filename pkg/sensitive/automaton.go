@@ -0,0 +1,90 @@
+package sensitive
+
+// automaton Aho-Corasick 多模式匹配自动机
+type automaton struct {
+	nodes []acNode
+}
+
+// acNode 自动机节点
+type acNode struct {
+	next   map[rune]int // 转移边
+	fail   int          // 失配指针
+	output int          // 沿失配链最近的输出节点（-1 表示无）
+	words  []int        // 以该节点结尾的模式下标
+	depth  int          // 节点深度，即模式长度
+}
+
+func newAutomaton() *automaton {
+	return &automaton{nodes: []acNode{{next: make(map[rune]int), output: -1}}}
+}
+
+// insert 插入模式
+func (a *automaton) insert(pattern []rune, idx int) {
+	cur := 0
+	for _, r := range pattern {
+		nxt, ok := a.nodes[cur].next[r]
+		if !ok {
+			nxt = len(a.nodes)
+			a.nodes = append(a.nodes, acNode{next: make(map[rune]int), output: -1, depth: a.nodes[cur].depth + 1})
+			a.nodes[cur].next[r] = nxt
+		}
+		cur = nxt
+	}
+	a.nodes[cur].words = append(a.nodes[cur].words, idx)
+}
+
+// build 广度优先构建失配指针
+func (a *automaton) build() {
+	queue := make([]int, 0, len(a.nodes))
+	for _, child := range a.nodes[0].next {
+		queue = append(queue, child)
+	}
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for r, child := range a.nodes[cur].next {
+			fail := a.nodes[cur].fail
+			for fail > 0 {
+				if _, ok := a.nodes[fail].next[r]; ok {
+					break
+				}
+				fail = a.nodes[fail].fail
+			}
+			if nxt, ok := a.nodes[fail].next[r]; ok && nxt != child {
+				a.nodes[child].fail = nxt
+			}
+
+			// 失配链上最近的输出节点
+			f := a.nodes[child].fail
+			if len(a.nodes[f].words) > 0 {
+				a.nodes[child].output = f
+			} else {
+				a.nodes[child].output = a.nodes[f].output
+			}
+			queue = append(queue, child)
+		}
+	}
+}
+
+// search 在文本中查找所有模式，回调参数为模式下标、起始位置与结束位置（不含）
+func (a *automaton) search(text []rune, fn func(idx, start, end int)) {
+	cur := 0
+	for i, r := range text {
+		for cur > 0 {
+			if _, ok := a.nodes[cur].next[r]; ok {
+				break
+			}
+			cur = a.nodes[cur].fail
+		}
+		if nxt, ok := a.nodes[cur].next[r]; ok {
+			cur = nxt
+		}
+
+		for n := cur; n > 0; n = a.nodes[n].output {
+			for _, idx := range a.nodes[n].words {
+				fn(idx, i+1-a.nodes[n].depth, i+1)
+			}
+		}
+	}
+}
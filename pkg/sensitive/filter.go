@@ -0,0 +1,252 @@
+// Package sensitive 提供基于 Aho-Corasick 自动机的敏感词过滤，
+// 支持全角/半角、大小写、插入符号以及拼音替换等常见规避手段。
+package sensitive
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mozillazg/go-pinyin"
+)
+
+// Action 命中敏感词后的处理方式
+type Action string
+
+const (
+	ActionMask   Action = "mask"   // 替换为掩码后放行
+	ActionReview Action = "review" // 转人工审核
+	ActionBlock  Action = "block"  // 直接拒绝
+)
+
+// severity 处理方式的严重程度，多个命中时取最严重的处理方式
+func (a Action) severity() int {
+	switch a {
+	case ActionMask:
+		return 1
+	case ActionReview:
+		return 2
+	case ActionBlock:
+		return 3
+	}
+	return 0
+}
+
+// MaskRune 掩码字符
+const MaskRune = '*'
+
+// minPinyinWordLen 参与拼音匹配的敏感词最少汉字数，避免单字拼音造成大量误判
+const minPinyinWordLen = 2
+
+// Word 敏感词
+type Word struct {
+	Text     string // 敏感词
+	Category string // 分类
+	Action   Action // 处理方式
+}
+
+// Hit 一次命中
+type Hit struct {
+	Word     string `json:"word"`     // 命中的敏感词
+	Category string `json:"category"` // 敏感词分类
+	Action   Action `json:"action"`   // 处理方式
+	Start    int    `json:"start"`    // 在原文中的起始位置（按字符计）
+	End      int    `json:"end"`      // 在原文中的结束位置（不含，按字符计）
+}
+
+// Result 检测结果
+type Result struct {
+	Action Action // 需要采取的处理方式，未命中时为空
+	Hits   []Hit  // 所有命中
+	Masked string // 将所有命中替换为掩码后的文本
+}
+
+// Words 命中的敏感词（去重）
+func (r *Result) Words() []string {
+	seen := make(map[string]struct{}, len(r.Hits))
+	words := make([]string, 0, len(r.Hits))
+	for _, hit := range r.Hits {
+		if _, ok := seen[hit.Word]; ok {
+			continue
+		}
+		seen[hit.Word] = struct{}{}
+		words = append(words, hit.Word)
+	}
+	return words
+}
+
+// Filter 敏感词过滤器，构建完成后只读，可并发使用
+type Filter struct {
+	words  []Word
+	text   *automaton // 归一化文本自动机
+	pinyin *automaton // 拼音自动机
+}
+
+// New 根据敏感词列表构建过滤器
+func New(words []Word) *Filter {
+	f := &Filter{
+		words:  make([]Word, 0, len(words)),
+		text:   newAutomaton(),
+		pinyin: newAutomaton(),
+	}
+
+	for _, word := range words {
+		key, _ := normalize(word.Text)
+		if len(key) == 0 {
+			continue
+		}
+
+		idx := len(f.words)
+		f.words = append(f.words, word)
+		f.text.insert(key, idx)
+
+		if countHan(key) >= minPinyinWordLen {
+			py, _, _ := toPinyin(key)
+			f.pinyin.insert(py, idx)
+		}
+	}
+
+	f.text.build()
+	f.pinyin.build()
+	return f
+}
+
+// Len 敏感词数量
+func (f *Filter) Len() int {
+	return len(f.words)
+}
+
+// Find 查找文本中的所有敏感词
+func (f *Filter) Find(text string) []Hit {
+	if len(f.words) == 0 || text == "" {
+		return nil
+	}
+
+	type span struct{ idx, start, end int }
+	seen := make(map[span]struct{})
+	var hits []Hit
+	add := func(idx, start, end int) {
+		key := span{idx, start, end}
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		word := f.words[idx]
+		hits = append(hits, Hit{Word: word.Text, Category: word.Category, Action: word.Action, Start: start, End: end})
+	}
+
+	// 归一化文本匹配
+	norm, pos := normalize(text)
+	f.text.search(norm, func(idx, start, end int) {
+		add(idx, pos[start], pos[end-1]+1)
+	})
+
+	// 拼音匹配：只接受起止均落在音节边界上的命中
+	py, pyPos, boundary := toPinyin(norm)
+	f.pinyin.search(py, func(idx, start, end int) {
+		if !boundary[start] || (end < len(py) && !boundary[end]) {
+			return
+		}
+		add(idx, pos[pyPos[start]], pos[pyPos[end-1]]+1)
+	})
+
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Start != hits[j].Start {
+			return hits[i].Start < hits[j].Start
+		}
+		return hits[i].End > hits[j].End
+	})
+	return hits
+}
+
+// Check 检测文本，返回处理方式与掩码后的文本
+func (f *Filter) Check(text string) *Result {
+	result := &Result{Masked: text}
+	result.Hits = f.Find(text)
+	if len(result.Hits) == 0 {
+		return result
+	}
+
+	runes := []rune(text)
+	for _, hit := range result.Hits {
+		if hit.Action.severity() > result.Action.severity() {
+			result.Action = hit.Action
+		}
+		for i := hit.Start; i < hit.End && i < len(runes); i++ {
+			if !isNoise(runes[i]) {
+				runes[i] = MaskRune
+			}
+		}
+	}
+	result.Masked = string(runes)
+	return result
+}
+
+// normalize 归一化文本：全角转半角、转小写并去除空白、标点、符号等干扰字符
+// 返回归一化后的字符序列以及每个字符在原文中的位置
+func normalize(text string) ([]rune, []int) {
+	norm := make([]rune, 0, len(text))
+	pos := make([]int, 0, len(text))
+
+	i := 0
+	for _, r := range text {
+		r = toHalfWidth(r)
+		if !isNoise(r) {
+			norm = append(norm, unicode.ToLower(r))
+			pos = append(pos, i)
+		}
+		i++
+	}
+	return norm, pos
+}
+
+// toHalfWidth 全角字符转半角
+func toHalfWidth(r rune) rune {
+	switch {
+	case r == '　':
+		return ' '
+	case r >= '！' && r <= '～':
+		return r - 0xFEE0
+	}
+	return r
+}
+
+// isNoise 判断是否为用于规避检测的干扰字符
+func isNoise(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.Is(unicode.Cf, r)
+}
+
+// countHan 统计汉字数量
+func countHan(runes []rune) int {
+	n := 0
+	for _, r := range runes {
+		if unicode.Is(unicode.Han, r) {
+			n++
+		}
+	}
+	return n
+}
+
+// toPinyin 将归一化文本中的汉字转换为拼音，其余字符保持不变
+// 返回拼音字符序列、每个拼音字符对应的归一化文本位置，以及每个位置是否为音节起点
+func toPinyin(norm []rune) ([]rune, []int, []bool) {
+	args := pinyin.NewArgs()
+	py := make([]rune, 0, len(norm)*3)
+	pos := make([]int, 0, len(norm)*3)
+	boundary := make([]bool, 0, len(norm)*3)
+
+	for i, r := range norm {
+		syllable := []rune{r}
+		if unicode.Is(unicode.Han, r) {
+			if p := pinyin.SinglePinyin(r, args); len(p) > 0 && p[0] != "" {
+				syllable = []rune(strings.ToLower(p[0]))
+			}
+		}
+		for j, c := range syllable {
+			py = append(py, c)
+			pos = append(pos, i)
+			boundary = append(boundary, j == 0)
+		}
+	}
+	return py, pos, boundary
+}
@@ -0,0 +1,89 @@
+// Package simhash 提供基于 SimHash 的文本指纹，用于近似重复内容检测。
+package simhash
+
+import (
+	"hash/fnv"
+	"math/bits"
+	"strings"
+	"unicode"
+)
+
+// ShingleSize 特征分片包含的词元数量
+const ShingleSize = 3
+
+// Fingerprint 计算文本的 64 位 SimHash 指纹，文本没有有效内容时返回 0
+func Fingerprint(text string) uint64 {
+	tokens := tokenize(text)
+	if len(tokens) == 0 {
+		return 0
+	}
+
+	// 统计特征分片及其权重（出现次数）
+	features := make(map[string]int)
+	if len(tokens) < ShingleSize {
+		features[strings.Join(tokens, " ")]++
+	} else {
+		for i := 0; i+ShingleSize <= len(tokens); i++ {
+			features[strings.Join(tokens[i:i+ShingleSize], " ")]++
+		}
+	}
+
+	var vector [64]int
+	h := fnv.New64a()
+	for feature, weight := range features {
+		h.Reset()
+		_, _ = h.Write([]byte(feature))
+		sum := h.Sum64()
+		for i := 0; i < 64; i++ {
+			if sum&(1<<uint(i)) != 0 {
+				vector[i] += weight
+			} else {
+				vector[i] -= weight
+			}
+		}
+	}
+
+	var fingerprint uint64
+	for i := 0; i < 64; i++ {
+		if vector[i] > 0 {
+			fingerprint |= 1 << uint(i)
+		}
+	}
+	return fingerprint
+}
+
+// Distance 两个指纹之间的汉明距离
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// Similarity 两个指纹的相似度，取值范围为 [0, 1]
+func Similarity(a, b uint64) float64 {
+	return 1 - float64(Distance(a, b))/64
+}
+
+// tokenize 将文本切分为词元：每个汉字单独作为一个词元，连续的字母与数字作为一个词元，其余字符作为分隔符
+func tokenize(text string) []string {
+	var tokens []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, unicode.ToLower(r))
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
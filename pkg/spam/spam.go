@@ -0,0 +1,143 @@
+// Package spam 提供垃圾内容识别用的文本特征提取与朴素贝叶斯分类器。
+package spam
+
+import (
+	"math"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// MaxTokens 单条文本最多提取的特征数
+const MaxTokens = 300
+
+// linkPattern 匹配文本中的链接
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>()\[\]"']+`)
+
+// Links 提取文本中的链接，去掉末尾的标点
+func Links(text string) []string {
+	links := linkPattern.FindAllString(text, -1)
+	for i, link := range links {
+		links[i] = strings.TrimRight(link, ".,;:!?")
+	}
+	return links
+}
+
+// Tokenize 提取文本特征：英文与数字按词切分，中日韩文字按相邻两字切分，链接额外记录域名
+// 返回去重后的特征，最多 MaxTokens 个
+func Tokenize(text string) []string {
+	seen := make(map[string]bool)
+	tokens := make([]string, 0, 64)
+	add := func(token string) {
+		if len(tokens) < MaxTokens && !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+
+	for _, link := range Links(text) {
+		raw := link
+		if !strings.Contains(raw, "://") {
+			raw = "http://" + raw
+		}
+		if u, err := url.Parse(raw); err == nil && u.Hostname() != "" {
+			add("host:" + strings.TrimPrefix(strings.ToLower(u.Hostname()), "www."))
+		}
+	}
+
+	var word []rune
+	var cjk []rune
+	flushWord := func() {
+		if n := len(word); n >= 2 && n <= 30 {
+			add(string(word))
+		}
+		word = word[:0]
+	}
+	flushCJK := func() {
+		if len(cjk) == 1 {
+			add(string(cjk))
+		}
+		for i := 0; i+1 < len(cjk); i++ {
+			add(string(cjk[i : i+2]))
+		}
+		cjk = cjk[:0]
+	}
+
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+// isCJK 判断是否为中日韩文字
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// TokenCount 特征在垃圾与正常样本中出现的次数
+type TokenCount struct {
+	Spam int64
+	Ham  int64
+}
+
+// Evidence 对分类结果影响较大的特征
+type Evidence struct {
+	Token  string  `json:"token"`
+	Weight float64 `json:"weight"` // 对数似然比，正数倾向垃圾，负数倾向正常
+}
+
+// Classifier 朴素贝叶斯分类器，样本统计由调用方存储
+type Classifier struct {
+	SpamDocs    int64 // 垃圾样本数
+	HamDocs     int64 // 正常样本数
+	MaxEvidence int   // 参与计算的最大特征数，只取影响最大的特征，避免长文本被大量中性特征稀释
+}
+
+// Ready 两类样本均达到 minDocs 时分类结果才可信
+func (c *Classifier) Ready(minDocs int64) bool {
+	return c.SpamDocs >= minDocs && c.HamDocs >= minDocs
+}
+
+// Classify 计算文本为垃圾内容的概率，同时返回影响最大的特征（按影响程度排序）
+// counts 为各特征的样本统计，未出现的特征视为两类均未出现
+func (c *Classifier) Classify(tokens []string, counts map[string]TokenCount) (float64, []Evidence) {
+	// 先验概率，加一平滑
+	logOdds := math.Log(float64(c.SpamDocs+1) / float64(c.HamDocs+1))
+
+	evidence := make([]Evidence, 0, len(tokens))
+	for _, token := range tokens {
+		count, ok := counts[token]
+		if !ok || count.Spam+count.Ham == 0 {
+			continue
+		}
+		pSpam := float64(count.Spam+1) / float64(c.SpamDocs+2)
+		pHam := float64(count.Ham+1) / float64(c.HamDocs+2)
+		evidence = append(evidence, Evidence{Token: token, Weight: math.Log(pSpam / pHam)})
+	}
+
+	sort.SliceStable(evidence, func(i, j int) bool {
+		return math.Abs(evidence[i].Weight) > math.Abs(evidence[j].Weight)
+	})
+	if c.MaxEvidence > 0 && len(evidence) > c.MaxEvidence {
+		evidence = evidence[:c.MaxEvidence]
+	}
+	for _, e := range evidence {
+		logOdds += e.Weight
+	}
+	return 1 / (1 + math.Exp(-logOdds)), evidence
+}
@@ -138,3 +138,16 @@ func GetBodyData(c *gin.Context) []byte {
 	}
 	return nil
 }
+
+// GetSiteURL 获取站点对外访问地址，优先使用配置的地址，未配置时根据请求推断
+func GetSiteURL(c *gin.Context, configured string) string {
+	if configured != "" {
+		return strings.TrimRight(configured, "/")
+	}
+
+	scheme := "http"
+	if c.Request.TLS != nil || strings.EqualFold(c.GetHeader("X-Forwarded-Proto"), "https") {
+		scheme = "https"
+	}
+	return scheme + "://" + c.Request.Host
+}
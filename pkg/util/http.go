@@ -0,0 +1,53 @@
+package util
+
+import (
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+)
+
+// ErrPrivateAddress 请求的目标为内网地址
+var ErrPrivateAddress = errors.New("private address is not allowed")
+
+// NewExternalHTTPClient 创建访问外部站点的 HTTP 客户端
+// allowPrivate 为 false 时拒绝连接内网、回环等地址，防止服务端请求伪造（SSRF），重定向最多跟随 5 次
+func NewExternalHTTPClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		// 在建立连接时检查解析后的地址，防止通过 DNS 指向内网地址
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || isPrivateIP(ip) {
+				return ErrPrivateAddress
+			}
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= 5 {
+				return errors.New("too many redirects")
+			}
+			return nil
+		},
+	}
+}
+
+// isPrivateIP 判断是否为内网、回环或链路本地地址
+func isPrivateIP(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified()
+}
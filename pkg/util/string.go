@@ -0,0 +1,9 @@
+package util
+
+// Truncate 截取字符串的前 n 个字符，用于避免超出字段长度
+func Truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,326 @@
+// Package webmention 实现 W3C Webmention 协议的发送端与接收端验证逻辑。
+package webmention
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+
+	"github.com/codeExpert666/goinkblog-backend/pkg/errors"
+	"github.com/codeExpert666/goinkblog-backend/pkg/util"
+)
+
+var (
+	// ErrSourceGone 来源页面已删除（410 Gone），应删除对应的引用
+	ErrSourceGone = errors.New("webmention: source is gone")
+	// ErrLinkNotFound 来源页面中不包含指向目标的链接
+	ErrLinkNotFound = errors.New("webmention: source does not link to target")
+	// ErrPrivateAddress 目标地址为内网地址
+	ErrPrivateAddress = util.ErrPrivateAddress
+)
+
+// Options 客户端选项
+type Options struct {
+	Timeout           time.Duration // 单次请求超时时间
+	MaxBodySize       int64         // 读取响应体的最大字节数
+	UserAgent         string        // 请求使用的 User-Agent
+	AllowPrivateHosts bool          // 是否允许访问内网地址（仅用于本地测试）
+}
+
+// Client Webmention 客户端，负责端点发现、发送通知与来源验证
+type Client struct {
+	httpClient  *http.Client
+	maxBodySize int64
+	userAgent   string
+}
+
+// NewClient 创建 Webmention 客户端
+func NewClient(opts Options) *Client {
+	if opts.Timeout <= 0 {
+		opts.Timeout = 10 * time.Second
+	}
+	if opts.MaxBodySize <= 0 {
+		opts.MaxBodySize = 1 << 20
+	}
+	if opts.UserAgent == "" {
+		opts.UserAgent = "goinkblog-webmention"
+	}
+
+	return &Client{
+		httpClient:  util.NewExternalHTTPClient(opts.Timeout, opts.AllowPrivateHosts),
+		maxBodySize: opts.MaxBodySize,
+		userAgent:   opts.UserAgent,
+	}
+}
+
+// get 发起 GET 请求并读取响应体（最多 maxBodySize 字节）
+func (c *Client) get(ctx context.Context, rawURL string) (*http.Response, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "text/html, application/xhtml+xml;q=0.9, */*;q=0.1")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxBodySize))
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	return resp, body, nil
+}
+
+// Discover 发现目标页面的 Webmention 端点，目标不支持 Webmention 时返回空字符串
+func (c *Client) Discover(ctx context.Context, target string) (string, error) {
+	resp, body, err := c.get(ctx, target)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", errors.Errorf("webmention: fetch target failed with status %d", resp.StatusCode)
+	}
+
+	// 相对地址以最终（重定向后）的页面地址为基准解析
+	base := resp.Request.URL
+
+	// 优先使用 HTTP Link 响应头
+	for _, header := range resp.Header.Values("Link") {
+		if endpoint, ok := parseLinkHeader(header); ok {
+			return resolve(base, endpoint)
+		}
+	}
+
+	// 其次使用页面中第一个 rel 包含 webmention 的 <link> 或 <a> 元素
+	if isHTML(resp.Header.Get("Content-Type")) {
+		if endpoint, ok := findEndpointInHTML(body); ok {
+			return resolve(base, endpoint)
+		}
+	}
+	return "", nil
+}
+
+// Send 向端点发送 Webmention 通知
+func (c *Client) Send(ctx context.Context, endpoint, source, target string) error {
+	form := url.Values{"source": {source}, "target": {target}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, c.maxBodySize))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webmention: endpoint responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Source 来源页面信息
+type Source struct {
+	URL     string // 来源地址
+	Title   string // 页面标题
+	Excerpt string // 页面摘要
+	Author  string // 作者
+}
+
+// Verify 验证来源页面包含指向目标的链接，并提取来源页面信息
+func (c *Client) Verify(ctx context.Context, source, target string) (*Source, error) {
+	resp, body, err := c.get(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusGone {
+		return nil, ErrSourceGone
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, errors.Errorf("webmention: fetch source failed with status %d", resp.StatusCode)
+	}
+
+	contentType := resp.Header.Get("Content-Type")
+	if !isHTML(contentType) {
+		// 非 HTML 内容只要求包含目标地址
+		if !bytes.Contains(body, []byte(target)) {
+			return nil, ErrLinkNotFound
+		}
+		return &Source{URL: source}, nil
+	}
+
+	info, ok := parseSourceHTML(body, resp.Request.URL, target)
+	if !ok {
+		return nil, ErrLinkNotFound
+	}
+	info.URL = source
+	return info, nil
+}
+
+// parseLinkHeader 解析 HTTP Link 响应头，返回 rel 包含 webmention 的地址
+func parseLinkHeader(header string) (string, bool) {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		if len(parts) < 2 {
+			continue
+		}
+		target := strings.TrimSpace(parts[0])
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+		for _, param := range parts[1:] {
+			key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+			if !ok || !strings.EqualFold(strings.TrimSpace(key), "rel") {
+				continue
+			}
+			if hasRel(strings.Trim(strings.TrimSpace(value), `"`), "webmention") {
+				return target[1 : len(target)-1], true
+			}
+		}
+	}
+	return "", false
+}
+
+// findEndpointInHTML 查找页面中第一个 rel 包含 webmention 的 <link> 或 <a> 元素
+func findEndpointInHTML(body []byte) (string, bool) {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return "", false
+	}
+
+	var endpoint string
+	var found bool
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if found {
+			return
+		}
+		if n.Type == html.ElementNode && (n.Data == "link" || n.Data == "a") {
+			if href, ok := attr(n, "href"); ok && hasRel(attrValue(n, "rel"), "webmention") {
+				endpoint, found = href, true
+				return
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(doc)
+	return endpoint, found
+}
+
+// parseSourceHTML 解析来源页面，检查是否包含指向目标的链接并提取标题、摘要与作者
+func parseSourceHTML(body []byte, base *url.URL, target string) (*Source, bool) {
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		return nil, false
+	}
+
+	info := &Source{}
+	linked := false
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			switch n.Data {
+			case "a", "link", "img", "video", "audio", "source":
+				for _, key := range []string{"href", "src"} {
+					if v, ok := attr(n, key); ok && sameURL(base, v, target) {
+						linked = true
+					}
+				}
+			case "title":
+				if info.Title == "" && n.FirstChild != nil {
+					info.Title = strings.TrimSpace(n.FirstChild.Data)
+				}
+			case "meta":
+				name := strings.ToLower(attrValue(n, "name") + attrValue(n, "property"))
+				content := strings.TrimSpace(attrValue(n, "content"))
+				switch name {
+				case "description", "og:description":
+					if info.Excerpt == "" {
+						info.Excerpt = content
+					}
+				case "author", "article:author":
+					if info.Author == "" {
+						info.Author = content
+					}
+				case "og:title":
+					if content != "" {
+						info.Title = content
+					}
+				}
+			}
+		}
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			walk(child)
+		}
+	}
+	walk(doc)
+	return info, linked
+}
+
+// sameURL 判断页面中的链接（可能为相对地址）是否指向目标地址
+func sameURL(base *url.URL, href, target string) bool {
+	ref, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return false
+	}
+	abs := base.ResolveReference(ref)
+	abs.Fragment = ""
+	return strings.TrimSuffix(abs.String(), "/") == strings.TrimSuffix(target, "/")
+}
+
+// resolve 以 base 为基准解析相对地址
+func resolve(base *url.URL, href string) (string, error) {
+	ref, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	abs := base.ResolveReference(ref)
+	if abs.Scheme != "http" && abs.Scheme != "https" {
+		return "", errors.Errorf("webmention: unsupported endpoint scheme %q", abs.Scheme)
+	}
+	return abs.String(), nil
+}
+
+func isHTML(contentType string) bool {
+	contentType = strings.ToLower(contentType)
+	return contentType == "" || strings.Contains(contentType, "text/html") || strings.Contains(contentType, "application/xhtml")
+}
+
+func hasRel(rel, value string) bool {
+	for _, r := range strings.Fields(strings.ToLower(rel)) {
+		if r == value {
+			return true
+		}
+	}
+	return false
+}
+
+func attr(n *html.Node, key string) (string, bool) {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val, true
+		}
+	}
+	return "", false
+}
+
+func attrValue(n *html.Node, key string) string {
+	v, _ := attr(n, key)
+	return v
+}
@@ -0,0 +1,193 @@
+package webmention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestClient() *Client {
+	return NewClient(Options{AllowPrivateHosts: true})
+}
+
+func TestDiscover(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/header", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Link", `<https://example.com/style.css>; rel="stylesheet"`)
+		w.Header().Add("Link", `</endpoint/header>; rel="webmention"`)
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		fmt.Fprint(w, `<html><head><link rel="webmention" href="/endpoint/html"></head></html>`)
+	})
+	mux.HandleFunc("/link", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><link rel="stylesheet" href="/a.css"><link rel="webmention" href="endpoint/link"></head></html>`)
+	})
+	mux.HandleFunc("/anchor", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><body><a rel="nofollow webmention" href="https://mentions.example.com/wm">wm</a></body></html>`)
+	})
+	mux.HandleFunc("/redirect", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/posts/final", http.StatusFound)
+	})
+	mux.HandleFunc("/posts/final", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><link rel="webmention" href="wm"></head></html>`)
+	})
+	mux.HandleFunc("/none", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><body><a href="/other">other</a></body></html>`)
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{"Link 响应头优先于页面元素", "/header", server.URL + "/endpoint/header", false},
+		{"<link> 元素的相对地址", "/link", server.URL + "/endpoint/link", false},
+		{"<a> 元素的绝对地址", "/anchor", "https://mentions.example.com/wm", false},
+		{"相对地址以重定向后的地址为基准", "/redirect", server.URL + "/posts/wm", false},
+		{"不支持 Webmention", "/none", "", false},
+		{"目标页面不存在", "/missing", "", true},
+	}
+
+	client := newTestClient()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := client.Discover(context.Background(), server.URL+tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Discover() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Discover() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerify(t *testing.T) {
+	const target = "https://blog.example.com/articles/1"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusGone)
+	})
+	mux.HandleFunc("/error", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	mux.HandleFunc("/unlinked", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><body>`+target+` <a href="https://blog.example.com/articles/2">other</a></body></html>`)
+	})
+	mux.HandleFunc("/linked", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head><title>Reply</title>`+
+			`<meta name="description" content="A reply">`+
+			`<meta name="author" content="Alice"></head>`+
+			`<body><a href="`+target+`/#comments">target</a></body></html>`)
+	})
+	mux.HandleFunc("/relative", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><body><a href="../articles/1">target</a></body></html>`)
+	})
+	mux.HandleFunc("/plain", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		fmt.Fprint(w, "see "+target)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client := newTestClient()
+	ctx := context.Background()
+
+	t.Run("来源已删除", func(t *testing.T) {
+		if _, err := client.Verify(ctx, server.URL+"/gone", target); !errors.Is(err, ErrSourceGone) {
+			t.Errorf("Verify() error = %v, want ErrSourceGone", err)
+		}
+	})
+
+	t.Run("来源请求失败", func(t *testing.T) {
+		_, err := client.Verify(ctx, server.URL+"/error", target)
+		if err == nil || errors.Is(err, ErrSourceGone) || errors.Is(err, ErrLinkNotFound) {
+			t.Errorf("Verify() error = %v, want retryable error", err)
+		}
+	})
+
+	t.Run("HTML 中只有文本没有链接", func(t *testing.T) {
+		if _, err := client.Verify(ctx, server.URL+"/unlinked", target); !errors.Is(err, ErrLinkNotFound) {
+			t.Errorf("Verify() error = %v, want ErrLinkNotFound", err)
+		}
+	})
+
+	t.Run("提取来源信息", func(t *testing.T) {
+		info, err := client.Verify(ctx, server.URL+"/linked", target)
+		if err != nil {
+			t.Fatalf("Verify() error = %v", err)
+		}
+		want := Source{URL: server.URL + "/linked", Title: "Reply", Excerpt: "A reply", Author: "Alice"}
+		if *info != want {
+			t.Errorf("Verify() = %+v, want %+v", *info, want)
+		}
+	})
+
+	t.Run("相对链接按来源地址解析", func(t *testing.T) {
+		relativeTarget := server.URL + "/articles/1"
+		if _, err := client.Verify(ctx, server.URL+"/relative", relativeTarget); err != nil {
+			t.Errorf("Verify() error = %v", err)
+		}
+	})
+
+	t.Run("非 HTML 内容包含目标地址", func(t *testing.T) {
+		if _, err := client.Verify(ctx, server.URL+"/plain", target); err != nil {
+			t.Errorf("Verify() error = %v", err)
+		}
+		if _, err := client.Verify(ctx, server.URL+"/plain", "https://blog.example.com/articles/2"); !errors.Is(err, ErrLinkNotFound) {
+			t.Errorf("Verify() error = %v, want ErrLinkNotFound", err)
+		}
+	})
+}
+
+func TestSend(t *testing.T) {
+	const (
+		source = "https://blog.example.com/articles/1"
+		target = "https://other.example.com/post"
+	)
+
+	var got struct {
+		method, contentType, source, target string
+	}
+	status := http.StatusAccepted
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got.method = r.Method
+		got.contentType = r.Header.Get("Content-Type")
+		got.source = r.PostFormValue("source")
+		got.target = r.PostFormValue("target")
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	client := newTestClient()
+	if err := client.Send(context.Background(), server.URL, source, target); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if got.method != http.MethodPost || got.contentType != "application/x-www-form-urlencoded" {
+		t.Errorf("Send() request = %s %s, want form POST", got.method, got.contentType)
+	}
+	if got.source != source || got.target != target {
+		t.Errorf("Send() form = source %q target %q", got.source, got.target)
+	}
+
+	status = http.StatusBadRequest
+	if err := client.Send(context.Background(), server.URL, source, target); err == nil {
+		t.Error("Send() error = nil, want error for status 400")
+	}
+}
@@ -0,0 +1,46 @@
+package webmention
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+var (
+	// markdownLinkRe Markdown 链接与图片：[text](url "title")
+	markdownLinkRe = regexp.MustCompile(`\]\(\s*<?(https?://[^\s)>]+)>?(?:\s+"[^"]*")?\s*\)`)
+	// htmlLinkRe HTML 链接：href="url"
+	htmlLinkRe = regexp.MustCompile(`(?i)href\s*=\s*["'](https?://[^"']+)["']`)
+	// bareLinkRe 裸链接
+	bareLinkRe = regexp.MustCompile(`https?://[^\s<>"'()\[\]]+`)
+)
+
+// ExtractLinks 提取文本（Markdown 或 HTML）中的外部链接，结果去重并保持出现顺序
+func ExtractLinks(content string) []string {
+	seen := make(map[string]struct{})
+	var links []string
+	add := func(raw string) {
+		raw = strings.TrimRight(raw, ".,;:!?。，；：！？")
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return
+		}
+		u.Fragment = ""
+		link := u.String()
+		if _, ok := seen[link]; ok {
+			return
+		}
+		seen[link] = struct{}{}
+		links = append(links, link)
+	}
+
+	for _, re := range []*regexp.Regexp{markdownLinkRe, htmlLinkRe} {
+		for _, m := range re.FindAllStringSubmatch(content, -1) {
+			add(m[1])
+		}
+	}
+	for _, m := range bareLinkRe.FindAllString(content, -1) {
+		add(m)
+	}
+	return links
+}